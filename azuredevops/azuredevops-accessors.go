@@ -47,1676 +47,3668 @@ func (a *AgentPoolQueue) GetURL() string {
 	return *a.URL
 }
 
-// GetAllowedMappings returns the AllowedMappings field if it's non-nil, zero value otherwise.
-func (b *Board) GetAllowedMappings() string {
-	if b == nil || b.AllowedMappings == nil {
+// GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
+func (a *AgentSpecification) GetIdentifier() string {
+	if a == nil || a.Identifier == nil {
 		return ""
 	}
-	return *b.AllowedMappings
+	return *a.Identifier
 }
 
-// GetCanEdit returns the CanEdit field if it's non-nil, zero value otherwise.
-func (b *Board) GetCanEdit() bool {
-	if b == nil || b.CanEdit == nil {
-		return false
+// GetCreatedOn returns the CreatedOn field.
+func (a *Approval) GetCreatedOn() *Time {
+	if a == nil {
+		return nil
 	}
-	return *b.CanEdit
+	return a.CreatedOn
 }
 
-// GetFields returns the Fields field.
-func (b *Board) GetFields() *BoardFields {
-	if b == nil {
+// GetExecutionOrder returns the ExecutionOrder field.
+func (a *Approval) GetExecutionOrder() *ApprovalExecutionOrder {
+	if a == nil {
 		return nil
 	}
-	return b.Fields
+	return a.ExecutionOrder
 }
 
-// GetIsValid returns the IsValid field if it's non-nil, zero value otherwise.
-func (b *Board) GetIsValid() bool {
-	if b == nil || b.IsValid == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *Approval) GetID() string {
+	if a == nil || a.ID == nil {
+		return ""
 	}
-	return *b.IsValid
+	return *a.ID
 }
 
-// GetLinks returns the Links field if it's non-nil, zero value otherwise.
-func (b *Board) GetLinks() map[string]Link {
-	if b == nil || b.Links == nil {
-		return map[string]Link{}
+// GetInstructions returns the Instructions field if it's non-nil, zero value otherwise.
+func (a *Approval) GetInstructions() string {
+	if a == nil || a.Instructions == nil {
+		return ""
 	}
-	return *b.Links
+	return *a.Instructions
 }
 
-// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
-func (b *Board) GetRevision() int {
-	if b == nil || b.Revision == nil {
-		return 0
+// GetLastModifiedOn returns the LastModifiedOn field.
+func (a *Approval) GetLastModifiedOn() *Time {
+	if a == nil {
+		return nil
 	}
-	return *b.Revision
+	return a.LastModifiedOn
 }
 
-// GetColumnType returns the ColumnType field if it's non-nil, zero value otherwise.
-func (b *BoardColumn) GetColumnType() string {
-	if b == nil || b.ColumnType == nil {
-		return ""
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (a *Approval) GetLinks() map[string]Link {
+	if a == nil || a.Links == nil {
+		return map[string]Link{}
 	}
-	return *b.ColumnType
+	return *a.Links
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (b *BoardColumn) GetDescription() string {
-	if b == nil || b.Description == nil {
-		return ""
+// GetMinRequiredApprovers returns the MinRequiredApprovers field if it's non-nil, zero value otherwise.
+func (a *Approval) GetMinRequiredApprovers() int {
+	if a == nil || a.MinRequiredApprovers == nil {
+		return 0
 	}
-	return *b.Description
+	return *a.MinRequiredApprovers
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (b *BoardColumn) GetID() string {
-	if b == nil || b.ID == nil {
-		return ""
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (a *Approval) GetPermissions() int {
+	if a == nil || a.Permissions == nil {
+		return 0
 	}
-	return *b.ID
+	return *a.Permissions
 }
 
-// GetIsSplit returns the IsSplit field if it's non-nil, zero value otherwise.
-func (b *BoardColumn) GetIsSplit() bool {
-	if b == nil || b.IsSplit == nil {
-		return false
+// GetPipeline returns the Pipeline field.
+func (a *Approval) GetPipeline() *ApprovalPipeline {
+	if a == nil {
+		return nil
 	}
-	return *b.IsSplit
+	return a.Pipeline
 }
 
-// GetItemLimit returns the ItemLimit field if it's non-nil, zero value otherwise.
-func (b *BoardColumn) GetItemLimit() int {
-	if b == nil || b.ItemLimit == nil {
-		return 0
+// GetStatus returns the Status field.
+func (a *Approval) GetStatus() *ApprovalStatus {
+	if a == nil {
+		return nil
 	}
-	return *b.ItemLimit
+	return a.Status
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (b *BoardColumn) GetName() string {
-	if b == nil || b.Name == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *ApprovalPipeline) GetID() string {
+	if a == nil || a.ID == nil {
 		return ""
 	}
-	return *b.Name
+	return *a.ID
 }
 
-// GetColumnField returns the ColumnField field.
-func (b *BoardFields) GetColumnField() *FieldReference {
-	if b == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *ApprovalPipeline) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
 	}
-	return b.ColumnField
+	return *a.Name
 }
 
-// GetDoneField returns the DoneField field.
-func (b *BoardFields) GetDoneField() *FieldReference {
-	if b == nil {
+// GetOwner returns the Owner field.
+func (a *ApprovalPipeline) GetOwner() *ApprovalPipelineOwner {
+	if a == nil {
 		return nil
 	}
-	return b.DoneField
+	return a.Owner
 }
 
-// GetRowField returns the RowField field.
-func (b *BoardFields) GetRowField() *FieldReference {
-	if b == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *ApprovalPipelineOwner) GetID() int {
+	if a == nil || a.ID == nil {
+		return 0
 	}
-	return b.RowField
+	return *a.ID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (b *BoardReference) GetID() string {
-	if b == nil || b.ID == nil {
-		return ""
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (a *ApprovalPipelineOwner) GetLinks() map[string]Link {
+	if a == nil || a.Links == nil {
+		return map[string]Link{}
 	}
-	return *b.ID
+	return *a.Links
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (b *BoardReference) GetName() string {
-	if b == nil || b.Name == nil {
+func (a *ApprovalPipelineOwner) GetName() string {
+	if a == nil || a.Name == nil {
 		return ""
 	}
-	return *b.Name
+	return *a.Name
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (b *BoardReference) GetURL() string {
-	if b == nil || b.URL == nil {
-		return ""
+// GetExecutionOrder returns the ExecutionOrder field.
+func (a *ApprovalSettings) GetExecutionOrder() *ApprovalExecutionOrder {
+	if a == nil {
+		return nil
 	}
-	return *b.URL
+	return a.ExecutionOrder
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (b *BoardRow) GetID() string {
-	if b == nil || b.ID == nil {
+// GetInstructions returns the Instructions field if it's non-nil, zero value otherwise.
+func (a *ApprovalSettings) GetInstructions() string {
+	if a == nil || a.Instructions == nil {
 		return ""
 	}
-	return *b.ID
+	return *a.Instructions
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (b *BoardRow) GetName() string {
-	if b == nil || b.Name == nil {
-		return ""
+// GetMinRequiredApprovers returns the MinRequiredApprovers field if it's non-nil, zero value otherwise.
+func (a *ApprovalSettings) GetMinRequiredApprovers() int {
+	if a == nil || a.MinRequiredApprovers == nil {
+		return 0
 	}
-	return *b.Name
+	return *a.MinRequiredApprovers
 }
 
-// GetBuildNumber returns the BuildNumber field if it's non-nil, zero value otherwise.
-func (b *Build) GetBuildNumber() string {
-	if b == nil || b.BuildNumber == nil {
-		return ""
+// GetRequesterCannotBeApprover returns the RequesterCannotBeApprover field if it's non-nil, zero value otherwise.
+func (a *ApprovalSettings) GetRequesterCannotBeApprover() bool {
+	if a == nil || a.RequesterCannotBeApprover == nil {
+		return false
 	}
-	return *b.BuildNumber
+	return *a.RequesterCannotBeApprover
 }
 
-// GetBuildNumberRevision returns the BuildNumberRevision field if it's non-nil, zero value otherwise.
-func (b *Build) GetBuildNumberRevision() int {
-	if b == nil || b.BuildNumberRevision == nil {
-		return 0
+// GetActualApprover returns the ActualApprover field.
+func (a *ApprovalStep) GetActualApprover() *IdentityRef {
+	if a == nil {
+		return nil
 	}
-	return *b.BuildNumberRevision
+	return a.ActualApprover
 }
 
-// GetChangedDate returns the ChangedDate field if it's non-nil, zero value otherwise.
-func (b *Build) GetChangedDate() string {
-	if b == nil || b.ChangedDate == nil {
-		return ""
+// GetAssignedApprover returns the AssignedApprover field.
+func (a *ApprovalStep) GetAssignedApprover() *IdentityRef {
+	if a == nil {
+		return nil
 	}
-	return *b.ChangedDate
+	return a.AssignedApprover
 }
 
-// GetController returns the Controller field.
-func (b *Build) GetController() *BuildController {
-	if b == nil {
-		return nil
+// GetComment returns the Comment field if it's non-nil, zero value otherwise.
+func (a *ApprovalStep) GetComment() string {
+	if a == nil || a.Comment == nil {
+		return ""
 	}
-	return b.Controller
+	return *a.Comment
 }
 
-// GetDefinition returns the Definition field.
-func (b *Build) GetDefinition() *BuildDefinition {
-	if b == nil {
+// GetInitiatedOn returns the InitiatedOn field.
+func (a *ApprovalStep) GetInitiatedOn() *Time {
+	if a == nil {
 		return nil
 	}
-	return b.Definition
+	return a.InitiatedOn
 }
 
-// GetDeleted returns the Deleted field if it's non-nil, zero value otherwise.
-func (b *Build) GetDeleted() bool {
-	if b == nil || b.Deleted == nil {
-		return false
+// GetLastModifiedBy returns the LastModifiedBy field.
+func (a *ApprovalStep) GetLastModifiedBy() *IdentityRef {
+	if a == nil {
+		return nil
 	}
-	return *b.Deleted
+	return a.LastModifiedBy
 }
 
-// GetDeletedBy returns the DeletedBy field.
-func (b *Build) GetDeletedBy() *IdentityRef {
-	if b == nil {
+// GetLastModifiedOn returns the LastModifiedOn field.
+func (a *ApprovalStep) GetLastModifiedOn() *Time {
+	if a == nil {
 		return nil
 	}
-	return b.DeletedBy
+	return a.LastModifiedOn
 }
 
-// GetDeletedDate returns the DeletedDate field if it's non-nil, zero value otherwise.
-func (b *Build) GetDeletedDate() string {
-	if b == nil || b.DeletedDate == nil {
-		return ""
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (a *ApprovalStep) GetPermissions() int {
+	if a == nil || a.Permissions == nil {
+		return 0
 	}
-	return *b.DeletedDate
+	return *a.Permissions
 }
 
-// GetDeletedReason returns the DeletedReason field if it's non-nil, zero value otherwise.
-func (b *Build) GetDeletedReason() string {
-	if b == nil || b.DeletedReason == nil {
-		return ""
+// GetStatus returns the Status field.
+func (a *ApprovalStep) GetStatus() *ApprovalStatus {
+	if a == nil {
+		return nil
 	}
-	return *b.DeletedReason
+	return a.Status
 }
 
-// GetFinishTime returns the FinishTime field if it's non-nil, zero value otherwise.
-func (b *Build) GetFinishTime() string {
-	if b == nil || b.FinishTime == nil {
+// GetApprovalID returns the ApprovalID field if it's non-nil, zero value otherwise.
+func (a *ApprovalUpdateParameters) GetApprovalID() string {
+	if a == nil || a.ApprovalID == nil {
 		return ""
 	}
-	return *b.FinishTime
+	return *a.ApprovalID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (b *Build) GetID() int {
-	if b == nil || b.ID == nil {
-		return 0
+// GetComment returns the Comment field if it's non-nil, zero value otherwise.
+func (a *ApprovalUpdateParameters) GetComment() string {
+	if a == nil || a.Comment == nil {
+		return ""
 	}
-	return *b.ID
+	return *a.Comment
 }
 
-// GetKeepForever returns the KeepForever field if it's non-nil, zero value otherwise.
-func (b *Build) GetKeepForever() bool {
-	if b == nil || b.KeepForever == nil {
-		return false
+// GetReassignTo returns the ReassignTo field.
+func (a *ApprovalUpdateParameters) GetReassignTo() *IdentityRef {
+	if a == nil {
+		return nil
 	}
-	return *b.KeepForever
+	return a.ReassignTo
 }
 
-// GetLastChangedBy returns the LastChangedBy field.
-func (b *Build) GetLastChangedBy() *IdentityRef {
-	if b == nil {
+// GetStatus returns the Status field.
+func (a *ApprovalUpdateParameters) GetStatus() *ApprovalStatus {
+	if a == nil {
 		return nil
 	}
-	return b.LastChangedBy
+	return a.Status
 }
 
-// GetLogs returns the Logs field.
-func (b *Build) GetLogs() *BuildLogReference {
-	if b == nil {
-		return nil
+// GetAlias returns the Alias field if it's non-nil, zero value otherwise.
+func (a *ArtifactMetadata) GetAlias() string {
+	if a == nil || a.Alias == nil {
+		return ""
 	}
-	return b.Logs
+	return *a.Alias
 }
 
-// GetOrchestrationPlan returns the OrchestrationPlan field.
-func (b *Build) GetOrchestrationPlan() *TaskOrchestrationPlanReference {
-	if b == nil {
+// GetInstanceReference returns the InstanceReference field.
+func (a *ArtifactMetadata) GetInstanceReference() *BuildVersion {
+	if a == nil {
 		return nil
 	}
-	return b.OrchestrationPlan
+	return a.InstanceReference
 }
 
-// GetParams returns the Params field if it's non-nil, zero value otherwise.
-func (b *Build) GetParams() string {
-	if b == nil || b.Params == nil {
+// GetData returns the Data field if it's non-nil, zero value otherwise.
+func (a *ArtifactResource) GetData() string {
+	if a == nil || a.Data == nil {
 		return ""
 	}
-	return *b.Params
+	return *a.Data
 }
 
-// GetPriority returns the Priority field if it's non-nil, zero value otherwise.
-func (b *Build) GetPriority() string {
-	if b == nil || b.Priority == nil {
+// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
+func (a *ArtifactResource) GetDownloadURL() string {
+	if a == nil || a.DownloadURL == nil {
 		return ""
 	}
-	return *b.Priority
+	return *a.DownloadURL
 }
 
-// GetProject returns the Project field.
-func (b *Build) GetProject() *TeamProjectReference {
-	if b == nil {
-		return nil
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (a *ArtifactResource) GetLinks() map[string]Link {
+	if a == nil || a.Links == nil {
+		return map[string]Link{}
 	}
-	return b.Project
+	return *a.Links
 }
 
-// GetQuality returns the Quality field if it's non-nil, zero value otherwise.
-func (b *Build) GetQuality() string {
-	if b == nil || b.Quality == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (a *ArtifactResource) GetType() string {
+	if a == nil || a.Type == nil {
 		return ""
 	}
-	return *b.Quality
+	return *a.Type
 }
 
-// GetQueue returns the Queue field.
-func (b *Build) GetQueue() *AgentPoolQueue {
-	if b == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (a *ArtifactResource) GetURL() string {
+	if a == nil || a.URL == nil {
+		return ""
 	}
-	return b.Queue
+	return *a.URL
 }
 
-// GetQueuePosition returns the QueuePosition field if it's non-nil, zero value otherwise.
-func (b *Build) GetQueuePosition() int {
-	if b == nil || b.QueuePosition == nil {
-		return 0
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *ArtifactSourceReference) GetID() string {
+	if a == nil || a.ID == nil {
+		return ""
 	}
-	return *b.QueuePosition
+	return *a.ID
 }
 
-// GetQueueTime returns the QueueTime field if it's non-nil, zero value otherwise.
-func (b *Build) GetQueueTime() string {
-	if b == nil || b.QueueTime == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *ArtifactSourceReference) GetName() string {
+	if a == nil || a.Name == nil {
 		return ""
 	}
-	return *b.QueueTime
+	return *a.Name
 }
 
-// GetRepository returns the Repository field.
-func (b *Build) GetRepository() *BuildRepository {
-	if b == nil {
+// GetAuthor returns the Author field.
+func (a *Attachment) GetAuthor() *IdentityRef {
+	if a == nil {
 		return nil
 	}
-	return b.Repository
+	return a.Author
 }
 
-// GetResult returns the Result field if it's non-nil, zero value otherwise.
-func (b *Build) GetResult() string {
-	if b == nil || b.Result == nil {
+// GetContentHash returns the ContentHash field if it's non-nil, zero value otherwise.
+func (a *Attachment) GetContentHash() string {
+	if a == nil || a.ContentHash == nil {
 		return ""
 	}
-	return *b.Result
-}
-
-// GetRetainedByRelease returns the RetainedByRelease field if it's non-nil, zero value otherwise.
-func (b *Build) GetRetainedByRelease() bool {
-	if b == nil || b.RetainedByRelease == nil {
-		return false
-	}
-	return *b.RetainedByRelease
+	return *a.ContentHash
 }
 
-// GetSourceBranch returns the SourceBranch field if it's non-nil, zero value otherwise.
-func (b *Build) GetSourceBranch() string {
-	if b == nil || b.SourceBranch == nil {
-		return ""
+// GetCreatedDate returns the CreatedDate field.
+func (a *Attachment) GetCreatedDate() *Time {
+	if a == nil {
+		return nil
 	}
-	return *b.SourceBranch
+	return a.CreatedDate
 }
 
-// GetStartTime returns the StartTime field if it's non-nil, zero value otherwise.
-func (b *Build) GetStartTime() string {
-	if b == nil || b.StartTime == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (a *Attachment) GetDescription() string {
+	if a == nil || a.Description == nil {
 		return ""
 	}
-	return *b.StartTime
+	return *a.Description
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (b *Build) GetStatus() string {
-	if b == nil || b.Status == nil {
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (a *Attachment) GetDisplayName() string {
+	if a == nil || a.DisplayName == nil {
 		return ""
 	}
-	return *b.Status
-}
-
-// GetTriggerBuild returns the TriggerBuild field.
-func (b *Build) GetTriggerBuild() *Build {
-	if b == nil {
-		return nil
-	}
-	return b.TriggerBuild
+	return *a.DisplayName
 }
 
-// GetTriggerInfo returns the TriggerInfo field.
-func (b *Build) GetTriggerInfo() *TriggerInfo {
-	if b == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *Attachment) GetID() int {
+	if a == nil || a.ID == nil {
+		return 0
 	}
-	return b.TriggerInfo
+	return *a.ID
 }
 
-// GetURI returns the URI field if it's non-nil, zero value otherwise.
-func (b *Build) GetURI() string {
-	if b == nil || b.URI == nil {
-		return ""
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (a *Attachment) GetLinks() map[string]Link {
+	if a == nil || a.Links == nil {
+		return map[string]Link{}
 	}
-	return *b.URI
+	return *a.Links
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (b *Build) GetURL() string {
-	if b == nil || b.URL == nil {
+func (a *Attachment) GetURL() string {
+	if a == nil || a.URL == nil {
 		return ""
 	}
-	return *b.URL
+	return *a.URL
 }
 
-// GetVersion returns the Version field if it's non-nil, zero value otherwise.
-func (b *Build) GetVersion() string {
-	if b == nil || b.Version == nil {
+// GetAllowedMappings returns the AllowedMappings field if it's non-nil, zero value otherwise.
+func (b *Board) GetAllowedMappings() string {
+	if b == nil || b.AllowedMappings == nil {
 		return ""
 	}
-	return *b.Version
+	return *b.AllowedMappings
 }
 
-// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
-func (b *BuildController) GetCreatedDate() string {
-	if b == nil || b.CreatedDate == nil {
-		return ""
+// GetCanEdit returns the CanEdit field if it's non-nil, zero value otherwise.
+func (b *Board) GetCanEdit() bool {
+	if b == nil || b.CanEdit == nil {
+		return false
 	}
-	return *b.CreatedDate
+	return *b.CanEdit
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (b *BuildController) GetDescription() string {
-	if b == nil || b.Description == nil {
-		return ""
+// GetFields returns the Fields field.
+func (b *Board) GetFields() *BoardFields {
+	if b == nil {
+		return nil
 	}
-	return *b.Description
+	return b.Fields
 }
 
-// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
-func (b *BuildController) GetEnabled() bool {
-	if b == nil || b.Enabled == nil {
+// GetIsValid returns the IsValid field if it's non-nil, zero value otherwise.
+func (b *Board) GetIsValid() bool {
+	if b == nil || b.IsValid == nil {
 		return false
 	}
-	return *b.Enabled
+	return *b.IsValid
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (b *BuildController) GetID() int {
-	if b == nil || b.ID == nil {
-		return 0
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (b *Board) GetLinks() map[string]Link {
+	if b == nil || b.Links == nil {
+		return map[string]Link{}
 	}
-	return *b.ID
+	return *b.Links
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (b *BuildController) GetName() string {
-	if b == nil || b.Name == nil {
-		return ""
+// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
+func (b *Board) GetRevision() int {
+	if b == nil || b.Revision == nil {
+		return 0
 	}
-	return *b.Name
+	return *b.Revision
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (b *BuildController) GetStatus() string {
-	if b == nil || b.Status == nil {
+// GetColumnType returns the ColumnType field if it's non-nil, zero value otherwise.
+func (b *BoardColumn) GetColumnType() string {
+	if b == nil || b.ColumnType == nil {
 		return ""
 	}
-	return *b.Status
+	return *b.ColumnType
 }
 
-// GetUpdateDate returns the UpdateDate field if it's non-nil, zero value otherwise.
-func (b *BuildController) GetUpdateDate() string {
-	if b == nil || b.UpdateDate == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (b *BoardColumn) GetDescription() string {
+	if b == nil || b.Description == nil {
 		return ""
 	}
-	return *b.UpdateDate
+	return *b.Description
 }
 
-// GetURI returns the URI field if it's non-nil, zero value otherwise.
-func (b *BuildController) GetURI() string {
-	if b == nil || b.URI == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BoardColumn) GetID() string {
+	if b == nil || b.ID == nil {
 		return ""
 	}
-	return *b.URI
+	return *b.ID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (b *BuildController) GetURL() string {
-	if b == nil || b.URL == nil {
-		return ""
+// GetIsSplit returns the IsSplit field if it's non-nil, zero value otherwise.
+func (b *BoardColumn) GetIsSplit() bool {
+	if b == nil || b.IsSplit == nil {
+		return false
 	}
-	return *b.URL
+	return *b.IsSplit
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (b *BuildDefinition) GetID() int {
-	if b == nil || b.ID == nil {
+// GetItemLimit returns the ItemLimit field if it's non-nil, zero value otherwise.
+func (b *BoardColumn) GetItemLimit() int {
+	if b == nil || b.ItemLimit == nil {
 		return 0
 	}
-	return *b.ID
+	return *b.ItemLimit
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (b *BuildDefinition) GetName() string {
+func (b *BoardColumn) GetName() string {
 	if b == nil || b.Name == nil {
 		return ""
 	}
 	return *b.Name
 }
 
-// GetRepository returns the Repository field.
-func (b *BuildDefinition) GetRepository() *BuildRepository {
+// GetColumnField returns the ColumnField field.
+func (b *BoardFields) GetColumnField() *FieldReference {
 	if b == nil {
 		return nil
 	}
-	return b.Repository
+	return b.ColumnField
 }
 
-// GetIncludeAllProperties returns the IncludeAllProperties field if it's non-nil, zero value otherwise.
-func (b *BuildDefinitionsListOptions) GetIncludeAllProperties() bool {
-	if b == nil || b.IncludeAllProperties == nil {
-		return false
+// GetDoneField returns the DoneField field.
+func (b *BoardFields) GetDoneField() *FieldReference {
+	if b == nil {
+		return nil
 	}
-	return *b.IncludeAllProperties
+	return b.DoneField
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (b *BuildDefinitionsListOptions) GetPath() string {
-	if b == nil || b.Path == nil {
+// GetRowField returns the RowField field.
+func (b *BoardFields) GetRowField() *FieldReference {
+	if b == nil {
+		return nil
+	}
+	return b.RowField
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BoardReference) GetID() string {
+	if b == nil || b.ID == nil {
 		return ""
 	}
-	return *b.Path
+	return *b.ID
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (b *BuildDemand) GetName() string {
+func (b *BoardReference) GetName() string {
 	if b == nil || b.Name == nil {
 		return ""
 	}
 	return *b.Name
 }
 
-// GetValue returns the Value field if it's non-nil, zero value otherwise.
-func (b *BuildDemand) GetValue() string {
-	if b == nil || b.Value == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (b *BoardReference) GetURL() string {
+	if b == nil || b.URL == nil {
 		return ""
 	}
-	return *b.Value
+	return *b.URL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (b *BuildLogReference) GetID() int {
+func (b *BoardRow) GetID() string {
 	if b == nil || b.ID == nil {
-		return 0
+		return ""
 	}
 	return *b.ID
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (b *BuildLogReference) GetType() string {
-	if b == nil || b.Type == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BoardRow) GetName() string {
+	if b == nil || b.Name == nil {
 		return ""
 	}
-	return *b.Type
+	return *b.Name
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (b *BuildLogReference) GetURL() string {
-	if b == nil || b.URL == nil {
+// GetBuildNumber returns the BuildNumber field if it's non-nil, zero value otherwise.
+func (b *Build) GetBuildNumber() string {
+	if b == nil || b.BuildNumber == nil {
 		return ""
 	}
-	return *b.URL
+	return *b.BuildNumber
 }
 
-// GetCheckoutSubmodules returns the CheckoutSubmodules field if it's non-nil, zero value otherwise.
-func (b *BuildRepository) GetCheckoutSubmodules() bool {
-	if b == nil || b.CheckoutSubmodules == nil {
-		return false
+// GetBuildNumberRevision returns the BuildNumberRevision field if it's non-nil, zero value otherwise.
+func (b *Build) GetBuildNumberRevision() int {
+	if b == nil || b.BuildNumberRevision == nil {
+		return 0
 	}
-	return *b.CheckoutSubmodules
+	return *b.BuildNumberRevision
 }
 
-// GetClean returns the Clean field if it's non-nil, zero value otherwise.
-func (b *BuildRepository) GetClean() string {
-	if b == nil || b.Clean == nil {
+// GetChangedDate returns the ChangedDate field if it's non-nil, zero value otherwise.
+func (b *Build) GetChangedDate() string {
+	if b == nil || b.ChangedDate == nil {
 		return ""
 	}
-	return *b.Clean
+	return *b.ChangedDate
 }
 
-// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
-func (b *BuildRepository) GetDefaultBranch() string {
-	if b == nil || b.DefaultBranch == nil {
-		return ""
+// GetController returns the Controller field.
+func (b *Build) GetController() *BuildController {
+	if b == nil {
+		return nil
 	}
-	return *b.DefaultBranch
+	return b.Controller
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (b *BuildRepository) GetID() string {
-	if b == nil || b.ID == nil {
-		return ""
+// GetDefinition returns the Definition field.
+func (b *Build) GetDefinition() *BuildDefinition {
+	if b == nil {
+		return nil
 	}
-	return *b.ID
+	return b.Definition
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (b *BuildRepository) GetName() string {
-	if b == nil || b.Name == nil {
-		return ""
+// GetDeleted returns the Deleted field if it's non-nil, zero value otherwise.
+func (b *Build) GetDeleted() bool {
+	if b == nil || b.Deleted == nil {
+		return false
 	}
-	return *b.Name
+	return *b.Deleted
 }
 
-// GetRootFolder returns the RootFolder field if it's non-nil, zero value otherwise.
-func (b *BuildRepository) GetRootFolder() string {
-	if b == nil || b.RootFolder == nil {
-		return ""
+// GetDeletedBy returns the DeletedBy field.
+func (b *Build) GetDeletedBy() *IdentityRef {
+	if b == nil {
+		return nil
 	}
-	return *b.RootFolder
+	return b.DeletedBy
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (b *BuildRepository) GetType() string {
-	if b == nil || b.Type == nil {
+// GetDeletedDate returns the DeletedDate field if it's non-nil, zero value otherwise.
+func (b *Build) GetDeletedDate() string {
+	if b == nil || b.DeletedDate == nil {
 		return ""
 	}
-	return *b.Type
+	return *b.DeletedDate
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (b *BuildRepository) GetURL() string {
-	if b == nil || b.URL == nil {
+// GetDeletedReason returns the DeletedReason field if it's non-nil, zero value otherwise.
+func (b *Build) GetDeletedReason() string {
+	if b == nil || b.DeletedReason == nil {
 		return ""
 	}
-	return *b.URL
+	return *b.DeletedReason
 }
 
-// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetBranch() string {
-	if b == nil || b.Branch == nil {
+// GetFinishTime returns the FinishTime field if it's non-nil, zero value otherwise.
+func (b *Build) GetFinishTime() string {
+	if b == nil || b.FinishTime == nil {
 		return ""
 	}
-	return *b.Branch
+	return *b.FinishTime
 }
 
-// GetBuildIDs returns the BuildIDs field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetBuildIDs() string {
-	if b == nil || b.BuildIDs == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *Build) GetID() int {
+	if b == nil || b.ID == nil {
+		return 0
 	}
-	return *b.BuildIDs
+	return *b.ID
 }
 
-// GetBuildNumber returns the BuildNumber field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetBuildNumber() string {
-	if b == nil || b.BuildNumber == nil {
-		return ""
+// GetKeepForever returns the KeepForever field if it's non-nil, zero value otherwise.
+func (b *Build) GetKeepForever() bool {
+	if b == nil || b.KeepForever == nil {
+		return false
 	}
-	return *b.BuildNumber
+	return *b.KeepForever
 }
 
-// GetCount returns the Count field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetCount() int {
-	if b == nil || b.Count == nil {
-		return 0
+// GetLastChangedBy returns the LastChangedBy field.
+func (b *Build) GetLastChangedBy() *IdentityRef {
+	if b == nil {
+		return nil
 	}
-	return *b.Count
+	return b.LastChangedBy
 }
 
-// GetDefinitions returns the Definitions field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetDefinitions() string {
-	if b == nil || b.Definitions == nil {
-		return ""
+// GetLogs returns the Logs field.
+func (b *Build) GetLogs() *BuildLogReference {
+	if b == nil {
+		return nil
 	}
-	return *b.Definitions
+	return b.Logs
 }
 
-// GetDeleted returns the Deleted field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetDeleted() string {
-	if b == nil || b.Deleted == nil {
-		return ""
+// GetOrchestrationPlan returns the OrchestrationPlan field.
+func (b *Build) GetOrchestrationPlan() *TaskOrchestrationPlanReference {
+	if b == nil {
+		return nil
 	}
-	return *b.Deleted
+	return b.OrchestrationPlan
 }
 
-// GetMaxPerDefinition returns the MaxPerDefinition field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetMaxPerDefinition() string {
-	if b == nil || b.MaxPerDefinition == nil {
+// GetParams returns the Params field if it's non-nil, zero value otherwise.
+func (b *Build) GetParams() string {
+	if b == nil || b.Params == nil {
 		return ""
 	}
-	return *b.MaxPerDefinition
+	return *b.Params
 }
 
-// GetMaxTime returns the MaxTime field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetMaxTime() string {
-	if b == nil || b.MaxTime == nil {
+// GetPriority returns the Priority field if it's non-nil, zero value otherwise.
+func (b *Build) GetPriority() string {
+	if b == nil || b.Priority == nil {
 		return ""
 	}
-	return *b.MaxTime
+	return *b.Priority
 }
 
-// GetMinTime returns the MinTime field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetMinTime() string {
-	if b == nil || b.MinTime == nil {
-		return ""
+// GetProject returns the Project field.
+func (b *Build) GetProject() *TeamProjectReference {
+	if b == nil {
+		return nil
 	}
-	return *b.MinTime
+	return b.Project
 }
 
-// GetOrder returns the Order field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetOrder() string {
-	if b == nil || b.Order == nil {
+// GetQuality returns the Quality field if it's non-nil, zero value otherwise.
+func (b *Build) GetQuality() string {
+	if b == nil || b.Quality == nil {
 		return ""
 	}
-	return *b.Order
+	return *b.Quality
 }
 
-// GetProps returns the Props field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetProps() string {
-	if b == nil || b.Props == nil {
-		return ""
+// GetQueue returns the Queue field.
+func (b *Build) GetQueue() *AgentPoolQueue {
+	if b == nil {
+		return nil
 	}
-	return *b.Props
+	return b.Queue
 }
 
-// GetQueues returns the Queues field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetQueues() string {
-	if b == nil || b.Queues == nil {
-		return ""
+// GetQueuePosition returns the QueuePosition field if it's non-nil, zero value otherwise.
+func (b *Build) GetQueuePosition() int {
+	if b == nil || b.QueuePosition == nil {
+		return 0
 	}
-	return *b.Queues
+	return *b.QueuePosition
 }
 
-// GetReason returns the Reason field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetReason() string {
-	if b == nil || b.Reason == nil {
+// GetQueueTime returns the QueueTime field if it's non-nil, zero value otherwise.
+func (b *Build) GetQueueTime() string {
+	if b == nil || b.QueueTime == nil {
 		return ""
 	}
-	return *b.Reason
+	return *b.QueueTime
 }
 
-// GetRepository returns the Repository field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetRepository() string {
-	if b == nil || b.Repository == nil {
-		return ""
+// GetRepository returns the Repository field.
+func (b *Build) GetRepository() *BuildRepository {
+	if b == nil {
+		return nil
 	}
-	return *b.Repository
+	return b.Repository
 }
 
-// GetRepoType returns the RepoType field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetRepoType() string {
-	if b == nil || b.RepoType == nil {
-		return ""
+// GetResult returns the Result field.
+func (b *Build) GetResult() *BuildResult {
+	if b == nil {
+		return nil
 	}
-	return *b.RepoType
+	return b.Result
 }
 
-// GetResult returns the Result field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetResult() string {
-	if b == nil || b.Result == nil {
-		return ""
+// GetRetainedByRelease returns the RetainedByRelease field if it's non-nil, zero value otherwise.
+func (b *Build) GetRetainedByRelease() bool {
+	if b == nil || b.RetainedByRelease == nil {
+		return false
 	}
-	return *b.Result
+	return *b.RetainedByRelease
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetStatus() string {
-	if b == nil || b.Status == nil {
+// GetSourceBranch returns the SourceBranch field if it's non-nil, zero value otherwise.
+func (b *Build) GetSourceBranch() string {
+	if b == nil || b.SourceBranch == nil {
 		return ""
 	}
-	return *b.Status
+	return *b.SourceBranch
 }
 
-// GetTags returns the Tags field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetTags() string {
-	if b == nil || b.Tags == nil {
+// GetStartTime returns the StartTime field if it's non-nil, zero value otherwise.
+func (b *Build) GetStartTime() string {
+	if b == nil || b.StartTime == nil {
 		return ""
 	}
-	return *b.Tags
+	return *b.StartTime
 }
 
-// GetToken returns the Token field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetToken() string {
-	if b == nil || b.Token == nil {
-		return ""
+// GetStatus returns the Status field.
+func (b *Build) GetStatus() *BuildStatus {
+	if b == nil {
+		return nil
 	}
-	return *b.Token
+	return b.Status
 }
 
-// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
-func (b *BuildsListOptions) GetUserID() string {
-	if b == nil || b.UserID == nil {
-		return ""
+// GetTriggerBuild returns the TriggerBuild field.
+func (b *Build) GetTriggerBuild() *Build {
+	if b == nil {
+		return nil
 	}
-	return *b.UserID
+	return b.TriggerBuild
 }
 
-// GetAuthor returns the Author field.
-func (c *Comment) GetAuthor() *IdentityRef {
-	if c == nil {
+// GetTriggerInfo returns the TriggerInfo field.
+func (b *Build) GetTriggerInfo() *TriggerInfo {
+	if b == nil {
 		return nil
 	}
-	return c.Author
+	return b.TriggerInfo
 }
 
-// GetCommentType returns the CommentType field if it's non-nil, zero value otherwise.
-func (c *Comment) GetCommentType() string {
-	if c == nil || c.CommentType == nil {
+// GetURI returns the URI field if it's non-nil, zero value otherwise.
+func (b *Build) GetURI() string {
+	if b == nil || b.URI == nil {
 		return ""
 	}
-	return *c.CommentType
+	return *b.URI
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (c *Comment) GetContent() string {
-	if c == nil || c.Content == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (b *Build) GetURL() string {
+	if b == nil || b.URL == nil {
 		return ""
 	}
-	return *c.Content
+	return *b.URL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *Comment) GetID() int {
-	if c == nil || c.ID == nil {
-		return 0
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (b *Build) GetVersion() string {
+	if b == nil || b.Version == nil {
+		return ""
 	}
-	return *c.ID
+	return *b.Version
 }
 
-// GetIsDeleted returns the IsDeleted field if it's non-nil, zero value otherwise.
-func (c *Comment) GetIsDeleted() bool {
-	if c == nil || c.IsDeleted == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BuildArtifact) GetID() int {
+	if b == nil || b.ID == nil {
+		return 0
 	}
-	return *c.IsDeleted
+	return *b.ID
 }
 
-// GetLastContentUpdatedDate returns the LastContentUpdatedDate field.
-func (c *Comment) GetLastContentUpdatedDate() *Time {
-	if c == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BuildArtifact) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
 	}
-	return c.LastContentUpdatedDate
+	return *b.Name
 }
 
-// GetLastUpdatedDate returns the LastUpdatedDate field.
-func (c *Comment) GetLastUpdatedDate() *Time {
-	if c == nil {
+// GetResource returns the Resource field.
+func (b *BuildArtifact) GetResource() *ArtifactResource {
+	if b == nil {
 		return nil
 	}
-	return c.LastUpdatedDate
-}
-
-// GetLinks returns the Links field if it's non-nil, zero value otherwise.
-func (c *Comment) GetLinks() map[string]Link {
-	if c == nil || c.Links == nil {
-		return map[string]Link{}
-	}
-	return *c.Links
+	return b.Resource
 }
 
-// GetParentCommentID returns the ParentCommentID field if it's non-nil, zero value otherwise.
-func (c *Comment) GetParentCommentID() int {
-	if c == nil || c.ParentCommentID == nil {
-		return 0
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (b *BuildArtifact) GetSource() string {
+	if b == nil || b.Source == nil {
+		return ""
 	}
-	return *c.ParentCommentID
+	return *b.Source
 }
 
-// GetPublishedDate returns the PublishedDate field.
-func (c *Comment) GetPublishedDate() *Time {
-	if c == nil {
+// GetAuthor returns the Author field.
+func (b *BuildChange) GetAuthor() *IdentityRef {
+	if b == nil {
 		return nil
 	}
-	return c.PublishedDate
+	return b.Author
 }
 
-// GetLine returns the Line field if it's non-nil, zero value otherwise.
-func (c *CommentPosition) GetLine() int {
-	if c == nil || c.Line == nil {
-		return 0
+// GetDisplayURI returns the DisplayURI field if it's non-nil, zero value otherwise.
+func (b *BuildChange) GetDisplayURI() string {
+	if b == nil || b.DisplayURI == nil {
+		return ""
 	}
-	return *c.Line
+	return *b.DisplayURI
 }
 
-// GetOffset returns the Offset field if it's non-nil, zero value otherwise.
-func (c *CommentPosition) GetOffset() int {
-	if c == nil || c.Offset == nil {
-		return 0
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BuildChange) GetID() string {
+	if b == nil || b.ID == nil {
+		return ""
 	}
-	return *c.Offset
+	return *b.ID
 }
 
-// GetCommentID returns the CommentID field if it's non-nil, zero value otherwise.
-func (c *CommentVersionRef) GetCommentID() int {
-	if c == nil || c.CommentID == nil {
-		return 0
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (b *BuildChange) GetLocation() string {
+	if b == nil || b.Location == nil {
+		return ""
 	}
-	return *c.CommentID
+	return *b.Location
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *CommentVersionRef) GetURL() string {
-	if c == nil || c.URL == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (b *BuildChange) GetMessage() string {
+	if b == nil || b.Message == nil {
 		return ""
 	}
-	return *c.URL
+	return *b.Message
 }
 
-// GetVersion returns the Version field if it's non-nil, zero value otherwise.
-func (c *CommentVersionRef) GetVersion() int {
-	if c == nil || c.Version == nil {
-		return 0
+// GetMessageTruncated returns the MessageTruncated field if it's non-nil, zero value otherwise.
+func (b *BuildChange) GetMessageTruncated() bool {
+	if b == nil || b.MessageTruncated == nil {
+		return false
 	}
-	return *c.Version
+	return *b.MessageTruncated
 }
 
-// GetCreated returns the Created field if it's non-nil, zero value otherwise.
-func (d *DeliveryPlan) GetCreated() string {
-	if d == nil || d.Created == nil {
+// GetPusher returns the Pusher field if it's non-nil, zero value otherwise.
+func (b *BuildChange) GetPusher() string {
+	if b == nil || b.Pusher == nil {
 		return ""
 	}
-	return *d.Created
+	return *b.Pusher
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *DeliveryPlan) GetID() string {
-	if d == nil || d.ID == nil {
-		return ""
+// GetTimestamp returns the Timestamp field.
+func (b *BuildChange) GetTimestamp() *Time {
+	if b == nil {
+		return nil
 	}
-	return *d.ID
+	return b.Timestamp
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (d *DeliveryPlan) GetName() string {
-	if d == nil || d.Name == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (b *BuildChange) GetType() string {
+	if b == nil || b.Type == nil {
 		return ""
 	}
-	return *d.Name
+	return *b.Type
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (d *DeliveryPlan) GetType() string {
-	if d == nil || d.Type == nil {
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (b *BuildController) GetCreatedDate() string {
+	if b == nil || b.CreatedDate == nil {
 		return ""
 	}
-	return *d.Type
+	return *b.CreatedDate
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (d *DeliveryPlan) GetURL() string {
-	if d == nil || d.URL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (b *BuildController) GetDescription() string {
+	if b == nil || b.Description == nil {
 		return ""
 	}
-	return *d.URL
+	return *b.Description
 }
 
-// GetEndDate returns the EndDate field if it's non-nil, zero value otherwise.
-func (d *DeliveryPlanTimeLine) GetEndDate() string {
-	if d == nil || d.EndDate == nil {
-		return ""
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (b *BuildController) GetEnabled() bool {
+	if b == nil || b.Enabled == nil {
+		return false
 	}
-	return *d.EndDate
+	return *b.Enabled
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *DeliveryPlanTimeLine) GetID() string {
-	if d == nil || d.ID == nil {
-		return ""
+func (b *BuildController) GetID() int {
+	if b == nil || b.ID == nil {
+		return 0
 	}
-	return *d.ID
+	return *b.ID
 }
 
-// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
-func (d *DeliveryPlanTimeLine) GetRevision() int {
-	if d == nil || d.Revision == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BuildController) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
 	}
-	return *d.Revision
+	return *b.Name
 }
 
-// GetStartDate returns the StartDate field if it's non-nil, zero value otherwise.
-func (d *DeliveryPlanTimeLine) GetStartDate() string {
-	if d == nil || d.StartDate == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (b *BuildController) GetStatus() string {
+	if b == nil || b.Status == nil {
 		return ""
 	}
-	return *d.StartDate
+	return *b.Status
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *DeliveryTeam) GetID() string {
-	if d == nil || d.ID == nil {
+// GetUpdateDate returns the UpdateDate field if it's non-nil, zero value otherwise.
+func (b *BuildController) GetUpdateDate() string {
+	if b == nil || b.UpdateDate == nil {
 		return ""
 	}
-	return *d.ID
+	return *b.UpdateDate
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (d *DeliveryTeam) GetName() string {
-	if d == nil || d.Name == nil {
+// GetURI returns the URI field if it's non-nil, zero value otherwise.
+func (b *BuildController) GetURI() string {
+	if b == nil || b.URI == nil {
 		return ""
 	}
-	return *d.Name
+	return *b.URI
 }
 
-// GetArtifactID returns the ArtifactID field if it's non-nil, zero value otherwise.
-func (f *Favourite) GetArtifactID() string {
-	if f == nil || f.ArtifactID == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (b *BuildController) GetURL() string {
+	if b == nil || b.URL == nil {
 		return ""
 	}
-	return *f.ArtifactID
+	return *b.URL
 }
 
-// GetArtifactName returns the ArtifactName field if it's non-nil, zero value otherwise.
-func (f *Favourite) GetArtifactName() string {
-	if f == nil || f.ArtifactName == nil {
-		return ""
+// GetAuthoredBy returns the AuthoredBy field.
+func (b *BuildDefinition) GetAuthoredBy() *IdentityRef {
+	if b == nil {
+		return nil
 	}
-	return *f.ArtifactName
+	return b.AuthoredBy
 }
 
-// GetArtifactType returns the ArtifactType field if it's non-nil, zero value otherwise.
-func (f *Favourite) GetArtifactType() string {
-	if f == nil || f.ArtifactType == nil {
-		return ""
+// GetBadgeEnabled returns the BadgeEnabled field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetBadgeEnabled() bool {
+	if b == nil || b.BadgeEnabled == nil {
+		return false
 	}
-	return *f.ArtifactType
+	return *b.BadgeEnabled
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (f *Favourite) GetID() string {
-	if f == nil || f.ID == nil {
+// GetBuildNumberFormat returns the BuildNumberFormat field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetBuildNumberFormat() string {
+	if b == nil || b.BuildNumberFormat == nil {
 		return ""
 	}
-	return *f.ID
+	return *b.BuildNumberFormat
 }
 
-// GetReferenceName returns the ReferenceName field if it's non-nil, zero value otherwise.
-func (f *FieldReference) GetReferenceName() string {
-	if f == nil || f.ReferenceName == nil {
+// GetComment returns the Comment field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetComment() string {
+	if b == nil || b.Comment == nil {
 		return ""
 	}
-	return *f.ReferenceName
+	return *b.Comment
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (f *FieldReference) GetURL() string {
-	if f == nil || f.URL == nil {
+// GetCreatedDate returns the CreatedDate field.
+func (b *BuildDefinition) GetCreatedDate() *Time {
+	if b == nil {
+		return nil
+	}
+	return b.CreatedDate
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetDescription() string {
+	if b == nil || b.Description == nil {
 		return ""
 	}
-	return *f.URL
+	return *b.Description
 }
 
-// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
-func (f *FileContentMetadata) GetContentType() string {
-	if f == nil || f.ContentType == nil {
+// GetDropLocation returns the DropLocation field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetDropLocation() string {
+	if b == nil || b.DropLocation == nil {
 		return ""
 	}
-	return *f.ContentType
+	return *b.DropLocation
 }
 
-// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
-func (f *FileContentMetadata) GetEncoding() int {
-	if f == nil || f.Encoding == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetID() int {
+	if b == nil || b.ID == nil {
 		return 0
 	}
-	return *f.Encoding
+	return *b.ID
 }
 
-// GetExtension returns the Extension field if it's non-nil, zero value otherwise.
-func (f *FileContentMetadata) GetExtension() string {
-	if f == nil || f.Extension == nil {
+// GetJobAuthorizationScope returns the JobAuthorizationScope field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetJobAuthorizationScope() string {
+	if b == nil || b.JobAuthorizationScope == nil {
 		return ""
 	}
-	return *f.Extension
+	return *b.JobAuthorizationScope
 }
 
-// GetFileName returns the FileName field if it's non-nil, zero value otherwise.
-func (f *FileContentMetadata) GetFileName() string {
-	if f == nil || f.FileName == nil {
-		return ""
+// GetJobCancelTimeoutInMinutes returns the JobCancelTimeoutInMinutes field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetJobCancelTimeoutInMinutes() int {
+	if b == nil || b.JobCancelTimeoutInMinutes == nil {
+		return 0
 	}
-	return *f.FileName
+	return *b.JobCancelTimeoutInMinutes
 }
 
-// GetIsBinary returns the IsBinary field if it's non-nil, zero value otherwise.
-func (f *FileContentMetadata) GetIsBinary() bool {
-	if f == nil || f.IsBinary == nil {
-		return false
+// GetJobTimeoutInMinutes returns the JobTimeoutInMinutes field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetJobTimeoutInMinutes() int {
+	if b == nil || b.JobTimeoutInMinutes == nil {
+		return 0
 	}
-	return *f.IsBinary
+	return *b.JobTimeoutInMinutes
 }
 
-// GetIsImage returns the IsImage field if it's non-nil, zero value otherwise.
-func (f *FileContentMetadata) GetIsImage() bool {
-	if f == nil || f.IsImage == nil {
-		return false
-	}
-	return *f.IsImage
+// GetLatestBuild returns the LatestBuild field.
+func (b *BuildDefinition) GetLatestBuild() *Build {
+	if b == nil {
+		return nil
+	}
+	return b.LatestBuild
 }
 
-// GetVSLink returns the VSLink field if it's non-nil, zero value otherwise.
-func (f *FileContentMetadata) GetVSLink() string {
-	if f == nil || f.VSLink == nil {
-		return ""
+// GetLatestCompletedBuild returns the LatestCompletedBuild field.
+func (b *BuildDefinition) GetLatestCompletedBuild() *Build {
+	if b == nil {
+		return nil
 	}
-	return *f.VSLink
+	return b.LatestCompletedBuild
 }
 
-// GetChangeID returns the ChangeID field if it's non-nil, zero value otherwise.
-func (g *GitChange) GetChangeID() int {
-	if g == nil || g.ChangeID == nil {
-		return 0
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetLinks() map[string]Link {
+	if b == nil || b.Links == nil {
+		return map[string]Link{}
 	}
-	return *g.ChangeID
+	return *b.Links
 }
 
-// GetChangeType returns the ChangeType field if it's non-nil, zero value otherwise.
-func (g *GitChange) GetChangeType() string {
-	if g == nil || g.ChangeType == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetName() string {
+	if b == nil || b.Name == nil {
 		return ""
 	}
-	return *g.ChangeType
+	return *b.Name
 }
 
-// GetItem returns the Item field.
-func (g *GitChange) GetItem() *GitItem {
-	if g == nil {
-		return nil
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetPath() string {
+	if b == nil || b.Path == nil {
+		return ""
 	}
-	return g.Item
+	return *b.Path
 }
 
-// GetNewContent returns the NewContent field.
-func (g *GitChange) GetNewContent() *ItemContent {
-	if g == nil {
+// GetProcess returns the Process field.
+func (b *BuildDefinition) GetProcess() *BuildProcess {
+	if b == nil {
 		return nil
 	}
-	return g.NewContent
+	return b.Process
 }
 
-// GetNewContentTemplate returns the NewContentTemplate field.
-func (g *GitChange) GetNewContentTemplate() *GitTemplate {
-	if g == nil {
+// GetProject returns the Project field.
+func (b *BuildDefinition) GetProject() *TeamProjectReference {
+	if b == nil {
 		return nil
 	}
-	return g.NewContentTemplate
+	return b.Project
 }
 
-// GetOriginalPath returns the OriginalPath field if it's non-nil, zero value otherwise.
-func (g *GitChange) GetOriginalPath() string {
-	if g == nil || g.OriginalPath == nil {
+// GetQuality returns the Quality field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetQuality() string {
+	if b == nil || b.Quality == nil {
 		return ""
 	}
-	return *g.OriginalPath
+	return *b.Quality
 }
 
-// GetSourceServerItem returns the SourceServerItem field if it's non-nil, zero value otherwise.
-func (g *GitChange) GetSourceServerItem() string {
-	if g == nil || g.SourceServerItem == nil {
-		return ""
+// GetQueue returns the Queue field.
+func (b *BuildDefinition) GetQueue() *AgentPoolQueue {
+	if b == nil {
+		return nil
 	}
-	return *g.SourceServerItem
+	return b.Queue
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GitChange) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetQueueStatus returns the QueueStatus field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetQueueStatus() string {
+	if b == nil || b.QueueStatus == nil {
 		return ""
 	}
-	return *g.URL
+	return *b.QueueStatus
 }
 
-// GetChangeCounts returns the ChangeCounts field if it's non-nil, zero value otherwise.
-func (g *GitCommitChanges) GetChangeCounts() map[string]int {
-	if g == nil || g.ChangeCounts == nil {
-		return map[string]int{}
+// GetRepository returns the Repository field.
+func (b *BuildDefinition) GetRepository() *BuildRepository {
+	if b == nil {
+		return nil
 	}
-	return *g.ChangeCounts
+	return b.Repository
 }
 
-// GetAheadCount returns the AheadCount field if it's non-nil, zero value otherwise.
-func (g *GitCommitDiffs) GetAheadCount() int {
-	if g == nil || g.AheadCount == nil {
+// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetRevision() int {
+	if b == nil || b.Revision == nil {
 		return 0
 	}
-	return *g.AheadCount
-}
-
-// GetAllChangesIncluded returns the AllChangesIncluded field if it's non-nil, zero value otherwise.
-func (g *GitCommitDiffs) GetAllChangesIncluded() bool {
-	if g == nil || g.AllChangesIncluded == nil {
-		return false
-	}
-	return *g.AllChangesIncluded
+	return *b.Revision
 }
 
-// GetBaseCommit returns the BaseCommit field if it's non-nil, zero value otherwise.
-func (g *GitCommitDiffs) GetBaseCommit() string {
-	if g == nil || g.BaseCommit == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetType() string {
+	if b == nil || b.Type == nil {
 		return ""
 	}
-	return *g.BaseCommit
-}
-
-// GetBehindCount returns the BehindCount field if it's non-nil, zero value otherwise.
-func (g *GitCommitDiffs) GetBehindCount() int {
-	if g == nil || g.BehindCount == nil {
-		return 0
-	}
-	return *g.BehindCount
-}
-
-// GetChangeCounts returns the ChangeCounts field if it's non-nil, zero value otherwise.
-func (g *GitCommitDiffs) GetChangeCounts() map[VersionControlChangeType]int {
-	if g == nil || g.ChangeCounts == nil {
-		return map[VersionControlChangeType]int{}
-	}
-	return *g.ChangeCounts
+	return *b.Type
 }
 
-// GetCommonCommit returns the CommonCommit field if it's non-nil, zero value otherwise.
-func (g *GitCommitDiffs) GetCommonCommit() string {
-	if g == nil || g.CommonCommit == nil {
+// GetURI returns the URI field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetURI() string {
+	if b == nil || b.URI == nil {
 		return ""
 	}
-	return *g.CommonCommit
+	return *b.URI
 }
 
-// GetTargetCommit returns the TargetCommit field if it's non-nil, zero value otherwise.
-func (g *GitCommitDiffs) GetTargetCommit() string {
-	if g == nil || g.TargetCommit == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (b *BuildDefinition) GetURL() string {
+	if b == nil || b.URL == nil {
 		return ""
 	}
-	return *g.TargetCommit
+	return *b.URL
 }
 
-// GetAuthor returns the Author field.
-func (g *GitCommitRef) GetAuthor() *GitUserDate {
-	if g == nil {
+// GetChangedBy returns the ChangedBy field.
+func (b *BuildDefinitionRevision) GetChangedBy() *IdentityRef {
+	if b == nil {
 		return nil
 	}
-	return g.Author
+	return b.ChangedBy
 }
 
-// GetChangeCounts returns the ChangeCounts field if it's non-nil, zero value otherwise.
-func (g *GitCommitRef) GetChangeCounts() map[string]int {
-	if g == nil || g.ChangeCounts == nil {
-		return map[string]int{}
+// GetChangedDate returns the ChangedDate field.
+func (b *BuildDefinitionRevision) GetChangedDate() *Time {
+	if b == nil {
+		return nil
 	}
-	return *g.ChangeCounts
+	return b.ChangedDate
 }
 
-// GetChanges returns the Changes field.
-func (g *GitCommitRef) GetChanges() *GitChange {
-	if g == nil {
-		return nil
+// GetChangeType returns the ChangeType field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionRevision) GetChangeType() string {
+	if b == nil || b.ChangeType == nil {
+		return ""
 	}
-	return g.Changes
+	return *b.ChangeType
 }
 
 // GetComment returns the Comment field if it's non-nil, zero value otherwise.
-func (g *GitCommitRef) GetComment() string {
-	if g == nil || g.Comment == nil {
+func (b *BuildDefinitionRevision) GetComment() string {
+	if b == nil || b.Comment == nil {
 		return ""
 	}
-	return *g.Comment
+	return *b.Comment
 }
 
-// GetCommentTruncated returns the CommentTruncated field if it's non-nil, zero value otherwise.
-func (g *GitCommitRef) GetCommentTruncated() bool {
-	if g == nil || g.CommentTruncated == nil {
-		return false
+// GetDefinitionURL returns the DefinitionURL field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionRevision) GetDefinitionURL() string {
+	if b == nil || b.DefinitionURL == nil {
+		return ""
 	}
-	return *g.CommentTruncated
+	return *b.DefinitionURL
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (g *GitCommitRef) GetCommitID() string {
-	if g == nil || g.CommitID == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionRevision) GetName() string {
+	if b == nil || b.Name == nil {
 		return ""
 	}
-	return *g.CommitID
+	return *b.Name
 }
 
-// GetCommitter returns the Committer field.
-func (g *GitCommitRef) GetCommitter() *GitUserDate {
-	if g == nil {
-		return nil
+// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionRevision) GetRevision() int {
+	if b == nil || b.Revision == nil {
+		return 0
 	}
-	return g.Committer
+	return *b.Revision
 }
 
-// GetLinks returns the Links field if it's non-nil, zero value otherwise.
-func (g *GitCommitRef) GetLinks() map[string]Link {
-	if g == nil || g.Links == nil {
-		return map[string]Link{}
+// GetIncludeAllProperties returns the IncludeAllProperties field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionsListOptions) GetIncludeAllProperties() bool {
+	if b == nil || b.IncludeAllProperties == nil {
+		return false
 	}
-	return *g.Links
+	return *b.IncludeAllProperties
 }
 
-// GetPush returns the Push field.
-func (g *GitCommitRef) GetPush() *GitPushRef {
-	if g == nil {
-		return nil
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionsListOptions) GetPath() string {
+	if b == nil || b.Path == nil {
+		return ""
 	}
-	return g.Push
+	return *b.Path
 }
 
-// GetRemoteURL returns the RemoteURL field if it's non-nil, zero value otherwise.
-func (g *GitCommitRef) GetRemoteURL() string {
-	if g == nil || g.RemoteURL == nil {
-		return ""
+// GetAlwaysRun returns the AlwaysRun field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionStep) GetAlwaysRun() bool {
+	if b == nil || b.AlwaysRun == nil {
+		return false
 	}
-	return *g.RemoteURL
+	return *b.AlwaysRun
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GitCommitRef) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetCondition returns the Condition field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionStep) GetCondition() string {
+	if b == nil || b.Condition == nil {
 		return ""
 	}
-	return *g.URL
+	return *b.Condition
 }
 
-// GetWorkItems returns the WorkItems field.
-func (g *GitCommitRef) GetWorkItems() *ResourceRef {
-	if g == nil {
-		return nil
+// GetContinueOnError returns the ContinueOnError field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionStep) GetContinueOnError() bool {
+	if b == nil || b.ContinueOnError == nil {
+		return false
 	}
-	return g.WorkItems
+	return *b.ContinueOnError
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (g *GitItem) GetCommitID() string {
-	if g == nil || g.CommitID == nil {
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionStep) GetDisplayName() string {
+	if b == nil || b.DisplayName == nil {
 		return ""
 	}
-	return *g.CommitID
+	return *b.DisplayName
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (g *GitItem) GetContent() string {
-	if g == nil || g.Content == nil {
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionStep) GetEnabled() bool {
+	if b == nil || b.Enabled == nil {
+		return false
+	}
+	return *b.Enabled
+}
+
+// GetRefName returns the RefName field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionStep) GetRefName() string {
+	if b == nil || b.RefName == nil {
 		return ""
 	}
-	return *g.Content
+	return *b.RefName
 }
 
-// GetContentMetadata returns the ContentMetadata field.
-func (g *GitItem) GetContentMetadata() *FileContentMetadata {
-	if g == nil {
+// GetTask returns the Task field.
+func (b *BuildDefinitionStep) GetTask() *TaskDefinitionReference {
+	if b == nil {
 		return nil
 	}
-	return g.ContentMetadata
+	return b.Task
 }
 
-// GetGitObjectType returns the GitObjectType field if it's non-nil, zero value otherwise.
-func (g *GitItem) GetGitObjectType() string {
-	if g == nil || g.GitObjectType == nil {
-		return ""
+// GetTimeoutInMinutes returns the TimeoutInMinutes field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionStep) GetTimeoutInMinutes() int {
+	if b == nil || b.TimeoutInMinutes == nil {
+		return 0
 	}
-	return *g.GitObjectType
+	return *b.TimeoutInMinutes
 }
 
-// GetIsFolder returns the IsFolder field if it's non-nil, zero value otherwise.
-func (g *GitItem) GetIsFolder() bool {
-	if g == nil || g.IsFolder == nil {
+// GetAllowOverride returns the AllowOverride field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionVariable) GetAllowOverride() bool {
+	if b == nil || b.AllowOverride == nil {
 		return false
 	}
-	return *g.IsFolder
+	return *b.AllowOverride
 }
 
-// GetIsSymLink returns the IsSymLink field if it's non-nil, zero value otherwise.
-func (g *GitItem) GetIsSymLink() bool {
-	if g == nil || g.IsSymLink == nil {
+// GetIsSecret returns the IsSecret field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionVariable) GetIsSecret() bool {
+	if b == nil || b.IsSecret == nil {
 		return false
 	}
-	return *g.IsSymLink
+	return *b.IsSecret
 }
 
-// GetLatestProcessedChange returns the LatestProcessedChange field.
-func (g *GitItem) GetLatestProcessedChange() *GitCommitRef {
-	if g == nil {
-		return nil
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (b *BuildDefinitionVariable) GetValue() string {
+	if b == nil || b.Value == nil {
+		return ""
 	}
-	return g.LatestProcessedChange
+	return *b.Value
 }
 
-// GetLinks returns the Links field if it's non-nil, zero value otherwise.
-func (g *GitItem) GetLinks() map[string]Link {
-	if g == nil || g.Links == nil {
-		return map[string]Link{}
-	}
-	return *g.Links
-}
-
-// GetObjectID returns the ObjectID field if it's non-nil, zero value otherwise.
-func (g *GitItem) GetObjectID() string {
-	if g == nil || g.ObjectID == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BuildDemand) GetName() string {
+	if b == nil || b.Name == nil {
 		return ""
 	}
-	return *g.ObjectID
+	return *b.Name
 }
 
-// GetOriginalObjectID returns the OriginalObjectID field if it's non-nil, zero value otherwise.
-func (g *GitItem) GetOriginalObjectID() string {
-	if g == nil || g.OriginalObjectID == nil {
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (b *BuildDemand) GetValue() string {
+	if b == nil || b.Value == nil {
 		return ""
 	}
-	return *g.OriginalObjectID
+	return *b.Value
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (g *GitItem) GetPath() string {
-	if g == nil || g.Path == nil {
-		return ""
+// GetCreatedOn returns the CreatedOn field.
+func (b *BuildLog) GetCreatedOn() *Time {
+	if b == nil {
+		return nil
 	}
-	return *g.Path
+	return b.CreatedOn
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GitItem) GetURL() string {
-	if g == nil || g.URL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BuildLog) GetID() int {
+	if b == nil || b.ID == nil {
+		return 0
 	}
-	return *g.URL
+	return *b.ID
 }
 
-// GetArtifactID returns the ArtifactID field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetArtifactID() string {
-	if g == nil || g.ArtifactID == nil {
-		return ""
+// GetLastChangedOn returns the LastChangedOn field.
+func (b *BuildLog) GetLastChangedOn() *Time {
+	if b == nil {
+		return nil
 	}
-	return *g.ArtifactID
+	return b.LastChangedOn
 }
 
-// GetAutoCompleteSetBy returns the AutoCompleteSetBy field.
-func (g *GitPullRequest) GetAutoCompleteSetBy() *IdentityRef {
-	if g == nil {
-		return nil
+// GetLineCount returns the LineCount field if it's non-nil, zero value otherwise.
+func (b *BuildLog) GetLineCount() int64 {
+	if b == nil || b.LineCount == nil {
+		return 0
 	}
-	return g.AutoCompleteSetBy
+	return *b.LineCount
 }
 
-// GetClosedBy returns the ClosedBy field.
-func (g *GitPullRequest) GetClosedBy() *IdentityRef {
-	if g == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (b *BuildLog) GetType() string {
+	if b == nil || b.Type == nil {
+		return ""
 	}
-	return g.ClosedBy
+	return *b.Type
 }
 
-// GetClosedDate returns the ClosedDate field.
-func (g *GitPullRequest) GetClosedDate() *Time {
-	if g == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (b *BuildLog) GetURL() string {
+	if b == nil || b.URL == nil {
+		return ""
 	}
-	return g.ClosedDate
+	return *b.URL
 }
 
-// GetCodeReviewID returns the CodeReviewID field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetCodeReviewID() int {
-	if g == nil || g.CodeReviewID == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BuildLogReference) GetID() int {
+	if b == nil || b.ID == nil {
 		return 0
 	}
-	return *g.CodeReviewID
+	return *b.ID
 }
 
-// GetCompletionOptions returns the CompletionOptions field.
-func (g *GitPullRequest) GetCompletionOptions() *GitPullRequestCompletionOptions {
-	if g == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (b *BuildLogReference) GetType() string {
+	if b == nil || b.Type == nil {
+		return ""
 	}
-	return g.CompletionOptions
+	return *b.Type
 }
 
-// GetCompletionQueueTime returns the CompletionQueueTime field.
-func (g *GitPullRequest) GetCompletionQueueTime() *Time {
-	if g == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (b *BuildLogReference) GetURL() string {
+	if b == nil || b.URL == nil {
+		return ""
 	}
-	return g.CompletionQueueTime
+	return *b.URL
 }
 
-// GetCreatedBy returns the CreatedBy field.
-func (g *GitPullRequest) GetCreatedBy() *IdentityRef {
-	if g == nil {
+// GetDefinition returns the Definition field.
+func (b *BuildOption) GetDefinition() *BuildOptionDefinitionReference {
+	if b == nil {
 		return nil
 	}
-	return g.CreatedBy
+	return b.Definition
 }
 
-// GetCreationDate returns the CreationDate field.
-func (g *GitPullRequest) GetCreationDate() *Time {
-	if g == nil {
-		return nil
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (b *BuildOption) GetEnabled() bool {
+	if b == nil || b.Enabled == nil {
+		return false
 	}
-	return g.CreationDate
+	return *b.Enabled
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetDescription() string {
-	if g == nil || g.Description == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BuildOptionDefinitionReference) GetID() string {
+	if b == nil || b.ID == nil {
 		return ""
 	}
-	return *g.Description
-}
-
-// GetForkSource returns the ForkSource field.
-func (g *GitPullRequest) GetForkSource() *GitRef {
-	if g == nil {
-		return nil
-	}
-	return g.ForkSource
+	return *b.ID
 }
 
-// GetIsDraft returns the IsDraft field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetIsDraft() bool {
-	if g == nil || g.IsDraft == nil {
-		return false
+// GetCondition returns the Condition field if it's non-nil, zero value otherwise.
+func (b *BuildPhase) GetCondition() string {
+	if b == nil || b.Condition == nil {
+		return ""
 	}
-	return *g.IsDraft
+	return *b.Condition
 }
 
-// GetLastMergeCommit returns the LastMergeCommit field.
-func (g *GitPullRequest) GetLastMergeCommit() *GitCommitRef {
-	if g == nil {
-		return nil
+// GetJobAuthorizationScope returns the JobAuthorizationScope field if it's non-nil, zero value otherwise.
+func (b *BuildPhase) GetJobAuthorizationScope() string {
+	if b == nil || b.JobAuthorizationScope == nil {
+		return ""
 	}
-	return g.LastMergeCommit
+	return *b.JobAuthorizationScope
 }
 
-// GetLastMergeSourceCommit returns the LastMergeSourceCommit field.
-func (g *GitPullRequest) GetLastMergeSourceCommit() *GitCommitRef {
-	if g == nil {
-		return nil
+// GetJobCancelTimeoutInMinutes returns the JobCancelTimeoutInMinutes field if it's non-nil, zero value otherwise.
+func (b *BuildPhase) GetJobCancelTimeoutInMinutes() int {
+	if b == nil || b.JobCancelTimeoutInMinutes == nil {
+		return 0
 	}
-	return g.LastMergeSourceCommit
+	return *b.JobCancelTimeoutInMinutes
 }
 
-// GetLastMergeTargetCommit returns the LastMergeTargetCommit field.
-func (g *GitPullRequest) GetLastMergeTargetCommit() *GitCommitRef {
-	if g == nil {
-		return nil
+// GetJobTimeoutInMinutes returns the JobTimeoutInMinutes field if it's non-nil, zero value otherwise.
+func (b *BuildPhase) GetJobTimeoutInMinutes() int {
+	if b == nil || b.JobTimeoutInMinutes == nil {
+		return 0
 	}
-	return g.LastMergeTargetCommit
+	return *b.JobTimeoutInMinutes
 }
 
-// GetLinks returns the Links field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetLinks() map[string]Link {
-	if g == nil || g.Links == nil {
-		return map[string]Link{}
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BuildPhase) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
 	}
-	return *g.Links
+	return *b.Name
 }
 
-// GetMergeFailureMessage returns the MergeFailureMessage field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetMergeFailureMessage() string {
-	if g == nil || g.MergeFailureMessage == nil {
+// GetRefName returns the RefName field if it's non-nil, zero value otherwise.
+func (b *BuildPhase) GetRefName() string {
+	if b == nil || b.RefName == nil {
 		return ""
 	}
-	return *g.MergeFailureMessage
+	return *b.RefName
 }
 
-// GetMergeFailureType returns the MergeFailureType field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetMergeFailureType() string {
-	if g == nil || g.MergeFailureType == nil {
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (b *BuildPhaseDependency) GetEvent() string {
+	if b == nil || b.Event == nil {
 		return ""
 	}
-	return *g.MergeFailureType
+	return *b.Event
 }
 
-// GetMergeID returns the MergeID field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetMergeID() string {
-	if g == nil || g.MergeID == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (b *BuildPhaseDependency) GetScope() string {
+	if b == nil || b.Scope == nil {
 		return ""
 	}
-	return *g.MergeID
+	return *b.Scope
 }
 
-// GetMergeOptions returns the MergeOptions field.
-func (g *GitPullRequest) GetMergeOptions() *GitPullRequestMergeOptions {
-	if g == nil {
+// GetTarget returns the Target field.
+func (b *BuildProcess) GetTarget() *BuildProcessTarget {
+	if b == nil {
 		return nil
 	}
-	return g.MergeOptions
-}
-
-// GetMergeStatus returns the MergeStatus field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetMergeStatus() string {
-	if g == nil || g.MergeStatus == nil {
-		return ""
-	}
-	return *g.MergeStatus
+	return b.Target
 }
 
-// GetPullRequestID returns the PullRequestID field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetPullRequestID() int {
-	if g == nil || g.PullRequestID == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (b *BuildProcess) GetType() int {
+	if b == nil || b.Type == nil {
 		return 0
 	}
-	return *g.PullRequestID
+	return *b.Type
 }
 
-// GetRemoteURL returns the RemoteURL field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetRemoteURL() string {
-	if g == nil || g.RemoteURL == nil {
+// GetYamlFilename returns the YamlFilename field if it's non-nil, zero value otherwise.
+func (b *BuildProcess) GetYamlFilename() string {
+	if b == nil || b.YamlFilename == nil {
 		return ""
 	}
-	return *g.RemoteURL
+	return *b.YamlFilename
 }
 
-// GetRepository returns the Repository field.
-func (g *GitPullRequest) GetRepository() *GitRepository {
-	if g == nil {
+// GetAgentSpecification returns the AgentSpecification field.
+func (b *BuildProcessTarget) GetAgentSpecification() *AgentSpecification {
+	if b == nil {
 		return nil
 	}
-	return g.Repository
+	return b.AgentSpecification
 }
 
-// GetSourceRefName returns the SourceRefName field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetSourceRefName() string {
-	if g == nil || g.SourceRefName == nil {
-		return ""
+// GetCheckoutSubmodules returns the CheckoutSubmodules field if it's non-nil, zero value otherwise.
+func (b *BuildRepository) GetCheckoutSubmodules() bool {
+	if b == nil || b.CheckoutSubmodules == nil {
+		return false
 	}
-	return *g.SourceRefName
+	return *b.CheckoutSubmodules
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetStatus() string {
-	if g == nil || g.Status == nil {
+// GetClean returns the Clean field if it's non-nil, zero value otherwise.
+func (b *BuildRepository) GetClean() string {
+	if b == nil || b.Clean == nil {
 		return ""
 	}
-	return *g.Status
+	return *b.Clean
 }
 
-// GetSupportsIterations returns the SupportsIterations field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetSupportsIterations() bool {
-	if g == nil || g.SupportsIterations == nil {
-		return false
+// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
+func (b *BuildRepository) GetDefaultBranch() string {
+	if b == nil || b.DefaultBranch == nil {
+		return ""
 	}
-	return *g.SupportsIterations
+	return *b.DefaultBranch
 }
 
-// GetTargetRefName returns the TargetRefName field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetTargetRefName() string {
-	if g == nil || g.TargetRefName == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BuildRepository) GetID() string {
+	if b == nil || b.ID == nil {
 		return ""
 	}
-	return *g.TargetRefName
+	return *b.ID
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetTitle() string {
-	if g == nil || g.Title == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BuildRepository) GetName() string {
+	if b == nil || b.Name == nil {
 		return ""
 	}
-	return *g.Title
+	return *b.Name
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GitPullRequest) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetRootFolder returns the RootFolder field if it's non-nil, zero value otherwise.
+func (b *BuildRepository) GetRootFolder() string {
+	if b == nil || b.RootFolder == nil {
 		return ""
 	}
-	return *g.URL
+	return *b.RootFolder
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (b *BuildRepository) GetType() string {
+	if b == nil || b.Type == nil {
+		return ""
+	}
+	return *b.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (b *BuildRepository) GetURL() string {
+	if b == nil || b.URL == nil {
+		return ""
+	}
+	return *b.URL
+}
+
+// GetDaysToBuild returns the DaysToBuild field.
+func (b *BuildSchedule) GetDaysToBuild() *ScheduleDays {
+	if b == nil {
+		return nil
+	}
+	return b.DaysToBuild
+}
+
+// GetScheduleJobID returns the ScheduleJobID field if it's non-nil, zero value otherwise.
+func (b *BuildSchedule) GetScheduleJobID() string {
+	if b == nil || b.ScheduleJobID == nil {
+		return ""
+	}
+	return *b.ScheduleJobID
+}
+
+// GetScheduleOnlyWithChanges returns the ScheduleOnlyWithChanges field if it's non-nil, zero value otherwise.
+func (b *BuildSchedule) GetScheduleOnlyWithChanges() bool {
+	if b == nil || b.ScheduleOnlyWithChanges == nil {
+		return false
+	}
+	return *b.ScheduleOnlyWithChanges
+}
+
+// GetStartHours returns the StartHours field if it's non-nil, zero value otherwise.
+func (b *BuildSchedule) GetStartHours() int {
+	if b == nil || b.StartHours == nil {
+		return 0
+	}
+	return *b.StartHours
+}
+
+// GetStartMinutes returns the StartMinutes field if it's non-nil, zero value otherwise.
+func (b *BuildSchedule) GetStartMinutes() int {
+	if b == nil || b.StartMinutes == nil {
+		return 0
+	}
+	return *b.StartMinutes
+}
+
+// GetTimeZoneID returns the TimeZoneID field if it's non-nil, zero value otherwise.
+func (b *BuildSchedule) GetTimeZoneID() string {
+	if b == nil || b.TimeZoneID == nil {
+		return ""
+	}
+	return *b.TimeZoneID
+}
+
+// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetBranch() string {
+	if b == nil || b.Branch == nil {
+		return ""
+	}
+	return *b.Branch
+}
+
+// GetBuildIDs returns the BuildIDs field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetBuildIDs() string {
+	if b == nil || b.BuildIDs == nil {
+		return ""
+	}
+	return *b.BuildIDs
+}
+
+// GetBuildNumber returns the BuildNumber field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetBuildNumber() string {
+	if b == nil || b.BuildNumber == nil {
+		return ""
+	}
+	return *b.BuildNumber
+}
+
+// GetCount returns the Count field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetCount() int {
+	if b == nil || b.Count == nil {
+		return 0
+	}
+	return *b.Count
+}
+
+// GetDefinitions returns the Definitions field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetDefinitions() string {
+	if b == nil || b.Definitions == nil {
+		return ""
+	}
+	return *b.Definitions
+}
+
+// GetDeleted returns the Deleted field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetDeleted() string {
+	if b == nil || b.Deleted == nil {
+		return ""
+	}
+	return *b.Deleted
+}
+
+// GetMaxPerDefinition returns the MaxPerDefinition field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetMaxPerDefinition() string {
+	if b == nil || b.MaxPerDefinition == nil {
+		return ""
+	}
+	return *b.MaxPerDefinition
+}
+
+// GetMaxTime returns the MaxTime field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetMaxTime() string {
+	if b == nil || b.MaxTime == nil {
+		return ""
+	}
+	return *b.MaxTime
+}
+
+// GetMinTime returns the MinTime field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetMinTime() string {
+	if b == nil || b.MinTime == nil {
+		return ""
+	}
+	return *b.MinTime
+}
+
+// GetOrder returns the Order field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetOrder() string {
+	if b == nil || b.Order == nil {
+		return ""
+	}
+	return *b.Order
+}
+
+// GetProps returns the Props field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetProps() string {
+	if b == nil || b.Props == nil {
+		return ""
+	}
+	return *b.Props
+}
+
+// GetQueues returns the Queues field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetQueues() string {
+	if b == nil || b.Queues == nil {
+		return ""
+	}
+	return *b.Queues
+}
+
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetReason() string {
+	if b == nil || b.Reason == nil {
+		return ""
+	}
+	return *b.Reason
+}
+
+// GetRepository returns the Repository field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetRepository() string {
+	if b == nil || b.Repository == nil {
+		return ""
+	}
+	return *b.Repository
+}
+
+// GetRepoType returns the RepoType field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetRepoType() string {
+	if b == nil || b.RepoType == nil {
+		return ""
+	}
+	return *b.RepoType
+}
+
+// GetResult returns the Result field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetResult() string {
+	if b == nil || b.Result == nil {
+		return ""
+	}
+	return *b.Result
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetStatus() string {
+	if b == nil || b.Status == nil {
+		return ""
+	}
+	return *b.Status
+}
+
+// GetTags returns the Tags field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetTags() string {
+	if b == nil || b.Tags == nil {
+		return ""
+	}
+	return *b.Tags
+}
+
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetToken() string {
+	if b == nil || b.Token == nil {
+		return ""
+	}
+	return *b.Token
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (b *BuildsListOptions) GetUserID() string {
+	if b == nil || b.UserID == nil {
+		return ""
+	}
+	return *b.UserID
+}
+
+// GetAutoCancel returns the AutoCancel field if it's non-nil, zero value otherwise.
+func (b *BuildTrigger) GetAutoCancel() bool {
+	if b == nil || b.AutoCancel == nil {
+		return false
+	}
+	return *b.AutoCancel
+}
+
+// GetBatchChanges returns the BatchChanges field if it's non-nil, zero value otherwise.
+func (b *BuildTrigger) GetBatchChanges() bool {
+	if b == nil || b.BatchChanges == nil {
+		return false
+	}
+	return *b.BatchChanges
+}
+
+// GetDefinition returns the Definition field.
+func (b *BuildTrigger) GetDefinition() *BuildDefinition {
+	if b == nil {
+		return nil
+	}
+	return b.Definition
+}
+
+// GetForks returns the Forks field.
+func (b *BuildTrigger) GetForks() *Forks {
+	if b == nil {
+		return nil
+	}
+	return b.Forks
+}
+
+// GetIsCommentRequiredForPullRequest returns the IsCommentRequiredForPullRequest field if it's non-nil, zero value otherwise.
+func (b *BuildTrigger) GetIsCommentRequiredForPullRequest() bool {
+	if b == nil || b.IsCommentRequiredForPullRequest == nil {
+		return false
+	}
+	return *b.IsCommentRequiredForPullRequest
+}
+
+// GetMaxConcurrentBuildsPerBranch returns the MaxConcurrentBuildsPerBranch field if it's non-nil, zero value otherwise.
+func (b *BuildTrigger) GetMaxConcurrentBuildsPerBranch() int {
+	if b == nil || b.MaxConcurrentBuildsPerBranch == nil {
+		return 0
+	}
+	return *b.MaxConcurrentBuildsPerBranch
+}
+
+// GetPollingInterval returns the PollingInterval field if it's non-nil, zero value otherwise.
+func (b *BuildTrigger) GetPollingInterval() int {
+	if b == nil || b.PollingInterval == nil {
+		return 0
+	}
+	return *b.PollingInterval
+}
+
+// GetPollingJobID returns the PollingJobID field if it's non-nil, zero value otherwise.
+func (b *BuildTrigger) GetPollingJobID() string {
+	if b == nil || b.PollingJobID == nil {
+		return ""
+	}
+	return *b.PollingJobID
+}
+
+// GetRequireCommentsForNonTeamMembersOnly returns the RequireCommentsForNonTeamMembersOnly field if it's non-nil, zero value otherwise.
+func (b *BuildTrigger) GetRequireCommentsForNonTeamMembersOnly() bool {
+	if b == nil || b.RequireCommentsForNonTeamMembersOnly == nil {
+		return false
+	}
+	return *b.RequireCommentsForNonTeamMembersOnly
+}
+
+// GetRequiresSuccessfulBuild returns the RequiresSuccessfulBuild field if it's non-nil, zero value otherwise.
+func (b *BuildTrigger) GetRequiresSuccessfulBuild() bool {
+	if b == nil || b.RequiresSuccessfulBuild == nil {
+		return false
+	}
+	return *b.RequiresSuccessfulBuild
+}
+
+// GetSettingsSourceType returns the SettingsSourceType field if it's non-nil, zero value otherwise.
+func (b *BuildTrigger) GetSettingsSourceType() int {
+	if b == nil || b.SettingsSourceType == nil {
+		return 0
+	}
+	return *b.SettingsSourceType
+}
+
+// GetTriggerType returns the TriggerType field.
+func (b *BuildTrigger) GetTriggerType() *DefinitionTriggerType {
+	if b == nil {
+		return nil
+	}
+	return b.TriggerType
+}
+
+// GetCommitMessage returns the CommitMessage field if it's non-nil, zero value otherwise.
+func (b *BuildVersion) GetCommitMessage() string {
+	if b == nil || b.CommitMessage == nil {
+		return ""
+	}
+	return *b.CommitMessage
+}
+
+// GetDefinitionID returns the DefinitionID field if it's non-nil, zero value otherwise.
+func (b *BuildVersion) GetDefinitionID() string {
+	if b == nil || b.DefinitionID == nil {
+		return ""
+	}
+	return *b.DefinitionID
+}
+
+// GetDefinitionName returns the DefinitionName field if it's non-nil, zero value otherwise.
+func (b *BuildVersion) GetDefinitionName() string {
+	if b == nil || b.DefinitionName == nil {
+		return ""
+	}
+	return *b.DefinitionName
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (b *BuildVersion) GetID() string {
+	if b == nil || b.ID == nil {
+		return ""
+	}
+	return *b.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BuildVersion) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
+	}
+	return *b.Name
+}
+
+// GetSourceBranch returns the SourceBranch field if it's non-nil, zero value otherwise.
+func (b *BuildVersion) GetSourceBranch() string {
+	if b == nil || b.SourceBranch == nil {
+		return ""
+	}
+	return *b.SourceBranch
+}
+
+// GetSourceRepositoryID returns the SourceRepositoryID field if it's non-nil, zero value otherwise.
+func (b *BuildVersion) GetSourceRepositoryID() string {
+	if b == nil || b.SourceRepositoryID == nil {
+		return ""
+	}
+	return *b.SourceRepositoryID
+}
+
+// GetSourceRepositoryType returns the SourceRepositoryType field if it's non-nil, zero value otherwise.
+func (b *BuildVersion) GetSourceRepositoryType() string {
+	if b == nil || b.SourceRepositoryType == nil {
+		return ""
+	}
+	return *b.SourceRepositoryType
+}
+
+// GetSourceVersion returns the SourceVersion field if it's non-nil, zero value otherwise.
+func (b *BuildVersion) GetSourceVersion() string {
+	if b == nil || b.SourceVersion == nil {
+		return ""
+	}
+	return *b.SourceVersion
+}
+
+// GetBuild returns the Build field.
+func (b *BuildWaitResult) GetBuild() *Build {
+	if b == nil {
+		return nil
+	}
+	return b.Build
+}
+
+// GetCreatedBy returns the CreatedBy field.
+func (c *CheckConfiguration) GetCreatedBy() *IdentityRef {
+	if c == nil {
+		return nil
+	}
+	return c.CreatedBy
+}
+
+// GetCreatedOn returns the CreatedOn field.
+func (c *CheckConfiguration) GetCreatedOn() *Time {
+	if c == nil {
+		return nil
+	}
+	return c.CreatedOn
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CheckConfiguration) GetID() int {
+	if c == nil || c.ID == nil {
+		return 0
+	}
+	return *c.ID
+}
+
+// GetIsDeleted returns the IsDeleted field if it's non-nil, zero value otherwise.
+func (c *CheckConfiguration) GetIsDeleted() bool {
+	if c == nil || c.IsDeleted == nil {
+		return false
+	}
+	return *c.IsDeleted
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (c *CheckConfiguration) GetLinks() map[string]Link {
+	if c == nil || c.Links == nil {
+		return map[string]Link{}
+	}
+	return *c.Links
+}
+
+// GetModifiedBy returns the ModifiedBy field.
+func (c *CheckConfiguration) GetModifiedBy() *IdentityRef {
+	if c == nil {
+		return nil
+	}
+	return c.ModifiedBy
+}
+
+// GetModifiedOn returns the ModifiedOn field.
+func (c *CheckConfiguration) GetModifiedOn() *Time {
+	if c == nil {
+		return nil
+	}
+	return c.ModifiedOn
+}
+
+// GetResource returns the Resource field.
+func (c *CheckConfiguration) GetResource() *CheckResource {
+	if c == nil {
+		return nil
+	}
+	return c.Resource
+}
+
+// GetTimeout returns the Timeout field if it's non-nil, zero value otherwise.
+func (c *CheckConfiguration) GetTimeout() int {
+	if c == nil || c.Timeout == nil {
+		return 0
+	}
+	return *c.Timeout
+}
+
+// GetType returns the Type field.
+func (c *CheckConfiguration) GetType() *CheckType {
+	if c == nil {
+		return nil
+	}
+	return c.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *CheckConfiguration) GetURL() string {
+	if c == nil || c.URL == nil {
+		return ""
+	}
+	return *c.URL
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (c *CheckConfiguration) GetVersion() int {
+	if c == nil || c.Version == nil {
+		return 0
+	}
+	return *c.Version
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CheckResource) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CheckResource) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (c *CheckResource) GetType() string {
+	if c == nil || c.Type == nil {
+		return ""
+	}
+	return *c.Type
+}
+
+// GetCheckConfigurationRef returns the CheckConfigurationRef field.
+func (c *CheckRun) GetCheckConfigurationRef() *CheckConfiguration {
+	if c == nil {
+		return nil
+	}
+	return c.CheckConfigurationRef
+}
+
+// GetCompletedDate returns the CompletedDate field.
+func (c *CheckRun) GetCompletedDate() *Time {
+	if c == nil {
+		return nil
+	}
+	return c.CompletedDate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetResultMessage returns the ResultMessage field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetResultMessage() string {
+	if c == nil || c.ResultMessage == nil {
+		return ""
+	}
+	return *c.ResultMessage
+}
+
+// GetStatus returns the Status field.
+func (c *CheckRun) GetStatus() *CheckRunStatus {
+	if c == nil {
+		return nil
+	}
+	return c.Status
+}
+
+// GetCompletedDate returns the CompletedDate field.
+func (c *CheckSuite) GetCompletedDate() *Time {
+	if c == nil {
+		return nil
+	}
+	return c.CompletedDate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CheckSuite) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (c *CheckSuite) GetLinks() map[string]Link {
+	if c == nil || c.Links == nil {
+		return map[string]Link{}
+	}
+	return *c.Links
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (c *CheckSuite) GetMessage() string {
+	if c == nil || c.Message == nil {
+		return ""
+	}
+	return *c.Message
+}
+
+// GetStatus returns the Status field.
+func (c *CheckSuite) GetStatus() *CheckRunStatus {
+	if c == nil {
+		return nil
+	}
+	return c.Status
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CheckType) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CheckType) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetAuthor returns the Author field.
+func (c *Comment) GetAuthor() *IdentityRef {
+	if c == nil {
+		return nil
+	}
+	return c.Author
+}
+
+// GetCommentType returns the CommentType field if it's non-nil, zero value otherwise.
+func (c *Comment) GetCommentType() string {
+	if c == nil || c.CommentType == nil {
+		return ""
+	}
+	return *c.CommentType
+}
+
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (c *Comment) GetContent() string {
+	if c == nil || c.Content == nil {
+		return ""
+	}
+	return *c.Content
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *Comment) GetID() int {
+	if c == nil || c.ID == nil {
+		return 0
+	}
+	return *c.ID
+}
+
+// GetIsDeleted returns the IsDeleted field if it's non-nil, zero value otherwise.
+func (c *Comment) GetIsDeleted() bool {
+	if c == nil || c.IsDeleted == nil {
+		return false
+	}
+	return *c.IsDeleted
+}
+
+// GetLastContentUpdatedDate returns the LastContentUpdatedDate field.
+func (c *Comment) GetLastContentUpdatedDate() *Time {
+	if c == nil {
+		return nil
+	}
+	return c.LastContentUpdatedDate
+}
+
+// GetLastUpdatedDate returns the LastUpdatedDate field.
+func (c *Comment) GetLastUpdatedDate() *Time {
+	if c == nil {
+		return nil
+	}
+	return c.LastUpdatedDate
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (c *Comment) GetLinks() map[string]Link {
+	if c == nil || c.Links == nil {
+		return map[string]Link{}
+	}
+	return *c.Links
+}
+
+// GetParentCommentID returns the ParentCommentID field if it's non-nil, zero value otherwise.
+func (c *Comment) GetParentCommentID() int {
+	if c == nil || c.ParentCommentID == nil {
+		return 0
+	}
+	return *c.ParentCommentID
+}
+
+// GetPublishedDate returns the PublishedDate field.
+func (c *Comment) GetPublishedDate() *Time {
+	if c == nil {
+		return nil
+	}
+	return c.PublishedDate
+}
+
+// GetFirstComparingIteration returns the FirstComparingIteration field if it's non-nil, zero value otherwise.
+func (c *CommentIterationContext) GetFirstComparingIteration() int {
+	if c == nil || c.FirstComparingIteration == nil {
+		return 0
+	}
+	return *c.FirstComparingIteration
+}
+
+// GetSecondComparingIteration returns the SecondComparingIteration field if it's non-nil, zero value otherwise.
+func (c *CommentIterationContext) GetSecondComparingIteration() int {
+	if c == nil || c.SecondComparingIteration == nil {
+		return 0
+	}
+	return *c.SecondComparingIteration
+}
+
+// GetLine returns the Line field if it's non-nil, zero value otherwise.
+func (c *CommentPosition) GetLine() int {
+	if c == nil || c.Line == nil {
+		return 0
+	}
+	return *c.Line
+}
+
+// GetOffset returns the Offset field if it's non-nil, zero value otherwise.
+func (c *CommentPosition) GetOffset() int {
+	if c == nil || c.Offset == nil {
+		return 0
+	}
+	return *c.Offset
+}
+
+// GetFirstComparingIteration returns the FirstComparingIteration field if it's non-nil, zero value otherwise.
+func (c *CommentTrackingCriteria) GetFirstComparingIteration() int {
+	if c == nil || c.FirstComparingIteration == nil {
+		return 0
+	}
+	return *c.FirstComparingIteration
+}
+
+// GetOrigFilePath returns the OrigFilePath field if it's non-nil, zero value otherwise.
+func (c *CommentTrackingCriteria) GetOrigFilePath() string {
+	if c == nil || c.OrigFilePath == nil {
+		return ""
+	}
+	return *c.OrigFilePath
+}
+
+// GetOrigLeftFileEnd returns the OrigLeftFileEnd field.
+func (c *CommentTrackingCriteria) GetOrigLeftFileEnd() *CommentPosition {
+	if c == nil {
+		return nil
+	}
+	return c.OrigLeftFileEnd
+}
+
+// GetOrigLeftFileStart returns the OrigLeftFileStart field.
+func (c *CommentTrackingCriteria) GetOrigLeftFileStart() *CommentPosition {
+	if c == nil {
+		return nil
+	}
+	return c.OrigLeftFileStart
+}
+
+// GetOrigRightFileEnd returns the OrigRightFileEnd field.
+func (c *CommentTrackingCriteria) GetOrigRightFileEnd() *CommentPosition {
+	if c == nil {
+		return nil
+	}
+	return c.OrigRightFileEnd
+}
+
+// GetOrigRightFileStart returns the OrigRightFileStart field.
+func (c *CommentTrackingCriteria) GetOrigRightFileStart() *CommentPosition {
+	if c == nil {
+		return nil
+	}
+	return c.OrigRightFileStart
+}
+
+// GetSecondComparingIteration returns the SecondComparingIteration field if it's non-nil, zero value otherwise.
+func (c *CommentTrackingCriteria) GetSecondComparingIteration() int {
+	if c == nil || c.SecondComparingIteration == nil {
+		return 0
+	}
+	return *c.SecondComparingIteration
+}
+
+// GetCommentID returns the CommentID field if it's non-nil, zero value otherwise.
+func (c *CommentVersionRef) GetCommentID() int {
+	if c == nil || c.CommentID == nil {
+		return 0
+	}
+	return *c.CommentID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *CommentVersionRef) GetURL() string {
+	if c == nil || c.URL == nil {
+		return ""
+	}
+	return *c.URL
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (c *CommentVersionRef) GetVersion() int {
+	if c == nil || c.Version == nil {
+		return 0
+	}
+	return *c.Version
+}
+
+// GetAllowOverride returns the AllowOverride field if it's non-nil, zero value otherwise.
+func (c *ConfigurationVariableValue) GetAllowOverride() bool {
+	if c == nil || c.AllowOverride == nil {
+		return false
+	}
+	return *c.AllowOverride
+}
+
+// GetIsSecret returns the IsSecret field if it's non-nil, zero value otherwise.
+func (c *ConfigurationVariableValue) GetIsSecret() bool {
+	if c == nil || c.IsSecret == nil {
+		return false
+	}
+	return *c.IsSecret
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (c *ConfigurationVariableValue) GetValue() string {
+	if c == nil || c.Value == nil {
+		return ""
+	}
+	return *c.Value
+}
+
+// GetCreated returns the Created field if it's non-nil, zero value otherwise.
+func (d *DeliveryPlan) GetCreated() string {
+	if d == nil || d.Created == nil {
+		return ""
+	}
+	return *d.Created
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DeliveryPlan) GetID() string {
+	if d == nil || d.ID == nil {
+		return ""
+	}
+	return *d.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DeliveryPlan) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
+	}
+	return *d.Name
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (d *DeliveryPlan) GetType() string {
+	if d == nil || d.Type == nil {
+		return ""
+	}
+	return *d.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DeliveryPlan) GetURL() string {
+	if d == nil || d.URL == nil {
+		return ""
+	}
+	return *d.URL
+}
+
+// GetEndDate returns the EndDate field if it's non-nil, zero value otherwise.
+func (d *DeliveryPlanTimeLine) GetEndDate() string {
+	if d == nil || d.EndDate == nil {
+		return ""
+	}
+	return *d.EndDate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DeliveryPlanTimeLine) GetID() string {
+	if d == nil || d.ID == nil {
+		return ""
+	}
+	return *d.ID
+}
+
+// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
+func (d *DeliveryPlanTimeLine) GetRevision() int {
+	if d == nil || d.Revision == nil {
+		return 0
+	}
+	return *d.Revision
+}
+
+// GetStartDate returns the StartDate field if it's non-nil, zero value otherwise.
+func (d *DeliveryPlanTimeLine) GetStartDate() string {
+	if d == nil || d.StartDate == nil {
+		return ""
+	}
+	return *d.StartDate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DeliveryTeam) GetID() string {
+	if d == nil || d.ID == nil {
+		return ""
+	}
+	return *d.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DeliveryTeam) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
+	}
+	return *d.Name
+}
+
+// GetAttempt returns the Attempt field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetAttempt() int {
+	if d == nil || d.Attempt == nil {
+		return 0
+	}
+	return *d.Attempt
+}
+
+// GetCompletedOn returns the CompletedOn field.
+func (d *Deployment) GetCompletedOn() *Time {
+	if d == nil {
+		return nil
+	}
+	return d.CompletedOn
+}
+
+// GetDefinitionEnvironmentID returns the DefinitionEnvironmentID field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetDefinitionEnvironmentID() int {
+	if d == nil || d.DefinitionEnvironmentID == nil {
+		return 0
+	}
+	return *d.DefinitionEnvironmentID
+}
+
+// GetDeploymentStatus returns the DeploymentStatus field.
+func (d *Deployment) GetDeploymentStatus() *DeploymentStatus {
+	if d == nil {
+		return nil
+	}
+	return d.DeploymentStatus
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetID() int {
+	if d == nil || d.ID == nil {
+		return 0
+	}
+	return *d.ID
+}
+
+// GetLastModifiedBy returns the LastModifiedBy field.
+func (d *Deployment) GetLastModifiedBy() *IdentityRef {
+	if d == nil {
+		return nil
+	}
+	return d.LastModifiedBy
+}
+
+// GetLastModifiedOn returns the LastModifiedOn field.
+func (d *Deployment) GetLastModifiedOn() *Time {
+	if d == nil {
+		return nil
+	}
+	return d.LastModifiedOn
+}
+
+// GetOperationStatus returns the OperationStatus field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetOperationStatus() string {
+	if d == nil || d.OperationStatus == nil {
+		return ""
+	}
+	return *d.OperationStatus
+}
+
+// GetQueuedOn returns the QueuedOn field.
+func (d *Deployment) GetQueuedOn() *Time {
+	if d == nil {
+		return nil
+	}
+	return d.QueuedOn
+}
+
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetReason() string {
+	if d == nil || d.Reason == nil {
+		return ""
+	}
+	return *d.Reason
+}
+
+// GetRelease returns the Release field.
+func (d *Deployment) GetRelease() *ReleaseShallowReference {
+	if d == nil {
+		return nil
+	}
+	return d.Release
+}
+
+// GetReleaseDefinition returns the ReleaseDefinition field.
+func (d *Deployment) GetReleaseDefinition() *ReleaseShallowReference {
+	if d == nil {
+		return nil
+	}
+	return d.ReleaseDefinition
+}
+
+// GetReleaseEnvironment returns the ReleaseEnvironment field.
+func (d *Deployment) GetReleaseEnvironment() *ReleaseShallowReference {
+	if d == nil {
+		return nil
+	}
+	return d.ReleaseEnvironment
+}
+
+// GetRequestedBy returns the RequestedBy field.
+func (d *Deployment) GetRequestedBy() *IdentityRef {
+	if d == nil {
+		return nil
+	}
+	return d.RequestedBy
+}
+
+// GetRequestedFor returns the RequestedFor field.
+func (d *Deployment) GetRequestedFor() *IdentityRef {
+	if d == nil {
+		return nil
+	}
+	return d.RequestedFor
+}
+
+// GetScheduledDeploymentTime returns the ScheduledDeploymentTime field.
+func (d *Deployment) GetScheduledDeploymentTime() *Time {
+	if d == nil {
+		return nil
+	}
+	return d.ScheduledDeploymentTime
+}
+
+// GetStartedOn returns the StartedOn field.
+func (d *Deployment) GetStartedOn() *Time {
+	if d == nil {
+		return nil
+	}
+	return d.StartedOn
+}
+
+// GetConcurrencyCount returns the ConcurrencyCount field if it's non-nil, zero value otherwise.
+func (e *EnvironmentExecutionPolicy) GetConcurrencyCount() int {
+	if e == nil || e.ConcurrencyCount == nil {
+		return 0
+	}
+	return *e.ConcurrencyCount
+}
+
+// GetQueueDepthCount returns the QueueDepthCount field if it's non-nil, zero value otherwise.
+func (e *EnvironmentExecutionPolicy) GetQueueDepthCount() int {
+	if e == nil || e.QueueDepthCount == nil {
+		return 0
+	}
+	return *e.QueueDepthCount
+}
+
+// GetDaysToKeep returns the DaysToKeep field if it's non-nil, zero value otherwise.
+func (e *EnvironmentRetentionPolicy) GetDaysToKeep() int {
+	if e == nil || e.DaysToKeep == nil {
+		return 0
+	}
+	return *e.DaysToKeep
+}
+
+// GetReleasesToKeep returns the ReleasesToKeep field if it's non-nil, zero value otherwise.
+func (e *EnvironmentRetentionPolicy) GetReleasesToKeep() int {
+	if e == nil || e.ReleasesToKeep == nil {
+		return 0
+	}
+	return *e.ReleasesToKeep
+}
+
+// GetRetainBuild returns the RetainBuild field if it's non-nil, zero value otherwise.
+func (e *EnvironmentRetentionPolicy) GetRetainBuild() bool {
+	if e == nil || e.RetainBuild == nil {
+		return false
+	}
+	return *e.RetainBuild
+}
+
+// GetArtifactID returns the ArtifactID field if it's non-nil, zero value otherwise.
+func (f *Favourite) GetArtifactID() string {
+	if f == nil || f.ArtifactID == nil {
+		return ""
+	}
+	return *f.ArtifactID
+}
+
+// GetArtifactName returns the ArtifactName field if it's non-nil, zero value otherwise.
+func (f *Favourite) GetArtifactName() string {
+	if f == nil || f.ArtifactName == nil {
+		return ""
+	}
+	return *f.ArtifactName
+}
+
+// GetArtifactType returns the ArtifactType field if it's non-nil, zero value otherwise.
+func (f *Favourite) GetArtifactType() string {
+	if f == nil || f.ArtifactType == nil {
+		return ""
+	}
+	return *f.ArtifactType
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (f *Favourite) GetID() string {
+	if f == nil || f.ID == nil {
+		return ""
+	}
+	return *f.ID
+}
+
+// GetReferenceName returns the ReferenceName field if it's non-nil, zero value otherwise.
+func (f *FieldReference) GetReferenceName() string {
+	if f == nil || f.ReferenceName == nil {
+		return ""
+	}
+	return *f.ReferenceName
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (f *FieldReference) GetURL() string {
+	if f == nil || f.URL == nil {
+		return ""
+	}
+	return *f.URL
+}
+
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (f *FileContentMetadata) GetContentType() string {
+	if f == nil || f.ContentType == nil {
+		return ""
+	}
+	return *f.ContentType
+}
+
+// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
+func (f *FileContentMetadata) GetEncoding() int {
+	if f == nil || f.Encoding == nil {
+		return 0
+	}
+	return *f.Encoding
+}
+
+// GetExtension returns the Extension field if it's non-nil, zero value otherwise.
+func (f *FileContentMetadata) GetExtension() string {
+	if f == nil || f.Extension == nil {
+		return ""
+	}
+	return *f.Extension
+}
+
+// GetFileName returns the FileName field if it's non-nil, zero value otherwise.
+func (f *FileContentMetadata) GetFileName() string {
+	if f == nil || f.FileName == nil {
+		return ""
+	}
+	return *f.FileName
+}
+
+// GetIsBinary returns the IsBinary field if it's non-nil, zero value otherwise.
+func (f *FileContentMetadata) GetIsBinary() bool {
+	if f == nil || f.IsBinary == nil {
+		return false
+	}
+	return *f.IsBinary
+}
+
+// GetIsImage returns the IsImage field if it's non-nil, zero value otherwise.
+func (f *FileContentMetadata) GetIsImage() bool {
+	if f == nil || f.IsImage == nil {
+		return false
+	}
+	return *f.IsImage
+}
+
+// GetVSLink returns the VSLink field if it's non-nil, zero value otherwise.
+func (f *FileContentMetadata) GetVSLink() string {
+	if f == nil || f.VSLink == nil {
+		return ""
+	}
+	return *f.VSLink
+}
+
+// GetOriginalPath returns the OriginalPath field if it's non-nil, zero value otherwise.
+func (f *FileDiff) GetOriginalPath() string {
+	if f == nil || f.OriginalPath == nil {
+		return ""
+	}
+	return *f.OriginalPath
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (f *FileDiff) GetPath() string {
+	if f == nil || f.Path == nil {
+		return ""
+	}
+	return *f.Path
+}
+
+// GetOriginalPath returns the OriginalPath field if it's non-nil, zero value otherwise.
+func (f *FileDiffParams) GetOriginalPath() string {
+	if f == nil || f.OriginalPath == nil {
+		return ""
+	}
+	return *f.OriginalPath
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (f *FileDiffParams) GetPath() string {
+	if f == nil || f.Path == nil {
+		return ""
+	}
+	return *f.Path
+}
+
+// GetBaseVersionCommit returns the BaseVersionCommit field if it's non-nil, zero value otherwise.
+func (f *FileDiffsCriteria) GetBaseVersionCommit() string {
+	if f == nil || f.BaseVersionCommit == nil {
+		return ""
+	}
+	return *f.BaseVersionCommit
+}
+
+// GetTargetVersionCommit returns the TargetVersionCommit field if it's non-nil, zero value otherwise.
+func (f *FileDiffsCriteria) GetTargetVersionCommit() string {
+	if f == nil || f.TargetVersionCommit == nil {
+		return ""
+	}
+	return *f.TargetVersionCommit
+}
+
+// GetAllowSecrets returns the AllowSecrets field if it's non-nil, zero value otherwise.
+func (f *Forks) GetAllowSecrets() bool {
+	if f == nil || f.AllowSecrets == nil {
+		return false
+	}
+	return *f.AllowSecrets
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (f *Forks) GetEnabled() bool {
+	if f == nil || f.Enabled == nil {
+		return false
+	}
+	return *f.Enabled
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (g *GitBlobRef) GetLinks() map[string]Link {
+	if g == nil || g.Links == nil {
+		return map[string]Link{}
+	}
+	return *g.Links
+}
+
+// GetObjectID returns the ObjectID field if it's non-nil, zero value otherwise.
+func (g *GitBlobRef) GetObjectID() string {
+	if g == nil || g.ObjectID == nil {
+		return ""
+	}
+	return *g.ObjectID
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (g *GitBlobRef) GetSize() int64 {
+	if g == nil || g.Size == nil {
+		return 0
+	}
+	return *g.Size
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitBlobRef) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetChangeID returns the ChangeID field if it's non-nil, zero value otherwise.
+func (g *GitChange) GetChangeID() int {
+	if g == nil || g.ChangeID == nil {
+		return 0
+	}
+	return *g.ChangeID
+}
+
+// GetChangeType returns the ChangeType field if it's non-nil, zero value otherwise.
+func (g *GitChange) GetChangeType() string {
+	if g == nil || g.ChangeType == nil {
+		return ""
+	}
+	return *g.ChangeType
+}
+
+// GetItem returns the Item field.
+func (g *GitChange) GetItem() *GitItem {
+	if g == nil {
+		return nil
+	}
+	return g.Item
+}
+
+// GetNewContent returns the NewContent field.
+func (g *GitChange) GetNewContent() *ItemContent {
+	if g == nil {
+		return nil
+	}
+	return g.NewContent
+}
+
+// GetNewContentTemplate returns the NewContentTemplate field.
+func (g *GitChange) GetNewContentTemplate() *GitTemplate {
+	if g == nil {
+		return nil
+	}
+	return g.NewContentTemplate
+}
+
+// GetOriginalPath returns the OriginalPath field if it's non-nil, zero value otherwise.
+func (g *GitChange) GetOriginalPath() string {
+	if g == nil || g.OriginalPath == nil {
+		return ""
+	}
+	return *g.OriginalPath
+}
+
+// GetSourceServerItem returns the SourceServerItem field if it's non-nil, zero value otherwise.
+func (g *GitChange) GetSourceServerItem() string {
+	if g == nil || g.SourceServerItem == nil {
+		return ""
+	}
+	return *g.SourceServerItem
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitChange) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetChangeCounts returns the ChangeCounts field if it's non-nil, zero value otherwise.
+func (g *GitCommitChanges) GetChangeCounts() map[string]int {
+	if g == nil || g.ChangeCounts == nil {
+		return map[string]int{}
+	}
+	return *g.ChangeCounts
+}
+
+// GetAheadCount returns the AheadCount field if it's non-nil, zero value otherwise.
+func (g *GitCommitDiffs) GetAheadCount() int {
+	if g == nil || g.AheadCount == nil {
+		return 0
+	}
+	return *g.AheadCount
+}
+
+// GetAllChangesIncluded returns the AllChangesIncluded field if it's non-nil, zero value otherwise.
+func (g *GitCommitDiffs) GetAllChangesIncluded() bool {
+	if g == nil || g.AllChangesIncluded == nil {
+		return false
+	}
+	return *g.AllChangesIncluded
+}
+
+// GetBaseCommit returns the BaseCommit field if it's non-nil, zero value otherwise.
+func (g *GitCommitDiffs) GetBaseCommit() string {
+	if g == nil || g.BaseCommit == nil {
+		return ""
+	}
+	return *g.BaseCommit
+}
+
+// GetBehindCount returns the BehindCount field if it's non-nil, zero value otherwise.
+func (g *GitCommitDiffs) GetBehindCount() int {
+	if g == nil || g.BehindCount == nil {
+		return 0
+	}
+	return *g.BehindCount
+}
+
+// GetChangeCounts returns the ChangeCounts field if it's non-nil, zero value otherwise.
+func (g *GitCommitDiffs) GetChangeCounts() map[VersionControlChangeType]int {
+	if g == nil || g.ChangeCounts == nil {
+		return map[VersionControlChangeType]int{}
+	}
+	return *g.ChangeCounts
+}
+
+// GetCommonCommit returns the CommonCommit field if it's non-nil, zero value otherwise.
+func (g *GitCommitDiffs) GetCommonCommit() string {
+	if g == nil || g.CommonCommit == nil {
+		return ""
+	}
+	return *g.CommonCommit
+}
+
+// GetTargetCommit returns the TargetCommit field if it's non-nil, zero value otherwise.
+func (g *GitCommitDiffs) GetTargetCommit() string {
+	if g == nil || g.TargetCommit == nil {
+		return ""
+	}
+	return *g.TargetCommit
+}
+
+// GetAuthor returns the Author field.
+func (g *GitCommitRef) GetAuthor() *GitUserDate {
+	if g == nil {
+		return nil
+	}
+	return g.Author
+}
+
+// GetChangeCounts returns the ChangeCounts field if it's non-nil, zero value otherwise.
+func (g *GitCommitRef) GetChangeCounts() map[string]int {
+	if g == nil || g.ChangeCounts == nil {
+		return map[string]int{}
+	}
+	return *g.ChangeCounts
+}
+
+// GetChanges returns the Changes field.
+func (g *GitCommitRef) GetChanges() *GitChange {
+	if g == nil {
+		return nil
+	}
+	return g.Changes
+}
+
+// GetComment returns the Comment field if it's non-nil, zero value otherwise.
+func (g *GitCommitRef) GetComment() string {
+	if g == nil || g.Comment == nil {
+		return ""
+	}
+	return *g.Comment
+}
+
+// GetCommentTruncated returns the CommentTruncated field if it's non-nil, zero value otherwise.
+func (g *GitCommitRef) GetCommentTruncated() bool {
+	if g == nil || g.CommentTruncated == nil {
+		return false
+	}
+	return *g.CommentTruncated
+}
+
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (g *GitCommitRef) GetCommitID() string {
+	if g == nil || g.CommitID == nil {
+		return ""
+	}
+	return *g.CommitID
+}
+
+// GetCommitter returns the Committer field.
+func (g *GitCommitRef) GetCommitter() *GitUserDate {
+	if g == nil {
+		return nil
+	}
+	return g.Committer
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (g *GitCommitRef) GetLinks() map[string]Link {
+	if g == nil || g.Links == nil {
+		return map[string]Link{}
+	}
+	return *g.Links
+}
+
+// GetPush returns the Push field.
+func (g *GitCommitRef) GetPush() *GitPushRef {
+	if g == nil {
+		return nil
+	}
+	return g.Push
+}
+
+// GetRemoteURL returns the RemoteURL field if it's non-nil, zero value otherwise.
+func (g *GitCommitRef) GetRemoteURL() string {
+	if g == nil || g.RemoteURL == nil {
+		return ""
+	}
+	return *g.RemoteURL
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitCommitRef) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetWorkItems returns the WorkItems field.
+func (g *GitCommitRef) GetWorkItems() *ResourceRef {
+	if g == nil {
+		return nil
+	}
+	return g.WorkItems
+}
+
+// GetBaseBlob returns the BaseBlob field.
+func (g *GitConflict) GetBaseBlob() *GitBlobRef {
+	if g == nil {
+		return nil
+	}
+	return g.BaseBlob
+}
+
+// GetConflictID returns the ConflictID field if it's non-nil, zero value otherwise.
+func (g *GitConflict) GetConflictID() int {
+	if g == nil || g.ConflictID == nil {
+		return 0
+	}
+	return *g.ConflictID
+}
+
+// GetConflictPath returns the ConflictPath field if it's non-nil, zero value otherwise.
+func (g *GitConflict) GetConflictPath() string {
+	if g == nil || g.ConflictPath == nil {
+		return ""
+	}
+	return *g.ConflictPath
+}
+
+// GetConflictType returns the ConflictType field.
+func (g *GitConflict) GetConflictType() *GitConflictType {
+	if g == nil {
+		return nil
+	}
+	return g.ConflictType
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (g *GitConflict) GetLinks() map[string]Link {
+	if g == nil || g.Links == nil {
+		return map[string]Link{}
+	}
+	return *g.Links
+}
+
+// GetMergeBaseCommit returns the MergeBaseCommit field.
+func (g *GitConflict) GetMergeBaseCommit() *GitCommitRef {
+	if g == nil {
+		return nil
+	}
+	return g.MergeBaseCommit
+}
+
+// GetMergeOrigin returns the MergeOrigin field.
+func (g *GitConflict) GetMergeOrigin() *GitMergeOriginRef {
+	if g == nil {
+		return nil
+	}
+	return g.MergeOrigin
+}
+
+// GetMergeSourceCommit returns the MergeSourceCommit field.
+func (g *GitConflict) GetMergeSourceCommit() *GitCommitRef {
+	if g == nil {
+		return nil
+	}
+	return g.MergeSourceCommit
+}
+
+// GetMergeTargetCommit returns the MergeTargetCommit field.
+func (g *GitConflict) GetMergeTargetCommit() *GitCommitRef {
+	if g == nil {
+		return nil
+	}
+	return g.MergeTargetCommit
+}
+
+// GetResolution returns the Resolution field.
+func (g *GitConflict) GetResolution() *GitResolution {
+	if g == nil {
+		return nil
+	}
+	return g.Resolution
+}
+
+// GetResolutionError returns the ResolutionError field if it's non-nil, zero value otherwise.
+func (g *GitConflict) GetResolutionError() string {
+	if g == nil || g.ResolutionError == nil {
+		return ""
+	}
+	return *g.ResolutionError
+}
+
+// GetResolutionStatus returns the ResolutionStatus field.
+func (g *GitConflict) GetResolutionStatus() *GitResolutionStatus {
+	if g == nil {
+		return nil
+	}
+	return g.ResolutionStatus
+}
+
+// GetResolvedBy returns the ResolvedBy field.
+func (g *GitConflict) GetResolvedBy() *IdentityRef {
+	if g == nil {
+		return nil
+	}
+	return g.ResolvedBy
+}
+
+// GetResolvedDate returns the ResolvedDate field.
+func (g *GitConflict) GetResolvedDate() *Time {
+	if g == nil {
+		return nil
+	}
+	return g.ResolvedDate
+}
+
+// GetSourceBlob returns the SourceBlob field.
+func (g *GitConflict) GetSourceBlob() *GitBlobRef {
+	if g == nil {
+		return nil
+	}
+	return g.SourceBlob
+}
+
+// GetTargetBlob returns the TargetBlob field.
+func (g *GitConflict) GetTargetBlob() *GitBlobRef {
+	if g == nil {
+		return nil
+	}
+	return g.TargetBlob
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitConflict) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetConflictID returns the ConflictID field if it's non-nil, zero value otherwise.
+func (g *GitConflictUpdateResult) GetConflictID() int {
+	if g == nil || g.ConflictID == nil {
+		return 0
+	}
+	return *g.ConflictID
+}
+
+// GetCustomMessage returns the CustomMessage field if it's non-nil, zero value otherwise.
+func (g *GitConflictUpdateResult) GetCustomMessage() string {
+	if g == nil || g.CustomMessage == nil {
+		return ""
+	}
+	return *g.CustomMessage
+}
+
+// GetUpdatedConflict returns the UpdatedConflict field.
+func (g *GitConflictUpdateResult) GetUpdatedConflict() *GitConflict {
+	if g == nil {
+		return nil
+	}
+	return g.UpdatedConflict
+}
+
+// GetUpdateStatus returns the UpdateStatus field if it's non-nil, zero value otherwise.
+func (g *GitConflictUpdateResult) GetUpdateStatus() string {
+	if g == nil || g.UpdateStatus == nil {
+		return ""
+	}
+	return *g.UpdateStatus
+}
+
+// GetDiffCommonCommit returns the DiffCommonCommit field if it's non-nil, zero value otherwise.
+func (g *GitDiffsOptions) GetDiffCommonCommit() bool {
+	if g == nil || g.DiffCommonCommit == nil {
+		return false
+	}
+	return *g.DiffCommonCommit
+}
+
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (g *GitItem) GetCommitID() string {
+	if g == nil || g.CommitID == nil {
+		return ""
+	}
+	return *g.CommitID
+}
+
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (g *GitItem) GetContent() string {
+	if g == nil || g.Content == nil {
+		return ""
+	}
+	return *g.Content
+}
+
+// GetContentMetadata returns the ContentMetadata field.
+func (g *GitItem) GetContentMetadata() *FileContentMetadata {
+	if g == nil {
+		return nil
+	}
+	return g.ContentMetadata
+}
+
+// GetGitObjectType returns the GitObjectType field if it's non-nil, zero value otherwise.
+func (g *GitItem) GetGitObjectType() string {
+	if g == nil || g.GitObjectType == nil {
+		return ""
+	}
+	return *g.GitObjectType
+}
+
+// GetIsFolder returns the IsFolder field if it's non-nil, zero value otherwise.
+func (g *GitItem) GetIsFolder() bool {
+	if g == nil || g.IsFolder == nil {
+		return false
+	}
+	return *g.IsFolder
+}
+
+// GetIsSymLink returns the IsSymLink field if it's non-nil, zero value otherwise.
+func (g *GitItem) GetIsSymLink() bool {
+	if g == nil || g.IsSymLink == nil {
+		return false
+	}
+	return *g.IsSymLink
+}
+
+// GetLatestProcessedChange returns the LatestProcessedChange field.
+func (g *GitItem) GetLatestProcessedChange() *GitCommitRef {
+	if g == nil {
+		return nil
+	}
+	return g.LatestProcessedChange
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (g *GitItem) GetLinks() map[string]Link {
+	if g == nil || g.Links == nil {
+		return map[string]Link{}
+	}
+	return *g.Links
+}
+
+// GetObjectID returns the ObjectID field if it's non-nil, zero value otherwise.
+func (g *GitItem) GetObjectID() string {
+	if g == nil || g.ObjectID == nil {
+		return ""
+	}
+	return *g.ObjectID
+}
+
+// GetOriginalObjectID returns the OriginalObjectID field if it's non-nil, zero value otherwise.
+func (g *GitItem) GetOriginalObjectID() string {
+	if g == nil || g.OriginalObjectID == nil {
+		return ""
+	}
+	return *g.OriginalObjectID
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (g *GitItem) GetPath() string {
+	if g == nil || g.Path == nil {
+		return ""
+	}
+	return *g.Path
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitItem) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetPullRequestID returns the PullRequestID field if it's non-nil, zero value otherwise.
+func (g *GitMergeOriginRef) GetPullRequestID() int {
+	if g == nil || g.PullRequestID == nil {
+		return 0
+	}
+	return *g.PullRequestID
+}
+
+// GetArtifactID returns the ArtifactID field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetArtifactID() string {
+	if g == nil || g.ArtifactID == nil {
+		return ""
+	}
+	return *g.ArtifactID
+}
+
+// GetAutoCompleteSetBy returns the AutoCompleteSetBy field.
+func (g *GitPullRequest) GetAutoCompleteSetBy() *IdentityRef {
+	if g == nil {
+		return nil
+	}
+	return g.AutoCompleteSetBy
+}
+
+// GetClosedBy returns the ClosedBy field.
+func (g *GitPullRequest) GetClosedBy() *IdentityRef {
+	if g == nil {
+		return nil
+	}
+	return g.ClosedBy
+}
+
+// GetClosedDate returns the ClosedDate field.
+func (g *GitPullRequest) GetClosedDate() *Time {
+	if g == nil {
+		return nil
+	}
+	return g.ClosedDate
+}
+
+// GetCodeReviewID returns the CodeReviewID field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetCodeReviewID() int {
+	if g == nil || g.CodeReviewID == nil {
+		return 0
+	}
+	return *g.CodeReviewID
+}
+
+// GetCompletionOptions returns the CompletionOptions field.
+func (g *GitPullRequest) GetCompletionOptions() *GitPullRequestCompletionOptions {
+	if g == nil {
+		return nil
+	}
+	return g.CompletionOptions
+}
+
+// GetCompletionQueueTime returns the CompletionQueueTime field.
+func (g *GitPullRequest) GetCompletionQueueTime() *Time {
+	if g == nil {
+		return nil
+	}
+	return g.CompletionQueueTime
+}
+
+// GetCreatedBy returns the CreatedBy field.
+func (g *GitPullRequest) GetCreatedBy() *IdentityRef {
+	if g == nil {
+		return nil
+	}
+	return g.CreatedBy
+}
+
+// GetCreationDate returns the CreationDate field.
+func (g *GitPullRequest) GetCreationDate() *Time {
+	if g == nil {
+		return nil
+	}
+	return g.CreationDate
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetDescription() string {
+	if g == nil || g.Description == nil {
+		return ""
+	}
+	return *g.Description
+}
+
+// GetForkSource returns the ForkSource field.
+func (g *GitPullRequest) GetForkSource() *GitRef {
+	if g == nil {
+		return nil
+	}
+	return g.ForkSource
+}
+
+// GetIsDraft returns the IsDraft field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetIsDraft() bool {
+	if g == nil || g.IsDraft == nil {
+		return false
+	}
+	return *g.IsDraft
+}
+
+// GetLastMergeCommit returns the LastMergeCommit field.
+func (g *GitPullRequest) GetLastMergeCommit() *GitCommitRef {
+	if g == nil {
+		return nil
+	}
+	return g.LastMergeCommit
+}
+
+// GetLastMergeSourceCommit returns the LastMergeSourceCommit field.
+func (g *GitPullRequest) GetLastMergeSourceCommit() *GitCommitRef {
+	if g == nil {
+		return nil
+	}
+	return g.LastMergeSourceCommit
+}
+
+// GetLastMergeTargetCommit returns the LastMergeTargetCommit field.
+func (g *GitPullRequest) GetLastMergeTargetCommit() *GitCommitRef {
+	if g == nil {
+		return nil
+	}
+	return g.LastMergeTargetCommit
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetLinks() map[string]Link {
+	if g == nil || g.Links == nil {
+		return map[string]Link{}
+	}
+	return *g.Links
+}
+
+// GetMergeFailureMessage returns the MergeFailureMessage field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetMergeFailureMessage() string {
+	if g == nil || g.MergeFailureMessage == nil {
+		return ""
+	}
+	return *g.MergeFailureMessage
+}
+
+// GetMergeFailureType returns the MergeFailureType field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetMergeFailureType() string {
+	if g == nil || g.MergeFailureType == nil {
+		return ""
+	}
+	return *g.MergeFailureType
+}
+
+// GetMergeID returns the MergeID field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetMergeID() string {
+	if g == nil || g.MergeID == nil {
+		return ""
+	}
+	return *g.MergeID
+}
+
+// GetMergeOptions returns the MergeOptions field.
+func (g *GitPullRequest) GetMergeOptions() *GitPullRequestMergeOptions {
+	if g == nil {
+		return nil
+	}
+	return g.MergeOptions
+}
+
+// GetMergeStatus returns the MergeStatus field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetMergeStatus() string {
+	if g == nil || g.MergeStatus == nil {
+		return ""
+	}
+	return *g.MergeStatus
+}
+
+// GetPullRequestID returns the PullRequestID field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetPullRequestID() int {
+	if g == nil || g.PullRequestID == nil {
+		return 0
+	}
+	return *g.PullRequestID
+}
+
+// GetRemoteURL returns the RemoteURL field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetRemoteURL() string {
+	if g == nil || g.RemoteURL == nil {
+		return ""
+	}
+	return *g.RemoteURL
+}
+
+// GetRepository returns the Repository field.
+func (g *GitPullRequest) GetRepository() *GitRepository {
+	if g == nil {
+		return nil
+	}
+	return g.Repository
+}
+
+// GetSourceRefName returns the SourceRefName field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetSourceRefName() string {
+	if g == nil || g.SourceRefName == nil {
+		return ""
+	}
+	return *g.SourceRefName
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetStatus() string {
+	if g == nil || g.Status == nil {
+		return ""
+	}
+	return *g.Status
+}
+
+// GetSupportsIterations returns the SupportsIterations field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetSupportsIterations() bool {
+	if g == nil || g.SupportsIterations == nil {
+		return false
+	}
+	return *g.SupportsIterations
+}
+
+// GetTargetRefName returns the TargetRefName field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetTargetRefName() string {
+	if g == nil || g.TargetRefName == nil {
+		return ""
+	}
+	return *g.TargetRefName
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetTitle() string {
+	if g == nil || g.Title == nil {
+		return ""
+	}
+	return *g.Title
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitPullRequest) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
 }
 
 // GetChangeTrackingID returns the ChangeTrackingID field if it's non-nil, zero value otherwise.
@@ -1724,1801 +3716,3601 @@ func (g *GitPullRequestChange) GetChangeTrackingID() int {
 	if g == nil || g.ChangeTrackingID == nil {
 		return 0
 	}
-	return *g.ChangeTrackingID
+	return *g.ChangeTrackingID
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestCommentThread) GetID() int {
+	if g == nil || g.ID == nil {
+		return 0
+	}
+	return *g.ID
+}
+
+// GetIsDeleted returns the IsDeleted field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestCommentThread) GetIsDeleted() bool {
+	if g == nil || g.IsDeleted == nil {
+		return false
+	}
+	return *g.IsDeleted
+}
+
+// GetLastUpdatedDate returns the LastUpdatedDate field.
+func (g *GitPullRequestCommentThread) GetLastUpdatedDate() *Time {
+	if g == nil {
+		return nil
+	}
+	return g.LastUpdatedDate
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestCommentThread) GetLinks() map[string]Link {
+	if g == nil || g.Links == nil {
+		return map[string]Link{}
+	}
+	return *g.Links
+}
+
+// GetPublishedDate returns the PublishedDate field.
+func (g *GitPullRequestCommentThread) GetPublishedDate() *Time {
+	if g == nil {
+		return nil
+	}
+	return g.PublishedDate
+}
+
+// GetPullRequestThreadContext returns the PullRequestThreadContext field.
+func (g *GitPullRequestCommentThread) GetPullRequestThreadContext() *PullRequestThreadContext {
+	if g == nil {
+		return nil
+	}
+	return g.PullRequestThreadContext
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestCommentThread) GetStatus() string {
+	if g == nil || g.Status == nil {
+		return ""
+	}
+	return *g.Status
+}
+
+// GetThreadContext returns the ThreadContext field.
+func (g *GitPullRequestCommentThread) GetThreadContext() *GitPullRequestCommentThreadContext {
+	if g == nil {
+		return nil
+	}
+	return g.ThreadContext
+}
+
+// GetFilePath returns the FilePath field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestCommentThreadContext) GetFilePath() string {
+	if g == nil || g.FilePath == nil {
+		return ""
+	}
+	return *g.FilePath
+}
+
+// GetLeftFileEnd returns the LeftFileEnd field.
+func (g *GitPullRequestCommentThreadContext) GetLeftFileEnd() *CommentPosition {
+	if g == nil {
+		return nil
+	}
+	return g.LeftFileEnd
+}
+
+// GetLeftFileStart returns the LeftFileStart field.
+func (g *GitPullRequestCommentThreadContext) GetLeftFileStart() *CommentPosition {
+	if g == nil {
+		return nil
+	}
+	return g.LeftFileStart
+}
+
+// GetRightFileEnd returns the RightFileEnd field.
+func (g *GitPullRequestCommentThreadContext) GetRightFileEnd() *CommentPosition {
+	if g == nil {
+		return nil
+	}
+	return g.RightFileEnd
+}
+
+// GetRightFileStart returns the RightFileStart field.
+func (g *GitPullRequestCommentThreadContext) GetRightFileStart() *CommentPosition {
+	if g == nil {
+		return nil
+	}
+	return g.RightFileStart
+}
+
+// GetBypassPolicy returns the BypassPolicy field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestCompletionOptions) GetBypassPolicy() bool {
+	if g == nil || g.BypassPolicy == nil {
+		return false
+	}
+	return *g.BypassPolicy
+}
+
+// GetBypassReason returns the BypassReason field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestCompletionOptions) GetBypassReason() string {
+	if g == nil || g.BypassReason == nil {
+		return ""
+	}
+	return *g.BypassReason
+}
+
+// GetDeleteSourceBranch returns the DeleteSourceBranch field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestCompletionOptions) GetDeleteSourceBranch() bool {
+	if g == nil || g.DeleteSourceBranch == nil {
+		return false
+	}
+	return *g.DeleteSourceBranch
+}
+
+// GetMergeCommitMessage returns the MergeCommitMessage field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestCompletionOptions) GetMergeCommitMessage() string {
+	if g == nil || g.MergeCommitMessage == nil {
+		return ""
+	}
+	return *g.MergeCommitMessage
+}
+
+// GetMergeStrategy returns the MergeStrategy field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestCompletionOptions) GetMergeStrategy() string {
+	if g == nil || g.MergeStrategy == nil {
+		return ""
+	}
+	return *g.MergeStrategy
+}
+
+// GetSquashMerge returns the SquashMerge field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestCompletionOptions) GetSquashMerge() bool {
+	if g == nil || g.SquashMerge == nil {
+		return false
+	}
+	return *g.SquashMerge
+}
+
+// GetTransitionWorkItems returns the TransitionWorkItems field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestCompletionOptions) GetTransitionWorkItems() bool {
+	if g == nil || g.TransitionWorkItems == nil {
+		return false
+	}
+	return *g.TransitionWorkItems
+}
+
+// GetTriggeredByAutoComplete returns the TriggeredByAutoComplete field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestCompletionOptions) GetTriggeredByAutoComplete() bool {
+	if g == nil || g.TriggeredByAutoComplete == nil {
+		return false
+	}
+	return *g.TriggeredByAutoComplete
+}
+
+// GetAuthor returns the Author field.
+func (g *GitPullRequestIteration) GetAuthor() *IdentityRef {
+	if g == nil {
+		return nil
+	}
+	return g.Author
+}
+
+// GetCommonRefCommit returns the CommonRefCommit field.
+func (g *GitPullRequestIteration) GetCommonRefCommit() *GitCommitRef {
+	if g == nil {
+		return nil
+	}
+	return g.CommonRefCommit
+}
+
+// GetCreatedDate returns the CreatedDate field.
+func (g *GitPullRequestIteration) GetCreatedDate() *Time {
+	if g == nil {
+		return nil
+	}
+	return g.CreatedDate
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestIteration) GetDescription() string {
+	if g == nil || g.Description == nil {
+		return ""
+	}
+	return *g.Description
+}
+
+// GetHasMoreCommits returns the HasMoreCommits field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestIteration) GetHasMoreCommits() bool {
+	if g == nil || g.HasMoreCommits == nil {
+		return false
+	}
+	return *g.HasMoreCommits
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestIteration) GetID() int {
+	if g == nil || g.ID == nil {
+		return 0
+	}
+	return *g.ID
+}
+
+// GetNewTargetRefName returns the NewTargetRefName field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestIteration) GetNewTargetRefName() string {
+	if g == nil || g.NewTargetRefName == nil {
+		return ""
+	}
+	return *g.NewTargetRefName
+}
+
+// GetOldTargetRefName returns the OldTargetRefName field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestIteration) GetOldTargetRefName() string {
+	if g == nil || g.OldTargetRefName == nil {
+		return ""
+	}
+	return *g.OldTargetRefName
+}
+
+// GetPush returns the Push field.
+func (g *GitPullRequestIteration) GetPush() *GitPushRef {
+	if g == nil {
+		return nil
+	}
+	return g.Push
+}
+
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestIteration) GetReason() string {
+	if g == nil || g.Reason == nil {
+		return ""
+	}
+	return *g.Reason
+}
+
+// GetSourceRefCommit returns the SourceRefCommit field.
+func (g *GitPullRequestIteration) GetSourceRefCommit() *GitCommitRef {
+	if g == nil {
+		return nil
+	}
+	return g.SourceRefCommit
+}
+
+// GetTargetRefCommit returns the TargetRefCommit field.
+func (g *GitPullRequestIteration) GetTargetRefCommit() *GitCommitRef {
+	if g == nil {
+		return nil
+	}
+	return g.TargetRefCommit
+}
+
+// GetUpdatedDate returns the UpdatedDate field.
+func (g *GitPullRequestIteration) GetUpdatedDate() *Time {
+	if g == nil {
+		return nil
+	}
+	return g.UpdatedDate
+}
+
+// GetNextSkip returns the NextSkip field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestIterationChanges) GetNextSkip() int {
+	if g == nil || g.NextSkip == nil {
+		return 0
+	}
+	return *g.NextSkip
+}
+
+// GetNextTop returns the NextTop field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestIterationChanges) GetNextTop() int {
+	if g == nil || g.NextTop == nil {
+		return 0
+	}
+	return *g.NextTop
+}
+
+// GetDetectRenameFalsePositives returns the DetectRenameFalsePositives field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestMergeOptions) GetDetectRenameFalsePositives() bool {
+	if g == nil || g.DetectRenameFalsePositives == nil {
+		return false
+	}
+	return *g.DetectRenameFalsePositives
+}
+
+// GetDisableRenames returns the DisableRenames field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestMergeOptions) GetDisableRenames() bool {
+	if g == nil || g.DisableRenames == nil {
+		return false
+	}
+	return *g.DisableRenames
+}
+
+// GetIterationID returns the IterationID field if it's non-nil, zero value otherwise.
+func (g *GitPullRequestStatus) GetIterationID() int {
+	if g == nil || g.IterationID == nil {
+		return 0
+	}
+	return *g.IterationID
+}
+
+// GetComment returns the Comment field.
+func (g *GitPullRequestWithComment) GetComment() *Comment {
+	if g == nil {
+		return nil
+	}
+	return g.Comment
+}
+
+// GetPullRequest returns the PullRequest field.
+func (g *GitPullRequestWithComment) GetPullRequest() *GitPullRequest {
+	if g == nil {
+		return nil
+	}
+	return g.PullRequest
+}
+
+// GetDate returns the Date field.
+func (g *GitPush) GetDate() *Time {
+	if g == nil {
+		return nil
+	}
+	return g.Date
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (g *GitPush) GetLinks() map[string]Link {
+	if g == nil || g.Links == nil {
+		return map[string]Link{}
+	}
+	return *g.Links
+}
+
+// GetPushedBy returns the PushedBy field.
+func (g *GitPush) GetPushedBy() *IdentityRef {
+	if g == nil {
+		return nil
+	}
+	return g.PushedBy
+}
+
+// GetPushID returns the PushID field if it's non-nil, zero value otherwise.
+func (g *GitPush) GetPushID() int {
+	if g == nil || g.PushID == nil {
+		return 0
+	}
+	return *g.PushID
+}
+
+// GetRepository returns the Repository field.
+func (g *GitPush) GetRepository() *GitRepository {
+	if g == nil {
+		return nil
+	}
+	return g.Repository
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitPush) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetRepository returns the Repository field.
+func (g *GitPushRef) GetRepository() *GitRepository {
+	if g == nil {
+		return nil
+	}
+	return g.Repository
+}
+
+// GetCreator returns the Creator field.
+func (g *GitRef) GetCreator() *IdentityRef {
+	if g == nil {
+		return nil
+	}
+	return g.Creator
+}
+
+// GetIsLocked returns the IsLocked field if it's non-nil, zero value otherwise.
+func (g *GitRef) GetIsLocked() bool {
+	if g == nil || g.IsLocked == nil {
+		return false
+	}
+	return *g.IsLocked
+}
+
+// GetIsLockedBy returns the IsLockedBy field.
+func (g *GitRef) GetIsLockedBy() *IdentityRef {
+	if g == nil {
+		return nil
+	}
+	return g.IsLockedBy
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (g *GitRef) GetLinks() map[string]Link {
+	if g == nil || g.Links == nil {
+		return map[string]Link{}
+	}
+	return *g.Links
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *GitRef) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
+// GetObjectID returns the ObjectID field if it's non-nil, zero value otherwise.
+func (g *GitRef) GetObjectID() string {
+	if g == nil || g.ObjectID == nil {
+		return ""
+	}
+	return *g.ObjectID
+}
+
+// GetPeeledObjectID returns the PeeledObjectID field if it's non-nil, zero value otherwise.
+func (g *GitRef) GetPeeledObjectID() string {
+	if g == nil || g.PeeledObjectID == nil {
+		return ""
+	}
+	return *g.PeeledObjectID
+}
+
+// GetRepository returns the Repository field.
+func (g *GitRef) GetRepository() *GitRepository {
+	if g == nil {
+		return nil
+	}
+	return g.Repository
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitRef) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetIsLocked returns the IsLocked field if it's non-nil, zero value otherwise.
+func (g *GitRefUpdate) GetIsLocked() bool {
+	if g == nil || g.IsLocked == nil {
+		return false
+	}
+	return *g.IsLocked
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *GitRefUpdate) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
+// GetNewObjectID returns the NewObjectID field if it's non-nil, zero value otherwise.
+func (g *GitRefUpdate) GetNewObjectID() string {
+	if g == nil || g.NewObjectID == nil {
+		return ""
+	}
+	return *g.NewObjectID
+}
+
+// GetOldObjectID returns the OldObjectID field if it's non-nil, zero value otherwise.
+func (g *GitRefUpdate) GetOldObjectID() string {
+	if g == nil || g.OldObjectID == nil {
+		return ""
+	}
+	return *g.OldObjectID
+}
+
+// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
+func (g *GitRefUpdate) GetRepositoryID() string {
+	if g == nil || g.RepositoryID == nil {
+		return ""
+	}
+	return *g.RepositoryID
+}
+
+// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
+func (g *GitRepository) GetDefaultBranch() string {
+	if g == nil || g.DefaultBranch == nil {
+		return ""
+	}
+	return *g.DefaultBranch
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GitRepository) GetID() string {
+	if g == nil || g.ID == nil {
+		return ""
+	}
+	return *g.ID
+}
+
+// GetIsFork returns the IsFork field if it's non-nil, zero value otherwise.
+func (g *GitRepository) GetIsFork() bool {
+	if g == nil || g.IsFork == nil {
+		return false
+	}
+	return *g.IsFork
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (g *GitRepository) GetLinks() map[string]Link {
+	if g == nil || g.Links == nil {
+		return map[string]Link{}
+	}
+	return *g.Links
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *GitRepository) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
+// GetParentRepository returns the ParentRepository field.
+func (g *GitRepository) GetParentRepository() *GitRepositoryRef {
+	if g == nil {
+		return nil
+	}
+	return g.ParentRepository
+}
+
+// GetProject returns the Project field.
+func (g *GitRepository) GetProject() *TeamProjectReference {
+	if g == nil {
+		return nil
+	}
+	return g.Project
+}
+
+// GetRemoteURL returns the RemoteURL field if it's non-nil, zero value otherwise.
+func (g *GitRepository) GetRemoteURL() string {
+	if g == nil || g.RemoteURL == nil {
+		return ""
+	}
+	return *g.RemoteURL
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (g *GitRepository) GetSize() int {
+	if g == nil || g.Size == nil {
+		return 0
+	}
+	return *g.Size
+}
+
+// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
+func (g *GitRepository) GetSSHURL() string {
+	if g == nil || g.SSHURL == nil {
+		return ""
+	}
+	return *g.SSHURL
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitRepository) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetWebURL returns the WebURL field if it's non-nil, zero value otherwise.
+func (g *GitRepository) GetWebURL() string {
+	if g == nil || g.WebURL == nil {
+		return ""
+	}
+	return *g.WebURL
+}
+
+// GetCollection returns the Collection field.
+func (g *GitRepositoryRef) GetCollection() *TeamProjectCollectionReference {
+	if g == nil {
+		return nil
+	}
+	return g.Collection
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GitRepositoryRef) GetID() string {
+	if g == nil || g.ID == nil {
+		return ""
+	}
+	return *g.ID
+}
+
+// GetIsFork returns the IsFork field if it's non-nil, zero value otherwise.
+func (g *GitRepositoryRef) GetIsFork() bool {
+	if g == nil || g.IsFork == nil {
+		return false
+	}
+	return *g.IsFork
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *GitRepositoryRef) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
+// GetProject returns the Project field.
+func (g *GitRepositoryRef) GetProject() *TeamProjectReference {
+	if g == nil {
+		return nil
+	}
+	return g.Project
+}
+
+// GetRemoteURL returns the RemoteURL field if it's non-nil, zero value otherwise.
+func (g *GitRepositoryRef) GetRemoteURL() string {
+	if g == nil || g.RemoteURL == nil {
+		return ""
+	}
+	return *g.RemoteURL
+}
+
+// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
+func (g *GitRepositoryRef) GetSSHURL() string {
+	if g == nil || g.SSHURL == nil {
+		return ""
+	}
+	return *g.SSHURL
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitRepositoryRef) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetAction returns the Action field.
+func (g *GitResolution) GetAction() *GitResolutionWhichAction {
+	if g == nil {
+		return nil
+	}
+	return g.Action
+}
+
+// GetAuthor returns the Author field.
+func (g *GitResolution) GetAuthor() *IdentityRef {
+	if g == nil {
+		return nil
+	}
+	return g.Author
+}
+
+// GetMergeType returns the MergeType field.
+func (g *GitResolution) GetMergeType() *GitResolutionMergeType {
+	if g == nil {
+		return nil
+	}
+	return g.MergeType
+}
+
+// GetRenamePath returns the RenamePath field if it's non-nil, zero value otherwise.
+func (g *GitResolution) GetRenamePath() string {
+	if g == nil || g.RenamePath == nil {
+		return ""
+	}
+	return *g.RenamePath
+}
+
+// GetUserMergedBlob returns the UserMergedBlob field.
+func (g *GitResolution) GetUserMergedBlob() *GitBlobRef {
+	if g == nil {
+		return nil
+	}
+	return g.UserMergedBlob
+}
+
+// GetContext returns the Context field.
+func (g *GitStatus) GetContext() *GitStatusContext {
+	if g == nil {
+		return nil
+	}
+	return g.Context
+}
+
+// GetCreatedBy returns the CreatedBy field.
+func (g *GitStatus) GetCreatedBy() *IdentityRef {
+	if g == nil {
+		return nil
+	}
+	return g.CreatedBy
+}
+
+// GetCreationDate returns the CreationDate field.
+func (g *GitStatus) GetCreationDate() *Time {
+	if g == nil {
+		return nil
+	}
+	return g.CreationDate
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (g *GitStatus) GetDescription() string {
+	if g == nil || g.Description == nil {
+		return ""
+	}
+	return *g.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GitStatus) GetID() int {
+	if g == nil || g.ID == nil {
+		return 0
+	}
+	return *g.ID
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (g *GitStatus) GetLinks() map[string]Link {
+	if g == nil || g.Links == nil {
+		return map[string]Link{}
+	}
+	return *g.Links
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (g *GitStatus) GetState() string {
+	if g == nil || g.State == nil {
+		return ""
+	}
+	return *g.State
+}
+
+// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
+func (g *GitStatus) GetTargetURL() string {
+	if g == nil || g.TargetURL == nil {
+		return ""
+	}
+	return *g.TargetURL
+}
+
+// GetUpdatedDate returns the UpdatedDate field.
+func (g *GitStatus) GetUpdatedDate() *Time {
+	if g == nil {
+		return nil
+	}
+	return g.UpdatedDate
+}
+
+// GetGenre returns the Genre field if it's non-nil, zero value otherwise.
+func (g *GitStatusContext) GetGenre() string {
+	if g == nil || g.Genre == nil {
+		return ""
+	}
+	return *g.Genre
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *GitStatusContext) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *GitTemplate) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (g *GitTemplate) GetType() string {
+	if g == nil || g.Type == nil {
+		return ""
+	}
+	return *g.Type
+}
+
+// GetDate returns the Date field.
+func (g *GitUserDate) GetDate() *Time {
+	if g == nil {
+		return nil
+	}
+	return g.Date
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (g *GitUserDate) GetEmail() string {
+	if g == nil || g.Email == nil {
+		return ""
+	}
+	return *g.Email
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *GitUserDate) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (g *GraphDescriptorResult) GetValue() string {
+	if g == nil || g.Value == nil {
+		return ""
+	}
+	return *g.Value
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (g *GraphGroup) GetDescription() string {
+	if g == nil || g.Description == nil {
+		return ""
+	}
+	return *g.Description
+}
+
+// GetIsCrossProject returns the IsCrossProject field if it's non-nil, zero value otherwise.
+func (g *GraphGroup) GetIsCrossProject() bool {
+	if g == nil || g.IsCrossProject == nil {
+		return false
+	}
+	return *g.IsCrossProject
+}
+
+// GetIsDeleted returns the IsDeleted field if it's non-nil, zero value otherwise.
+func (g *GraphGroup) GetIsDeleted() bool {
+	if g == nil || g.IsDeleted == nil {
+		return false
+	}
+	return *g.IsDeleted
+}
+
+// GetIsGlobalScope returns the IsGlobalScope field if it's non-nil, zero value otherwise.
+func (g *GraphGroup) GetIsGlobalScope() bool {
+	if g == nil || g.IsGlobalScope == nil {
+		return false
+	}
+	return *g.IsGlobalScope
+}
+
+// GetIsRestrictedVisible returns the IsRestrictedVisible field if it's non-nil, zero value otherwise.
+func (g *GraphGroup) GetIsRestrictedVisible() bool {
+	if g == nil || g.IsRestrictedVisible == nil {
+		return false
+	}
+	return *g.IsRestrictedVisible
+}
+
+// GetLocalScopeID returns the LocalScopeID field if it's non-nil, zero value otherwise.
+func (g *GraphGroup) GetLocalScopeID() string {
+	if g == nil || g.LocalScopeID == nil {
+		return ""
+	}
+	return *g.LocalScopeID
+}
+
+// GetScopeID returns the ScopeID field if it's non-nil, zero value otherwise.
+func (g *GraphGroup) GetScopeID() string {
+	if g == nil || g.ScopeID == nil {
+		return ""
+	}
+	return *g.ScopeID
+}
+
+// GetScopeName returns the ScopeName field if it's non-nil, zero value otherwise.
+func (g *GraphGroup) GetScopeName() string {
+	if g == nil || g.ScopeName == nil {
+		return ""
+	}
+	return *g.ScopeName
+}
+
+// GetScopeType returns the ScopeType field if it's non-nil, zero value otherwise.
+func (g *GraphGroup) GetScopeType() string {
+	if g == nil || g.ScopeType == nil {
+		return ""
+	}
+	return *g.ScopeType
+}
+
+// GetSecuringHostID returns the SecuringHostID field if it's non-nil, zero value otherwise.
+func (g *GraphGroup) GetSecuringHostID() string {
+	if g == nil || g.SecuringHostID == nil {
+		return ""
+	}
+	return *g.SecuringHostID
+}
+
+// GetSpecialType returns the SpecialType field if it's non-nil, zero value otherwise.
+func (g *GraphGroup) GetSpecialType() string {
+	if g == nil || g.SpecialType == nil {
+		return ""
+	}
+	return *g.SpecialType
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (g *GraphMember) GetDomain() string {
+	if g == nil || g.Domain == nil {
+		return ""
+	}
+	return *g.Domain
+}
+
+// GetMailAddress returns the MailAddress field if it's non-nil, zero value otherwise.
+func (g *GraphMember) GetMailAddress() string {
+	if g == nil || g.MailAddress == nil {
+		return ""
+	}
+	return *g.MailAddress
+}
+
+// GetPrincipalName returns the PrincipalName field if it's non-nil, zero value otherwise.
+func (g *GraphMember) GetPrincipalName() string {
+	if g == nil || g.PrincipalName == nil {
+		return ""
+	}
+	return *g.PrincipalName
+}
+
+// GetLegacyDescriptor returns the LegacyDescriptor field if it's non-nil, zero value otherwise.
+func (g *GraphSubject) GetLegacyDescriptor() string {
+	if g == nil || g.LegacyDescriptor == nil {
+		return ""
+	}
+	return *g.LegacyDescriptor
+}
+
+// GetOrigin returns the Origin field if it's non-nil, zero value otherwise.
+func (g *GraphSubject) GetOrigin() string {
+	if g == nil || g.Origin == nil {
+		return ""
+	}
+	return *g.Origin
+}
+
+// GetOriginID returns the OriginID field if it's non-nil, zero value otherwise.
+func (g *GraphSubject) GetOriginID() string {
+	if g == nil || g.OriginID == nil {
+		return ""
+	}
+	return *g.OriginID
+}
+
+// GetSubjectKind returns the SubjectKind field if it's non-nil, zero value otherwise.
+func (g *GraphSubject) GetSubjectKind() string {
+	if g == nil || g.SubjectKind == nil {
+		return ""
+	}
+	return *g.SubjectKind
+}
+
+// GetDescriptor returns the Descriptor field if it's non-nil, zero value otherwise.
+func (g *GraphSubjectBase) GetDescriptor() string {
+	if g == nil || g.Descriptor == nil {
+		return ""
+	}
+	return *g.Descriptor
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (g *GraphSubjectBase) GetDisplayName() string {
+	if g == nil || g.DisplayName == nil {
+		return ""
+	}
+	return *g.DisplayName
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GraphSubjectBase) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetIsDeletedInOrigin returns the IsDeletedInOrigin field if it's non-nil, zero value otherwise.
+func (g *GraphUser) GetIsDeletedInOrigin() bool {
+	if g == nil || g.IsDeletedInOrigin == nil {
+		return false
+	}
+	return *g.IsDeletedInOrigin
+}
+
+// GetMetadataUpdateDate returns the MetadataUpdateDate field.
+func (g *GraphUser) GetMetadataUpdateDate() *Time {
+	if g == nil {
+		return nil
+	}
+	return g.MetadataUpdateDate
+}
+
+// GetMetaType returns the MetaType field if it's non-nil, zero value otherwise.
+func (g *GraphUser) GetMetaType() string {
+	if g == nil || g.MetaType == nil {
+		return ""
+	}
+	return *g.MetaType
+}
+
+// GetDescriptor returns the Descriptor field if it's non-nil, zero value otherwise.
+func (i *IdentityRef) GetDescriptor() string {
+	if i == nil || i.Descriptor == nil {
+		return ""
+	}
+	return *i.Descriptor
+}
+
+// GetDirectoryAlias returns the DirectoryAlias field if it's non-nil, zero value otherwise.
+func (i *IdentityRef) GetDirectoryAlias() string {
+	if i == nil || i.DirectoryAlias == nil {
+		return ""
+	}
+	return *i.DirectoryAlias
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (i *IdentityRef) GetDisplayName() string {
+	if i == nil || i.DisplayName == nil {
+		return ""
+	}
+	return *i.DisplayName
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IdentityRef) GetID() string {
+	if i == nil || i.ID == nil {
+		return ""
+	}
+	return *i.ID
+}
+
+// GetImageURL returns the ImageURL field if it's non-nil, zero value otherwise.
+func (i *IdentityRef) GetImageURL() string {
+	if i == nil || i.ImageURL == nil {
+		return ""
+	}
+	return *i.ImageURL
+}
+
+// GetInactive returns the Inactive field if it's non-nil, zero value otherwise.
+func (i *IdentityRef) GetInactive() bool {
+	if i == nil || i.Inactive == nil {
+		return false
+	}
+	return *i.Inactive
+}
+
+// GetIsAadIdentity returns the IsAadIdentity field if it's non-nil, zero value otherwise.
+func (i *IdentityRef) GetIsAadIdentity() bool {
+	if i == nil || i.IsAadIdentity == nil {
+		return false
+	}
+	return *i.IsAadIdentity
+}
+
+// GetIsContainer returns the IsContainer field if it's non-nil, zero value otherwise.
+func (i *IdentityRef) GetIsContainer() bool {
+	if i == nil || i.IsContainer == nil {
+		return false
+	}
+	return *i.IsContainer
+}
+
+// GetIsDeletedInOrigin returns the IsDeletedInOrigin field if it's non-nil, zero value otherwise.
+func (i *IdentityRef) GetIsDeletedInOrigin() bool {
+	if i == nil || i.IsDeletedInOrigin == nil {
+		return false
+	}
+	return *i.IsDeletedInOrigin
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (i *IdentityRef) GetLinks() map[string]Link {
+	if i == nil || i.Links == nil {
+		return map[string]Link{}
+	}
+	return *i.Links
+}
+
+// GetProfileURL returns the ProfileURL field if it's non-nil, zero value otherwise.
+func (i *IdentityRef) GetProfileURL() string {
+	if i == nil || i.ProfileURL == nil {
+		return ""
+	}
+	return *i.ProfileURL
+}
+
+// GetUniqueName returns the UniqueName field if it's non-nil, zero value otherwise.
+func (i *IdentityRef) GetUniqueName() string {
+	if i == nil || i.UniqueName == nil {
+		return ""
+	}
+	return *i.UniqueName
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IdentityRef) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetIsRequired returns the IsRequired field if it's non-nil, zero value otherwise.
+func (i *IdentityRefWithVote) GetIsRequired() bool {
+	if i == nil || i.IsRequired == nil {
+		return false
+	}
+	return *i.IsRequired
+}
+
+// GetReviewerURL returns the ReviewerURL field if it's non-nil, zero value otherwise.
+func (i *IdentityRefWithVote) GetReviewerURL() string {
+	if i == nil || i.ReviewerURL == nil {
+		return ""
+	}
+	return *i.ReviewerURL
+}
+
+// GetVote returns the Vote field if it's non-nil, zero value otherwise.
+func (i *IdentityRefWithVote) GetVote() int {
+	if i == nil || i.Vote == nil {
+		return 0
+	}
+	return *i.Vote
+}
+
+// GetLastModifiedOn returns the LastModifiedOn field.
+func (i *IgnoredGate) GetLastModifiedOn() *Time {
+	if i == nil {
+		return nil
+	}
+	return i.LastModifiedOn
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (i *IgnoredGate) GetName() string {
+	if i == nil || i.Name == nil {
+		return ""
+	}
+	return *i.Name
+}
+
+// GetCategory returns the Category field if it's non-nil, zero value otherwise.
+func (i *Issue) GetCategory() string {
+	if i == nil || i.Category == nil {
+		return ""
+	}
+	return *i.Category
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (i *Issue) GetMessage() string {
+	if i == nil || i.Message == nil {
+		return ""
+	}
+	return *i.Message
+}
+
+// GetType returns the Type field.
+func (i *Issue) GetType() *IssueType {
+	if i == nil {
+		return nil
+	}
+	return i.Type
+}
+
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (i *ItemContent) GetContent() string {
+	if i == nil || i.Content == nil {
+		return ""
+	}
+	return *i.Content
+}
+
+// GetContentType returns the ContentType field.
+func (i *ItemContent) GetContentType() *ItemContentType {
+	if i == nil {
+		return nil
+	}
+	return i.ContentType
+}
+
+// GetBase64Encoded returns the Base64Encoded field if it's non-nil, zero value otherwise.
+func (i *ItemContentType) GetBase64Encoded() string {
+	if i == nil || i.Base64Encoded == nil {
+		return ""
+	}
+	return *i.Base64Encoded
+}
+
+// GetRawText returns the RawText field if it's non-nil, zero value otherwise.
+func (i *ItemContentType) GetRawText() string {
+	if i == nil || i.RawText == nil {
+		return ""
+	}
+	return *i.RawText
+}
+
+// GetEndDate returns the EndDate field if it's non-nil, zero value otherwise.
+func (i *Iteration) GetEndDate() string {
+	if i == nil || i.EndDate == nil {
+		return ""
+	}
+	return *i.EndDate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Iteration) GetID() string {
+	if i == nil || i.ID == nil {
+		return ""
+	}
+	return *i.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (i *Iteration) GetName() string {
+	if i == nil || i.Name == nil {
+		return ""
+	}
+	return *i.Name
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (i *Iteration) GetPath() string {
+	if i == nil || i.Path == nil {
+		return ""
+	}
+	return *i.Path
+}
+
+// GetStartDate returns the StartDate field if it's non-nil, zero value otherwise.
+func (i *Iteration) GetStartDate() string {
+	if i == nil || i.StartDate == nil {
+		return ""
+	}
+	return *i.StartDate
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *Iteration) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (i *IterationWorkItems) GetLinks() map[string]Link {
+	if i == nil || i.Links == nil {
+		return map[string]Link{}
+	}
+	return *i.Links
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IterationWorkItems) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetChangeType returns the ChangeType field if it's non-nil, zero value otherwise.
+func (l *LineDiffBlock) GetChangeType() string {
+	if l == nil || l.ChangeType == nil {
+		return ""
+	}
+	return *l.ChangeType
+}
+
+// GetModifiedLineNumberStart returns the ModifiedLineNumberStart field if it's non-nil, zero value otherwise.
+func (l *LineDiffBlock) GetModifiedLineNumberStart() int {
+	if l == nil || l.ModifiedLineNumberStart == nil {
+		return 0
+	}
+	return *l.ModifiedLineNumberStart
+}
+
+// GetModifiedLinesCount returns the ModifiedLinesCount field if it's non-nil, zero value otherwise.
+func (l *LineDiffBlock) GetModifiedLinesCount() int {
+	if l == nil || l.ModifiedLinesCount == nil {
+		return 0
+	}
+	return *l.ModifiedLinesCount
+}
+
+// GetOriginalLineNumberStart returns the OriginalLineNumberStart field if it's non-nil, zero value otherwise.
+func (l *LineDiffBlock) GetOriginalLineNumberStart() int {
+	if l == nil || l.OriginalLineNumberStart == nil {
+		return 0
+	}
+	return *l.OriginalLineNumberStart
+}
+
+// GetOriginalLinesCount returns the OriginalLinesCount field if it's non-nil, zero value otherwise.
+func (l *LineDiffBlock) GetOriginalLinesCount() int {
+	if l == nil || l.OriginalLinesCount == nil {
+		return 0
+	}
+	return *l.OriginalLinesCount
+}
+
+// GetHref returns the Href field if it's non-nil, zero value otherwise.
+func (l *Link) GetHref() string {
+	if l == nil || l.Href == nil {
+		return ""
+	}
+	return *l.Href
+}
+
+// GetCount returns the Count field if it's non-nil, zero value otherwise.
+func (l *ListBoardsResponse) GetCount() int {
+	if l == nil || l.Count == nil {
+		return 0
+	}
+	return *l.Count
+}
+
+// GetHTML returns the HTML field if it's non-nil, zero value otherwise.
+func (m *Message) GetHTML() string {
+	if m == nil || m.HTML == nil {
+		return ""
+	}
+	return *m.HTML
+}
+
+// GetMarkdown returns the Markdown field if it's non-nil, zero value otherwise.
+func (m *Message) GetMarkdown() string {
+	if m == nil || m.Markdown == nil {
+		return ""
+	}
+	return *m.Markdown
+}
+
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (m *Message) GetText() string {
+	if m == nil || m.Text == nil {
+		return ""
+	}
+	return *m.Text
+}
+
+// GetConfiguration returns the Configuration field.
+func (p *Pipeline) GetConfiguration() *PipelineConfiguration {
+	if p == nil {
+		return nil
+	}
+	return p.Configuration
+}
+
+// GetFolder returns the Folder field if it's non-nil, zero value otherwise.
+func (p *Pipeline) GetFolder() string {
+	if p == nil || p.Folder == nil {
+		return ""
+	}
+	return *p.Folder
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *Pipeline) GetID() int {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (p *Pipeline) GetLinks() map[string]Link {
+	if p == nil || p.Links == nil {
+		return map[string]Link{}
+	}
+	return *p.Links
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Pipeline) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
+func (p *Pipeline) GetRevision() int {
+	if p == nil || p.Revision == nil {
+		return 0
+	}
+	return *p.Revision
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Pipeline) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (p *PipelineConfiguration) GetPath() string {
+	if p == nil || p.Path == nil {
+		return ""
+	}
+	return *p.Path
+}
+
+// GetRepository returns the Repository field.
+func (p *PipelineConfiguration) GetRepository() *PipelineRepository {
+	if p == nil {
+		return nil
+	}
+	return p.Repository
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineConfiguration) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PipelineRepository) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PipelineRepository) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetPipeline returns the Pipeline field.
+func (p *PipelineResource) GetPipeline() *Pipeline {
+	if p == nil {
+		return nil
+	}
+	return p.Pipeline
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (p *PipelineResource) GetVersion() string {
+	if p == nil || p.Version == nil {
+		return ""
+	}
+	return *p.Version
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (p *PipelineResourceParameters) GetVersion() string {
+	if p == nil || p.Version == nil {
+		return ""
+	}
+	return *p.Version
+}
+
+// GetCreatedDate returns the CreatedDate field.
+func (p *PipelineRun) GetCreatedDate() *Time {
+	if p == nil {
+		return nil
+	}
+	return p.CreatedDate
+}
+
+// GetFinalYaml returns the FinalYaml field if it's non-nil, zero value otherwise.
+func (p *PipelineRun) GetFinalYaml() string {
+	if p == nil || p.FinalYaml == nil {
+		return ""
+	}
+	return *p.FinalYaml
+}
+
+// GetFinishedDate returns the FinishedDate field.
+func (p *PipelineRun) GetFinishedDate() *Time {
+	if p == nil {
+		return nil
+	}
+	return p.FinishedDate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PipelineRun) GetID() int {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (p *PipelineRun) GetLinks() map[string]Link {
+	if p == nil || p.Links == nil {
+		return map[string]Link{}
+	}
+	return *p.Links
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PipelineRun) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetPipeline returns the Pipeline field.
+func (p *PipelineRun) GetPipeline() *Pipeline {
+	if p == nil {
+		return nil
+	}
+	return p.Pipeline
+}
+
+// GetResources returns the Resources field.
+func (p *PipelineRun) GetResources() *PipelineRunResources {
+	if p == nil {
+		return nil
+	}
+	return p.Resources
+}
+
+// GetResult returns the Result field.
+func (p *PipelineRun) GetResult() *PipelineRunResult {
+	if p == nil {
+		return nil
+	}
+	return p.Result
+}
+
+// GetState returns the State field.
+func (p *PipelineRun) GetState() *PipelineRunState {
+	if p == nil {
+		return nil
+	}
+	return p.State
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PipelineRun) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetIsSecret returns the IsSecret field if it's non-nil, zero value otherwise.
+func (p *PipelineVariable) GetIsSecret() bool {
+	if p == nil || p.IsSecret == nil {
+		return false
+	}
+	return *p.IsSecret
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (p *PipelineVariable) GetValue() string {
+	if p == nil || p.Value == nil {
+		return ""
+	}
+	return *p.Value
+}
+
+// GetCreatedBy returns the CreatedBy field.
+func (p *PolicyConfiguration) GetCreatedBy() *IdentityRef {
+	if p == nil {
+		return nil
+	}
+	return p.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (p *PolicyConfiguration) GetCreatedDate() string {
+	if p == nil || p.CreatedDate == nil {
+		return ""
+	}
+	return *p.CreatedDate
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestCommentThread) GetID() int {
-	if g == nil || g.ID == nil {
+func (p *PolicyConfiguration) GetID() int {
+	if p == nil || p.ID == nil {
 		return 0
 	}
-	return *g.ID
+	return *p.ID
+}
+
+// GetIsBlocking returns the IsBlocking field if it's non-nil, zero value otherwise.
+func (p *PolicyConfiguration) GetIsBlocking() bool {
+	if p == nil || p.IsBlocking == nil {
+		return false
+	}
+	return *p.IsBlocking
 }
 
 // GetIsDeleted returns the IsDeleted field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestCommentThread) GetIsDeleted() bool {
-	if g == nil || g.IsDeleted == nil {
+func (p *PolicyConfiguration) GetIsDeleted() bool {
+	if p == nil || p.IsDeleted == nil {
 		return false
 	}
-	return *g.IsDeleted
+	return *p.IsDeleted
 }
 
-// GetLastUpdatedDate returns the LastUpdatedDate field.
-func (g *GitPullRequestCommentThread) GetLastUpdatedDate() *Time {
-	if g == nil {
-		return nil
+// GetIsEnabled returns the IsEnabled field if it's non-nil, zero value otherwise.
+func (p *PolicyConfiguration) GetIsEnabled() bool {
+	if p == nil || p.IsEnabled == nil {
+		return false
 	}
-	return g.LastUpdatedDate
+	return *p.IsEnabled
 }
 
-// GetLinks returns the Links field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestCommentThread) GetLinks() map[string]Link {
-	if g == nil || g.Links == nil {
-		return map[string]Link{}
+// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
+func (p *PolicyConfiguration) GetRevision() int {
+	if p == nil || p.Revision == nil {
+		return 0
 	}
-	return *g.Links
+	return *p.Revision
 }
 
-// GetPublishedDate returns the PublishedDate field.
-func (g *GitPullRequestCommentThread) GetPublishedDate() *Time {
-	if g == nil {
+// GetType returns the Type field.
+func (p *PolicyConfiguration) GetType() *PolicyTypeRef {
+	if p == nil {
 		return nil
 	}
-	return g.PublishedDate
+	return p.Type
 }
 
-// GetPullRequestThreadContext returns the PullRequestThreadContext field.
-func (g *GitPullRequestCommentThread) GetPullRequestThreadContext() *GitPullRequestCommentThreadContext {
-	if g == nil {
-		return nil
+// GetUrl returns the Url field if it's non-nil, zero value otherwise.
+func (p *PolicyConfiguration) GetUrl() string {
+	if p == nil || p.Url == nil {
+		return ""
 	}
-	return g.PullRequestThreadContext
+	return *p.Url
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestCommentThread) GetStatus() string {
-	if g == nil || g.Status == nil {
+// GetArtifactID returns the ArtifactID field if it's non-nil, zero value otherwise.
+func (p *PolicyEvaluationRecord) GetArtifactID() string {
+	if p == nil || p.ArtifactID == nil {
 		return ""
 	}
-	return *g.Status
+	return *p.ArtifactID
 }
 
-// GetFilePath returns the FilePath field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestCommentThreadContext) GetFilePath() string {
-	if g == nil || g.FilePath == nil {
+// GetCompletedDate returns the CompletedDate field if it's non-nil, zero value otherwise.
+func (p *PolicyEvaluationRecord) GetCompletedDate() string {
+	if p == nil || p.CompletedDate == nil {
 		return ""
 	}
-	return *g.FilePath
+	return *p.CompletedDate
 }
 
-// GetLeftFileEnd returns the LeftFileEnd field.
-func (g *GitPullRequestCommentThreadContext) GetLeftFileEnd() *CommentPosition {
-	if g == nil {
+// GetConfiguration returns the Configuration field.
+func (p *PolicyEvaluationRecord) GetConfiguration() *PolicyConfiguration {
+	if p == nil {
 		return nil
 	}
-	return g.LeftFileEnd
+	return p.Configuration
 }
 
-// GetLeftFileStart returns the LeftFileStart field.
-func (g *GitPullRequestCommentThreadContext) GetLeftFileStart() *CommentPosition {
-	if g == nil {
-		return nil
+// GetEvaluationID returns the EvaluationID field if it's non-nil, zero value otherwise.
+func (p *PolicyEvaluationRecord) GetEvaluationID() string {
+	if p == nil || p.EvaluationID == nil {
+		return ""
 	}
-	return g.LeftFileStart
+	return *p.EvaluationID
 }
 
-// GetRightFileEnd returns the RightFileEnd field.
-func (g *GitPullRequestCommentThreadContext) GetRightFileEnd() *CommentPosition {
-	if g == nil {
-		return nil
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (p *PolicyEvaluationRecord) GetLinks() map[string]Link {
+	if p == nil || p.Links == nil {
+		return map[string]Link{}
 	}
-	return g.RightFileEnd
+	return *p.Links
 }
 
-// GetRightFileStart returns the RightFileStart field.
-func (g *GitPullRequestCommentThreadContext) GetRightFileStart() *CommentPosition {
-	if g == nil {
-		return nil
+// GetStartedDate returns the StartedDate field if it's non-nil, zero value otherwise.
+func (p *PolicyEvaluationRecord) GetStartedDate() string {
+	if p == nil || p.StartedDate == nil {
+		return ""
 	}
-	return g.RightFileStart
+	return *p.StartedDate
 }
 
-// GetBypassPolicy returns the BypassPolicy field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestCompletionOptions) GetBypassPolicy() bool {
-	if g == nil || g.BypassPolicy == nil {
-		return false
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *PolicyEvaluationRecord) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
 	}
-	return *g.BypassPolicy
+	return *p.Status
 }
 
-// GetBypassReason returns the BypassReason field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestCompletionOptions) GetBypassReason() string {
-	if g == nil || g.BypassReason == nil {
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (p *PolicyTypeRef) GetDisplayName() string {
+	if p == nil || p.DisplayName == nil {
 		return ""
 	}
-	return *g.BypassReason
+	return *p.DisplayName
 }
 
-// GetDeleteSourceBranch returns the DeleteSourceBranch field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestCompletionOptions) GetDeleteSourceBranch() bool {
-	if g == nil || g.DeleteSourceBranch == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PolicyTypeRef) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
 	}
-	return *g.DeleteSourceBranch
+	return *p.ID
 }
 
-// GetMergeCommitMessage returns the MergeCommitMessage field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestCompletionOptions) GetMergeCommitMessage() string {
-	if g == nil || g.MergeCommitMessage == nil {
+// GetUrl returns the Url field if it's non-nil, zero value otherwise.
+func (p *PolicyTypeRef) GetUrl() string {
+	if p == nil || p.Url == nil {
 		return ""
 	}
-	return *g.MergeCommitMessage
+	return *p.Url
 }
 
-// GetMergeStrategy returns the MergeStrategy field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestCompletionOptions) GetMergeStrategy() string {
-	if g == nil || g.MergeStrategy == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *Project) GetDescription() string {
+	if p == nil || p.Description == nil {
 		return ""
 	}
-	return *g.MergeStrategy
+	return *p.Description
 }
 
-// GetSquashMerge returns the SquashMerge field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestCompletionOptions) GetSquashMerge() bool {
-	if g == nil || g.SquashMerge == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *Project) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
 	}
-	return *g.SquashMerge
+	return *p.ID
 }
 
-// GetTransitionWorkItems returns the TransitionWorkItems field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestCompletionOptions) GetTransitionWorkItems() bool {
-	if g == nil || g.TransitionWorkItems == nil {
-		return false
+// GetLastUpdateTime returns the LastUpdateTime field.
+func (p *Project) GetLastUpdateTime() *Time {
+	if p == nil {
+		return nil
 	}
-	return *g.TransitionWorkItems
+	return p.LastUpdateTime
 }
 
-// GetTriggeredByAutoComplete returns the TriggeredByAutoComplete field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestCompletionOptions) GetTriggeredByAutoComplete() bool {
-	if g == nil || g.TriggeredByAutoComplete == nil {
-		return false
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Project) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return *g.TriggeredByAutoComplete
+	return *p.Name
 }
 
-// GetAuthor returns the Author field.
-func (g *GitPullRequestIteration) GetAuthor() *IdentityRef {
-	if g == nil {
-		return nil
+// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
+func (p *Project) GetRevision() int {
+	if p == nil || p.Revision == nil {
+		return 0
 	}
-	return g.Author
+	return *p.Revision
 }
 
-// GetCommonRefCommit returns the CommonRefCommit field.
-func (g *GitPullRequestIteration) GetCommonRefCommit() *GitCommitRef {
-	if g == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *Project) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Project) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (p *Project) GetVisibility() string {
+	if p == nil || p.Visibility == nil {
+		return ""
+	}
+	return *p.Visibility
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PropertyValue) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetDiff returns the Diff field.
+func (p *PullRequestMergePreview) GetDiff() *GitCommitDiffs {
+	if p == nil {
 		return nil
 	}
-	return g.CommonRefCommit
+	return p.Diff
 }
 
-// GetCreatedDate returns the CreatedDate field.
-func (g *GitPullRequestIteration) GetCreatedDate() *Time {
-	if g == nil {
+// GetLastMergeCommit returns the LastMergeCommit field.
+func (p *PullRequestMergePreview) GetLastMergeCommit() *GitCommitRef {
+	if p == nil {
 		return nil
 	}
-	return g.CreatedDate
+	return p.LastMergeCommit
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestIteration) GetDescription() string {
-	if g == nil || g.Description == nil {
-		return ""
+// GetLastMergeSourceCommit returns the LastMergeSourceCommit field.
+func (p *PullRequestMergePreview) GetLastMergeSourceCommit() *GitCommitRef {
+	if p == nil {
+		return nil
 	}
-	return *g.Description
+	return p.LastMergeSourceCommit
 }
 
-// GetHasMoreCommits returns the HasMoreCommits field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestIteration) GetHasMoreCommits() bool {
-	if g == nil || g.HasMoreCommits == nil {
-		return false
+// GetLastMergeTargetCommit returns the LastMergeTargetCommit field.
+func (p *PullRequestMergePreview) GetLastMergeTargetCommit() *GitCommitRef {
+	if p == nil {
+		return nil
 	}
-	return *g.HasMoreCommits
+	return p.LastMergeTargetCommit
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestIteration) GetID() int {
-	if g == nil || g.ID == nil {
-		return 0
+// GetWait returns the Wait field.
+func (p *PullRequestMergePreview) GetWait() *PullRequestWaitResult {
+	if p == nil {
+		return nil
 	}
-	return *g.ID
+	return p.Wait
 }
 
-// GetNewTargetRefName returns the NewTargetRefName field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestIteration) GetNewTargetRefName() string {
-	if g == nil || g.NewTargetRefName == nil {
-		return ""
+// GetStatus returns the Status field.
+func (p *PullRequestSearchCriteria) GetStatus() *PullRequestStatus {
+	if p == nil {
+		return nil
 	}
-	return *g.NewTargetRefName
+	return p.Status
 }
 
-// GetOldTargetRefName returns the OldTargetRefName field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestIteration) GetOldTargetRefName() string {
-	if g == nil || g.OldTargetRefName == nil {
-		return ""
+// GetChangeTrackingID returns the ChangeTrackingID field if it's non-nil, zero value otherwise.
+func (p *PullRequestThreadContext) GetChangeTrackingID() int {
+	if p == nil || p.ChangeTrackingID == nil {
+		return 0
 	}
-	return *g.OldTargetRefName
+	return *p.ChangeTrackingID
 }
 
-// GetPush returns the Push field.
-func (g *GitPullRequestIteration) GetPush() *GitPushRef {
-	if g == nil {
+// GetIterationContext returns the IterationContext field.
+func (p *PullRequestThreadContext) GetIterationContext() *CommentIterationContext {
+	if p == nil {
 		return nil
 	}
-	return g.Push
+	return p.IterationContext
 }
 
-// GetReason returns the Reason field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestIteration) GetReason() string {
-	if g == nil || g.Reason == nil {
-		return ""
+// GetTrackingCriteria returns the TrackingCriteria field.
+func (p *PullRequestThreadContext) GetTrackingCriteria() *CommentTrackingCriteria {
+	if p == nil {
+		return nil
 	}
-	return *g.Reason
+	return p.TrackingCriteria
 }
 
-// GetSourceRefCommit returns the SourceRefCommit field.
-func (g *GitPullRequestIteration) GetSourceRefCommit() *GitCommitRef {
-	if g == nil {
+// GetPullRequest returns the PullRequest field.
+func (p *PullRequestWaitResult) GetPullRequest() *GitPullRequest {
+	if p == nil {
 		return nil
 	}
-	return g.SourceRefCommit
+	return p.PullRequest
 }
 
-// GetTargetRefCommit returns the TargetRefCommit field.
-func (g *GitPullRequestIteration) GetTargetRefCommit() *GitCommitRef {
-	if g == nil {
+// GetCreatedBy returns the CreatedBy field.
+func (q *QueryHierarchyItem) GetCreatedBy() *IdentityRef {
+	if q == nil {
 		return nil
 	}
-	return g.TargetRefCommit
+	return q.CreatedBy
 }
 
-// GetUpdatedDate returns the UpdatedDate field.
-func (g *GitPullRequestIteration) GetUpdatedDate() *Time {
-	if g == nil {
+// GetCreatedDate returns the CreatedDate field.
+func (q *QueryHierarchyItem) GetCreatedDate() *Time {
+	if q == nil {
 		return nil
 	}
-	return g.UpdatedDate
+	return q.CreatedDate
 }
 
-// GetNextSkip returns the NextSkip field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestIterationChanges) GetNextSkip() int {
-	if g == nil || g.NextSkip == nil {
-		return 0
+// GetHasChildren returns the HasChildren field if it's non-nil, zero value otherwise.
+func (q *QueryHierarchyItem) GetHasChildren() bool {
+	if q == nil || q.HasChildren == nil {
+		return false
 	}
-	return *g.NextSkip
+	return *q.HasChildren
 }
 
-// GetNextTop returns the NextTop field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestIterationChanges) GetNextTop() int {
-	if g == nil || g.NextTop == nil {
-		return 0
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (q *QueryHierarchyItem) GetID() string {
+	if q == nil || q.ID == nil {
+		return ""
 	}
-	return *g.NextTop
+	return *q.ID
 }
 
-// GetDetectRenameFalsePositives returns the DetectRenameFalsePositives field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestMergeOptions) GetDetectRenameFalsePositives() bool {
-	if g == nil || g.DetectRenameFalsePositives == nil {
+// GetIsDeleted returns the IsDeleted field if it's non-nil, zero value otherwise.
+func (q *QueryHierarchyItem) GetIsDeleted() bool {
+	if q == nil || q.IsDeleted == nil {
 		return false
 	}
-	return *g.DetectRenameFalsePositives
+	return *q.IsDeleted
 }
 
-// GetDisableRenames returns the DisableRenames field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestMergeOptions) GetDisableRenames() bool {
-	if g == nil || g.DisableRenames == nil {
+// GetIsFolder returns the IsFolder field if it's non-nil, zero value otherwise.
+func (q *QueryHierarchyItem) GetIsFolder() bool {
+	if q == nil || q.IsFolder == nil {
 		return false
 	}
-	return *g.DisableRenames
-}
-
-// GetIterationID returns the IterationID field if it's non-nil, zero value otherwise.
-func (g *GitPullRequestStatus) GetIterationID() int {
-	if g == nil || g.IterationID == nil {
-		return 0
-	}
-	return *g.IterationID
+	return *q.IsFolder
 }
 
-// GetComment returns the Comment field.
-func (g *GitPullRequestWithComment) GetComment() *Comment {
-	if g == nil {
-		return nil
+// GetIsPublic returns the IsPublic field if it's non-nil, zero value otherwise.
+func (q *QueryHierarchyItem) GetIsPublic() bool {
+	if q == nil || q.IsPublic == nil {
+		return false
 	}
-	return g.Comment
+	return *q.IsPublic
 }
 
-// GetPullRequest returns the PullRequest field.
-func (g *GitPullRequestWithComment) GetPullRequest() *GitPullRequest {
-	if g == nil {
+// GetLastModifiedBy returns the LastModifiedBy field.
+func (q *QueryHierarchyItem) GetLastModifiedBy() *IdentityRef {
+	if q == nil {
 		return nil
 	}
-	return g.PullRequest
+	return q.LastModifiedBy
 }
 
-// GetDate returns the Date field.
-func (g *GitPush) GetDate() *Time {
-	if g == nil {
+// GetLastModifiedDate returns the LastModifiedDate field.
+func (q *QueryHierarchyItem) GetLastModifiedDate() *Time {
+	if q == nil {
 		return nil
 	}
-	return g.Date
+	return q.LastModifiedDate
 }
 
 // GetLinks returns the Links field if it's non-nil, zero value otherwise.
-func (g *GitPush) GetLinks() map[string]Link {
-	if g == nil || g.Links == nil {
+func (q *QueryHierarchyItem) GetLinks() map[string]Link {
+	if q == nil || q.Links == nil {
 		return map[string]Link{}
 	}
-	return *g.Links
+	return *q.Links
 }
 
-// GetPushedBy returns the PushedBy field.
-func (g *GitPush) GetPushedBy() *IdentityRef {
-	if g == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (q *QueryHierarchyItem) GetName() string {
+	if q == nil || q.Name == nil {
+		return ""
 	}
-	return g.PushedBy
+	return *q.Name
 }
 
-// GetPushID returns the PushID field if it's non-nil, zero value otherwise.
-func (g *GitPush) GetPushID() int {
-	if g == nil || g.PushID == nil {
-		return 0
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (q *QueryHierarchyItem) GetPath() string {
+	if q == nil || q.Path == nil {
+		return ""
 	}
-	return *g.PushID
+	return *q.Path
 }
 
-// GetRepository returns the Repository field.
-func (g *GitPush) GetRepository() *GitRepository {
-	if g == nil {
+// GetQueryType returns the QueryType field.
+func (q *QueryHierarchyItem) GetQueryType() *QueryType {
+	if q == nil {
 		return nil
 	}
-	return g.Repository
+	return q.QueryType
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GitPush) GetURL() string {
-	if g == nil || g.URL == nil {
+func (q *QueryHierarchyItem) GetURL() string {
+	if q == nil || q.URL == nil {
 		return ""
 	}
-	return *g.URL
+	return *q.URL
 }
 
-// GetRepository returns the Repository field.
-func (g *GitPushRef) GetRepository() *GitRepository {
-	if g == nil {
+// GetWiql returns the Wiql field if it's non-nil, zero value otherwise.
+func (q *QueryHierarchyItem) GetWiql() string {
+	if q == nil || q.Wiql == nil {
+		return ""
+	}
+	return *q.Wiql
+}
+
+// GetComment returns the Comment field if it's non-nil, zero value otherwise.
+func (r *Release) GetComment() string {
+	if r == nil || r.Comment == nil {
+		return ""
+	}
+	return *r.Comment
+}
+
+// GetCreatedBy returns the CreatedBy field.
+func (r *Release) GetCreatedBy() *IdentityRef {
+	if r == nil {
 		return nil
 	}
-	return g.Repository
+	return r.CreatedBy
 }
 
-// GetCreator returns the Creator field.
-func (g *GitRef) GetCreator() *IdentityRef {
-	if g == nil {
+// GetCreatedOn returns the CreatedOn field.
+func (r *Release) GetCreatedOn() *Time {
+	if r == nil {
 		return nil
 	}
-	return g.Creator
+	return r.CreatedOn
 }
 
-// GetIsLocked returns the IsLocked field if it's non-nil, zero value otherwise.
-func (g *GitRef) GetIsLocked() bool {
-	if g == nil || g.IsLocked == nil {
-		return false
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *Release) GetDescription() string {
+	if r == nil || r.Description == nil {
+		return ""
 	}
-	return *g.IsLocked
+	return *r.Description
 }
 
-// GetIsLockedBy returns the IsLockedBy field.
-func (g *GitRef) GetIsLockedBy() *IdentityRef {
-	if g == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Release) GetID() int {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return g.IsLockedBy
+	return *r.ID
+}
+
+// GetKeepForever returns the KeepForever field if it's non-nil, zero value otherwise.
+func (r *Release) GetKeepForever() bool {
+	if r == nil || r.KeepForever == nil {
+		return false
+	}
+	return *r.KeepForever
 }
 
 // GetLinks returns the Links field if it's non-nil, zero value otherwise.
-func (g *GitRef) GetLinks() map[string]Link {
-	if g == nil || g.Links == nil {
+func (r *Release) GetLinks() map[string]Link {
+	if r == nil || r.Links == nil {
 		return map[string]Link{}
 	}
-	return *g.Links
+	return *r.Links
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (g *GitRef) GetName() string {
-	if g == nil || g.Name == nil {
-		return ""
+// GetModifiedBy returns the ModifiedBy field.
+func (r *Release) GetModifiedBy() *IdentityRef {
+	if r == nil {
+		return nil
 	}
-	return *g.Name
+	return r.ModifiedBy
 }
 
-// GetObjectID returns the ObjectID field if it's non-nil, zero value otherwise.
-func (g *GitRef) GetObjectID() string {
-	if g == nil || g.ObjectID == nil {
+// GetModifiedOn returns the ModifiedOn field.
+func (r *Release) GetModifiedOn() *Time {
+	if r == nil {
+		return nil
+	}
+	return r.ModifiedOn
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *Release) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *g.ObjectID
+	return *r.Name
 }
 
-// GetPeeledObjectID returns the PeeledObjectID field if it's non-nil, zero value otherwise.
-func (g *GitRef) GetPeeledObjectID() string {
-	if g == nil || g.PeeledObjectID == nil {
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (r *Release) GetReason() string {
+	if r == nil || r.Reason == nil {
 		return ""
 	}
-	return *g.PeeledObjectID
+	return *r.Reason
 }
 
-// GetRepository returns the Repository field.
-func (g *GitRef) GetRepository() *GitRepository {
-	if g == nil {
+// GetReleaseDefinition returns the ReleaseDefinition field.
+func (r *Release) GetReleaseDefinition() *ReleaseShallowReference {
+	if r == nil {
 		return nil
 	}
-	return g.Repository
+	return r.ReleaseDefinition
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GitRef) GetURL() string {
-	if g == nil || g.URL == nil {
-		return ""
+// GetReleaseDefinitionRevision returns the ReleaseDefinitionRevision field if it's non-nil, zero value otherwise.
+func (r *Release) GetReleaseDefinitionRevision() int {
+	if r == nil || r.ReleaseDefinitionRevision == nil {
+		return 0
 	}
-	return *g.URL
+	return *r.ReleaseDefinitionRevision
 }
 
-// GetIsLocked returns the IsLocked field if it's non-nil, zero value otherwise.
-func (g *GitRefUpdate) GetIsLocked() bool {
-	if g == nil || g.IsLocked == nil {
-		return false
+// GetStatus returns the Status field.
+func (r *Release) GetStatus() *ReleaseStatus {
+	if r == nil {
+		return nil
 	}
-	return *g.IsLocked
+	return r.Status
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (g *GitRefUpdate) GetName() string {
-	if g == nil || g.Name == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *Release) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *g.Name
+	return *r.URL
 }
 
-// GetNewObjectID returns the NewObjectID field if it's non-nil, zero value otherwise.
-func (g *GitRefUpdate) GetNewObjectID() string {
-	if g == nil || g.NewObjectID == nil {
-		return ""
+// GetApprovalType returns the ApprovalType field.
+func (r *ReleaseApproval) GetApprovalType() *ReleaseApprovalType {
+	if r == nil {
+		return nil
 	}
-	return *g.NewObjectID
+	return r.ApprovalType
 }
 
-// GetOldObjectID returns the OldObjectID field if it's non-nil, zero value otherwise.
-func (g *GitRefUpdate) GetOldObjectID() string {
-	if g == nil || g.OldObjectID == nil {
-		return ""
+// GetApprovedBy returns the ApprovedBy field.
+func (r *ReleaseApproval) GetApprovedBy() *IdentityRef {
+	if r == nil {
+		return nil
 	}
-	return *g.OldObjectID
+	return r.ApprovedBy
 }
 
-// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
-func (g *GitRefUpdate) GetRepositoryID() string {
-	if g == nil || g.RepositoryID == nil {
-		return ""
+// GetApprover returns the Approver field.
+func (r *ReleaseApproval) GetApprover() *IdentityRef {
+	if r == nil {
+		return nil
 	}
-	return *g.RepositoryID
+	return r.Approver
 }
 
-// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
-func (g *GitRepository) GetDefaultBranch() string {
-	if g == nil || g.DefaultBranch == nil {
+// GetAttempt returns the Attempt field if it's non-nil, zero value otherwise.
+func (r *ReleaseApproval) GetAttempt() int {
+	if r == nil || r.Attempt == nil {
+		return 0
+	}
+	return *r.Attempt
+}
+
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (r *ReleaseApproval) GetComments() string {
+	if r == nil || r.Comments == nil {
 		return ""
 	}
-	return *g.DefaultBranch
+	return *r.Comments
+}
+
+// GetCreatedOn returns the CreatedOn field.
+func (r *ReleaseApproval) GetCreatedOn() *Time {
+	if r == nil {
+		return nil
+	}
+	return r.CreatedOn
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GitRepository) GetID() string {
-	if g == nil || g.ID == nil {
-		return ""
+func (r *ReleaseApproval) GetID() int {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *g.ID
+	return *r.ID
 }
 
-// GetIsFork returns the IsFork field if it's non-nil, zero value otherwise.
-func (g *GitRepository) GetIsFork() bool {
-	if g == nil || g.IsFork == nil {
+// GetIsAutomated returns the IsAutomated field if it's non-nil, zero value otherwise.
+func (r *ReleaseApproval) GetIsAutomated() bool {
+	if r == nil || r.IsAutomated == nil {
 		return false
 	}
-	return *g.IsFork
+	return *r.IsAutomated
 }
 
-// GetLinks returns the Links field if it's non-nil, zero value otherwise.
-func (g *GitRepository) GetLinks() map[string]Link {
-	if g == nil || g.Links == nil {
-		return map[string]Link{}
+// GetModifiedOn returns the ModifiedOn field.
+func (r *ReleaseApproval) GetModifiedOn() *Time {
+	if r == nil {
+		return nil
 	}
-	return *g.Links
+	return r.ModifiedOn
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (g *GitRepository) GetName() string {
-	if g == nil || g.Name == nil {
-		return ""
+// GetRank returns the Rank field if it's non-nil, zero value otherwise.
+func (r *ReleaseApproval) GetRank() int {
+	if r == nil || r.Rank == nil {
+		return 0
 	}
-	return *g.Name
+	return *r.Rank
 }
 
-// GetParentRepository returns the ParentRepository field.
-func (g *GitRepository) GetParentRepository() *GitRepositoryRef {
-	if g == nil {
+// GetRelease returns the Release field.
+func (r *ReleaseApproval) GetRelease() *ReleaseShallowReference {
+	if r == nil {
 		return nil
 	}
-	return g.ParentRepository
+	return r.Release
 }
 
-// GetProject returns the Project field.
-func (g *GitRepository) GetProject() *TeamProjectReference {
-	if g == nil {
+// GetReleaseDefinition returns the ReleaseDefinition field.
+func (r *ReleaseApproval) GetReleaseDefinition() *ReleaseShallowReference {
+	if r == nil {
 		return nil
 	}
-	return g.Project
+	return r.ReleaseDefinition
 }
 
-// GetRemoteURL returns the RemoteURL field if it's non-nil, zero value otherwise.
-func (g *GitRepository) GetRemoteURL() string {
-	if g == nil || g.RemoteURL == nil {
-		return ""
+// GetReleaseEnvironment returns the ReleaseEnvironment field.
+func (r *ReleaseApproval) GetReleaseEnvironment() *ReleaseShallowReference {
+	if r == nil {
+		return nil
 	}
-	return *g.RemoteURL
+	return r.ReleaseEnvironment
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (g *GitRepository) GetSize() int {
-	if g == nil || g.Size == nil {
+// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
+func (r *ReleaseApproval) GetRevision() int {
+	if r == nil || r.Revision == nil {
 		return 0
 	}
-	return *g.Size
+	return *r.Revision
 }
 
-// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
-func (g *GitRepository) GetSSHURL() string {
-	if g == nil || g.SSHURL == nil {
-		return ""
+// GetStatus returns the Status field.
+func (r *ReleaseApproval) GetStatus() *ReleaseApprovalStatus {
+	if r == nil {
+		return nil
 	}
-	return *g.SSHURL
+	return r.Status
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GitRepository) GetURL() string {
-	if g == nil || g.URL == nil {
+func (r *ReleaseApproval) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *g.URL
+	return *r.URL
 }
 
-// GetWebURL returns the WebURL field if it's non-nil, zero value otherwise.
-func (g *GitRepository) GetWebURL() string {
-	if g == nil || g.WebURL == nil {
+// GetAlias returns the Alias field if it's non-nil, zero value otherwise.
+func (r *ReleaseArtifact) GetAlias() string {
+	if r == nil || r.Alias == nil {
 		return ""
 	}
-	return *g.WebURL
-}
-
-// GetCollection returns the Collection field.
-func (g *GitRepositoryRef) GetCollection() *TeamProjectCollectionReference {
-	if g == nil {
-		return nil
-	}
-	return g.Collection
+	return *r.Alias
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GitRepositoryRef) GetID() string {
-	if g == nil || g.ID == nil {
-		return ""
+// GetIsPrimary returns the IsPrimary field if it's non-nil, zero value otherwise.
+func (r *ReleaseArtifact) GetIsPrimary() bool {
+	if r == nil || r.IsPrimary == nil {
+		return false
 	}
-	return *g.ID
+	return *r.IsPrimary
 }
 
-// GetIsFork returns the IsFork field if it's non-nil, zero value otherwise.
-func (g *GitRepositoryRef) GetIsFork() bool {
-	if g == nil || g.IsFork == nil {
+// GetIsRetained returns the IsRetained field if it's non-nil, zero value otherwise.
+func (r *ReleaseArtifact) GetIsRetained() bool {
+	if r == nil || r.IsRetained == nil {
 		return false
 	}
-	return *g.IsFork
+	return *r.IsRetained
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (g *GitRepositoryRef) GetName() string {
-	if g == nil || g.Name == nil {
+// GetSourceID returns the SourceID field if it's non-nil, zero value otherwise.
+func (r *ReleaseArtifact) GetSourceID() string {
+	if r == nil || r.SourceID == nil {
 		return ""
 	}
-	return *g.Name
+	return *r.SourceID
 }
 
-// GetProject returns the Project field.
-func (g *GitRepositoryRef) GetProject() *TeamProjectReference {
-	if g == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *ReleaseArtifact) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
 	}
-	return g.Project
+	return *r.Type
 }
 
-// GetRemoteURL returns the RemoteURL field if it's non-nil, zero value otherwise.
-func (g *GitRepositoryRef) GetRemoteURL() string {
-	if g == nil || g.RemoteURL == nil {
+// GetConditionType returns the ConditionType field if it's non-nil, zero value otherwise.
+func (r *ReleaseCondition) GetConditionType() string {
+	if r == nil || r.ConditionType == nil {
 		return ""
 	}
-	return *g.RemoteURL
+	return *r.ConditionType
 }
 
-// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
-func (g *GitRepositoryRef) GetSSHURL() string {
-	if g == nil || g.SSHURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *ReleaseCondition) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *g.SSHURL
+	return *r.Name
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GitRepositoryRef) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (r *ReleaseCondition) GetValue() string {
+	if r == nil || r.Value == nil {
 		return ""
 	}
-	return *g.URL
+	return *r.Value
 }
 
-// GetContext returns the Context field.
-func (g *GitStatus) GetContext() *GitStatusContext {
-	if g == nil {
-		return nil
+// GetComment returns the Comment field if it's non-nil, zero value otherwise.
+func (r *ReleaseDefinition) GetComment() string {
+	if r == nil || r.Comment == nil {
+		return ""
 	}
-	return g.Context
+	return *r.Comment
 }
 
 // GetCreatedBy returns the CreatedBy field.
-func (g *GitStatus) GetCreatedBy() *IdentityRef {
-	if g == nil {
+func (r *ReleaseDefinition) GetCreatedBy() *IdentityRef {
+	if r == nil {
 		return nil
 	}
-	return g.CreatedBy
+	return r.CreatedBy
 }
 
-// GetCreationDate returns the CreationDate field.
-func (g *GitStatus) GetCreationDate() *Time {
-	if g == nil {
+// GetCreatedOn returns the CreatedOn field.
+func (r *ReleaseDefinition) GetCreatedOn() *Time {
+	if r == nil {
 		return nil
 	}
-	return g.CreationDate
+	return r.CreatedOn
 }
 
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (g *GitStatus) GetDescription() string {
-	if g == nil || g.Description == nil {
+func (r *ReleaseDefinition) GetDescription() string {
+	if r == nil || r.Description == nil {
 		return ""
 	}
-	return *g.Description
+	return *r.Description
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GitStatus) GetID() int {
-	if g == nil || g.ID == nil {
+func (r *ReleaseDefinition) GetID() int {
+	if r == nil || r.ID == nil {
 		return 0
 	}
-	return *g.ID
+	return *r.ID
 }
 
-// GetLinks returns the Links field if it's non-nil, zero value otherwise.
-func (g *GitStatus) GetLinks() map[string]Link {
-	if g == nil || g.Links == nil {
-		return map[string]Link{}
+// GetIsDeleted returns the IsDeleted field if it's non-nil, zero value otherwise.
+func (r *ReleaseDefinition) GetIsDeleted() bool {
+	if r == nil || r.IsDeleted == nil {
+		return false
 	}
-	return *g.Links
+	return *r.IsDeleted
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (g *GitStatus) GetState() string {
-	if g == nil || g.State == nil {
-		return ""
+// GetLastRelease returns the LastRelease field.
+func (r *ReleaseDefinition) GetLastRelease() *ReleaseShallowReference {
+	if r == nil {
+		return nil
 	}
-	return *g.State
+	return r.LastRelease
 }
 
-// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
-func (g *GitStatus) GetTargetURL() string {
-	if g == nil || g.TargetURL == nil {
-		return ""
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (r *ReleaseDefinition) GetLinks() map[string]Link {
+	if r == nil || r.Links == nil {
+		return map[string]Link{}
 	}
-	return *g.TargetURL
+	return *r.Links
 }
 
-// GetUpdatedDate returns the UpdatedDate field.
-func (g *GitStatus) GetUpdatedDate() *Time {
-	if g == nil {
+// GetModifiedBy returns the ModifiedBy field.
+func (r *ReleaseDefinition) GetModifiedBy() *IdentityRef {
+	if r == nil {
 		return nil
 	}
-	return g.UpdatedDate
+	return r.ModifiedBy
 }
 
-// GetGenre returns the Genre field if it's non-nil, zero value otherwise.
-func (g *GitStatusContext) GetGenre() string {
-	if g == nil || g.Genre == nil {
-		return ""
+// GetModifiedOn returns the ModifiedOn field.
+func (r *ReleaseDefinition) GetModifiedOn() *Time {
+	if r == nil {
+		return nil
 	}
-	return *g.Genre
+	return r.ModifiedOn
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (g *GitStatusContext) GetName() string {
-	if g == nil || g.Name == nil {
+func (r *ReleaseDefinition) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *g.Name
+	return *r.Name
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (g *GitTemplate) GetName() string {
-	if g == nil || g.Name == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *ReleaseDefinition) GetPath() string {
+	if r == nil || r.Path == nil {
 		return ""
 	}
-	return *g.Name
+	return *r.Path
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (g *GitTemplate) GetType() string {
-	if g == nil || g.Type == nil {
+// GetReleaseNameFormat returns the ReleaseNameFormat field if it's non-nil, zero value otherwise.
+func (r *ReleaseDefinition) GetReleaseNameFormat() string {
+	if r == nil || r.ReleaseNameFormat == nil {
 		return ""
 	}
-	return *g.Type
+	return *r.ReleaseNameFormat
 }
 
-// GetDate returns the Date field.
-func (g *GitUserDate) GetDate() *Time {
-	if g == nil {
-		return nil
+// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
+func (r *ReleaseDefinition) GetRevision() int {
+	if r == nil || r.Revision == nil {
+		return 0
 	}
-	return g.Date
+	return *r.Revision
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (g *GitUserDate) GetEmail() string {
-	if g == nil || g.Email == nil {
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (r *ReleaseDefinition) GetSource() string {
+	if r == nil || r.Source == nil {
 		return ""
 	}
-	return *g.Email
+	return *r.Source
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (g *GitUserDate) GetName() string {
-	if g == nil || g.Name == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *ReleaseDefinition) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *g.Name
+	return *r.URL
 }
 
-// GetValue returns the Value field if it's non-nil, zero value otherwise.
-func (g *GraphDescriptorResult) GetValue() string {
-	if g == nil || g.Value == nil {
-		return ""
+// GetExecutionPolicy returns the ExecutionPolicy field.
+func (r *ReleaseDefinitionEnvironment) GetExecutionPolicy() *EnvironmentExecutionPolicy {
+	if r == nil {
+		return nil
 	}
-	return *g.Value
+	return r.ExecutionPolicy
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (g *GraphGroup) GetDescription() string {
-	if g == nil || g.Description == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *ReleaseDefinitionEnvironment) GetID() int {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *ReleaseDefinitionEnvironment) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *g.Description
+	return *r.Name
 }
 
-// GetIsCrossProject returns the IsCrossProject field if it's non-nil, zero value otherwise.
-func (g *GraphGroup) GetIsCrossProject() bool {
-	if g == nil || g.IsCrossProject == nil {
-		return false
+// GetOwner returns the Owner field.
+func (r *ReleaseDefinitionEnvironment) GetOwner() *IdentityRef {
+	if r == nil {
+		return nil
 	}
-	return *g.IsCrossProject
+	return r.Owner
 }
 
-// GetIsDeleted returns the IsDeleted field if it's non-nil, zero value otherwise.
-func (g *GraphGroup) GetIsDeleted() bool {
-	if g == nil || g.IsDeleted == nil {
-		return false
+// GetRank returns the Rank field if it's non-nil, zero value otherwise.
+func (r *ReleaseDefinitionEnvironment) GetRank() int {
+	if r == nil || r.Rank == nil {
+		return 0
 	}
-	return *g.IsDeleted
+	return *r.Rank
 }
 
-// GetIsGlobalScope returns the IsGlobalScope field if it's non-nil, zero value otherwise.
-func (g *GraphGroup) GetIsGlobalScope() bool {
-	if g == nil || g.IsGlobalScope == nil {
-		return false
+// GetRetentionPolicy returns the RetentionPolicy field.
+func (r *ReleaseDefinitionEnvironment) GetRetentionPolicy() *EnvironmentRetentionPolicy {
+	if r == nil {
+		return nil
 	}
-	return *g.IsGlobalScope
+	return r.RetentionPolicy
 }
 
-// GetIsRestrictedVisible returns the IsRestrictedVisible field if it's non-nil, zero value otherwise.
-func (g *GraphGroup) GetIsRestrictedVisible() bool {
-	if g == nil || g.IsRestrictedVisible == nil {
-		return false
+// GetCreatedOn returns the CreatedOn field.
+func (r *ReleaseEnvironment) GetCreatedOn() *Time {
+	if r == nil {
+		return nil
 	}
-	return *g.IsRestrictedVisible
+	return r.CreatedOn
 }
 
-// GetLocalScopeID returns the LocalScopeID field if it's non-nil, zero value otherwise.
-func (g *GraphGroup) GetLocalScopeID() string {
-	if g == nil || g.LocalScopeID == nil {
-		return ""
+// GetDefinitionEnvironmentID returns the DefinitionEnvironmentID field if it's non-nil, zero value otherwise.
+func (r *ReleaseEnvironment) GetDefinitionEnvironmentID() int {
+	if r == nil || r.DefinitionEnvironmentID == nil {
+		return 0
+	}
+	return *r.DefinitionEnvironmentID
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *ReleaseEnvironment) GetID() int {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *g.LocalScopeID
+	return *r.ID
 }
 
-// GetScopeID returns the ScopeID field if it's non-nil, zero value otherwise.
-func (g *GraphGroup) GetScopeID() string {
-	if g == nil || g.ScopeID == nil {
-		return ""
+// GetModifiedOn returns the ModifiedOn field.
+func (r *ReleaseEnvironment) GetModifiedOn() *Time {
+	if r == nil {
+		return nil
 	}
-	return *g.ScopeID
+	return r.ModifiedOn
 }
 
-// GetScopeName returns the ScopeName field if it's non-nil, zero value otherwise.
-func (g *GraphGroup) GetScopeName() string {
-	if g == nil || g.ScopeName == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *ReleaseEnvironment) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *g.ScopeName
+	return *r.Name
 }
 
-// GetScopeType returns the ScopeType field if it's non-nil, zero value otherwise.
-func (g *GraphGroup) GetScopeType() string {
-	if g == nil || g.ScopeType == nil {
-		return ""
+// GetOwner returns the Owner field.
+func (r *ReleaseEnvironment) GetOwner() *IdentityRef {
+	if r == nil {
+		return nil
 	}
-	return *g.ScopeType
+	return r.Owner
 }
 
-// GetSecuringHostID returns the SecuringHostID field if it's non-nil, zero value otherwise.
-func (g *GraphGroup) GetSecuringHostID() string {
-	if g == nil || g.SecuringHostID == nil {
-		return ""
+// GetPostDeploymentGates returns the PostDeploymentGates field.
+func (r *ReleaseEnvironment) GetPostDeploymentGates() *ReleaseGates {
+	if r == nil {
+		return nil
 	}
-	return *g.SecuringHostID
+	return r.PostDeploymentGates
 }
 
-// GetSpecialType returns the SpecialType field if it's non-nil, zero value otherwise.
-func (g *GraphGroup) GetSpecialType() string {
-	if g == nil || g.SpecialType == nil {
-		return ""
+// GetPreDeploymentGates returns the PreDeploymentGates field.
+func (r *ReleaseEnvironment) GetPreDeploymentGates() *ReleaseGates {
+	if r == nil {
+		return nil
 	}
-	return *g.SpecialType
+	return r.PreDeploymentGates
 }
 
-// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
-func (g *GraphMember) GetDomain() string {
-	if g == nil || g.Domain == nil {
-		return ""
+// GetRank returns the Rank field if it's non-nil, zero value otherwise.
+func (r *ReleaseEnvironment) GetRank() int {
+	if r == nil || r.Rank == nil {
+		return 0
 	}
-	return *g.Domain
+	return *r.Rank
 }
 
-// GetMailAddress returns the MailAddress field if it's non-nil, zero value otherwise.
-func (g *GraphMember) GetMailAddress() string {
-	if g == nil || g.MailAddress == nil {
-		return ""
+// GetRelease returns the Release field.
+func (r *ReleaseEnvironment) GetRelease() *ReleaseShallowReference {
+	if r == nil {
+		return nil
 	}
-	return *g.MailAddress
+	return r.Release
 }
 
-// GetPrincipalName returns the PrincipalName field if it's non-nil, zero value otherwise.
-func (g *GraphMember) GetPrincipalName() string {
-	if g == nil || g.PrincipalName == nil {
-		return ""
+// GetReleaseDefinition returns the ReleaseDefinition field.
+func (r *ReleaseEnvironment) GetReleaseDefinition() *ReleaseShallowReference {
+	if r == nil {
+		return nil
 	}
-	return *g.PrincipalName
+	return r.ReleaseDefinition
 }
 
-// GetLegacyDescriptor returns the LegacyDescriptor field if it's non-nil, zero value otherwise.
-func (g *GraphSubject) GetLegacyDescriptor() string {
-	if g == nil || g.LegacyDescriptor == nil {
-		return ""
+// GetReleaseID returns the ReleaseID field if it's non-nil, zero value otherwise.
+func (r *ReleaseEnvironment) GetReleaseID() int {
+	if r == nil || r.ReleaseID == nil {
+		return 0
 	}
-	return *g.LegacyDescriptor
+	return *r.ReleaseID
 }
 
-// GetOrigin returns the Origin field if it's non-nil, zero value otherwise.
-func (g *GraphSubject) GetOrigin() string {
-	if g == nil || g.Origin == nil {
-		return ""
+// GetScheduledDeploymentTime returns the ScheduledDeploymentTime field.
+func (r *ReleaseEnvironment) GetScheduledDeploymentTime() *Time {
+	if r == nil {
+		return nil
 	}
-	return *g.Origin
+	return r.ScheduledDeploymentTime
 }
 
-// GetOriginID returns the OriginID field if it's non-nil, zero value otherwise.
-func (g *GraphSubject) GetOriginID() string {
-	if g == nil || g.OriginID == nil {
-		return ""
+// GetStatus returns the Status field.
+func (r *ReleaseEnvironment) GetStatus() *EnvironmentStatus {
+	if r == nil {
+		return nil
 	}
-	return *g.OriginID
+	return r.Status
 }
 
-// GetSubjectKind returns the SubjectKind field if it's non-nil, zero value otherwise.
-func (g *GraphSubject) GetSubjectKind() string {
-	if g == nil || g.SubjectKind == nil {
+// GetTriggerReason returns the TriggerReason field if it's non-nil, zero value otherwise.
+func (r *ReleaseEnvironment) GetTriggerReason() string {
+	if r == nil || r.TriggerReason == nil {
 		return ""
 	}
-	return *g.SubjectKind
+	return *r.TriggerReason
 }
 
-// GetDescriptor returns the Descriptor field if it's non-nil, zero value otherwise.
-func (g *GraphSubjectBase) GetDescriptor() string {
-	if g == nil || g.Descriptor == nil {
+// GetComment returns the Comment field if it's non-nil, zero value otherwise.
+func (r *ReleaseEnvironmentUpdateMetadata) GetComment() string {
+	if r == nil || r.Comment == nil {
 		return ""
 	}
-	return *g.Descriptor
+	return *r.Comment
 }
 
-// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
-func (g *GraphSubjectBase) GetDisplayName() string {
-	if g == nil || g.DisplayName == nil {
-		return ""
+// GetScheduledDeploymentTime returns the ScheduledDeploymentTime field.
+func (r *ReleaseEnvironmentUpdateMetadata) GetScheduledDeploymentTime() *Time {
+	if r == nil {
+		return nil
 	}
-	return *g.DisplayName
+	return r.ScheduledDeploymentTime
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GraphSubjectBase) GetURL() string {
-	if g == nil || g.URL == nil {
-		return ""
+// GetStatus returns the Status field.
+func (r *ReleaseEnvironmentUpdateMetadata) GetStatus() *EnvironmentStatus {
+	if r == nil {
+		return nil
 	}
-	return *g.URL
+	return r.Status
 }
 
-// GetIsDeletedInOrigin returns the IsDeletedInOrigin field if it's non-nil, zero value otherwise.
-func (g *GraphUser) GetIsDeletedInOrigin() bool {
-	if g == nil || g.IsDeletedInOrigin == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *ReleaseGates) GetID() int {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *g.IsDeletedInOrigin
+	return *r.ID
 }
 
-// GetMetadataUpdateDate returns the MetadataUpdateDate field.
-func (g *GraphUser) GetMetadataUpdateDate() *Time {
-	if g == nil {
+// GetLastModifiedOn returns the LastModifiedOn field.
+func (r *ReleaseGates) GetLastModifiedOn() *Time {
+	if r == nil {
 		return nil
 	}
-	return g.MetadataUpdateDate
+	return r.LastModifiedOn
 }
 
-// GetMetaType returns the MetaType field if it's non-nil, zero value otherwise.
-func (g *GraphUser) GetMetaType() string {
-	if g == nil || g.MetaType == nil {
+// GetRunPlanID returns the RunPlanID field if it's non-nil, zero value otherwise.
+func (r *ReleaseGates) GetRunPlanID() string {
+	if r == nil || r.RunPlanID == nil {
 		return ""
 	}
-	return *g.MetaType
+	return *r.RunPlanID
 }
 
-// GetDescriptor returns the Descriptor field if it's non-nil, zero value otherwise.
-func (i *IdentityRef) GetDescriptor() string {
-	if i == nil || i.Descriptor == nil {
-		return ""
+// GetStabilizationCompletedOn returns the StabilizationCompletedOn field.
+func (r *ReleaseGates) GetStabilizationCompletedOn() *Time {
+	if r == nil {
+		return nil
 	}
-	return *i.Descriptor
+	return r.StabilizationCompletedOn
 }
 
-// GetDirectoryAlias returns the DirectoryAlias field if it's non-nil, zero value otherwise.
-func (i *IdentityRef) GetDirectoryAlias() string {
-	if i == nil || i.DirectoryAlias == nil {
-		return ""
+// GetStartedOn returns the StartedOn field.
+func (r *ReleaseGates) GetStartedOn() *Time {
+	if r == nil {
+		return nil
 	}
-	return *i.DirectoryAlias
+	return r.StartedOn
 }
 
-// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
-func (i *IdentityRef) GetDisplayName() string {
-	if i == nil || i.DisplayName == nil {
-		return ""
+// GetStatus returns the Status field.
+func (r *ReleaseGates) GetStatus() *GateStatus {
+	if r == nil {
+		return nil
 	}
-	return *i.DisplayName
+	return r.Status
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IdentityRef) GetID() string {
-	if i == nil || i.ID == nil {
-		return ""
+// GetSucceedingSince returns the SucceedingSince field.
+func (r *ReleaseGates) GetSucceedingSince() *Time {
+	if r == nil {
+		return nil
 	}
-	return *i.ID
+	return r.SucceedingSince
 }
 
-// GetImageURL returns the ImageURL field if it's non-nil, zero value otherwise.
-func (i *IdentityRef) GetImageURL() string {
-	if i == nil || i.ImageURL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *ReleaseShallowReference) GetID() int {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *i.ImageURL
+	return *r.ID
 }
 
-// GetInactive returns the Inactive field if it's non-nil, zero value otherwise.
-func (i *IdentityRef) GetInactive() bool {
-	if i == nil || i.Inactive == nil {
-		return false
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (r *ReleaseShallowReference) GetLinks() map[string]Link {
+	if r == nil || r.Links == nil {
+		return map[string]Link{}
 	}
-	return *i.Inactive
+	return *r.Links
 }
 
-// GetIsAadIdentity returns the IsAadIdentity field if it's non-nil, zero value otherwise.
-func (i *IdentityRef) GetIsAadIdentity() bool {
-	if i == nil || i.IsAadIdentity == nil {
-		return false
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *ReleaseShallowReference) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
 	}
-	return *i.IsAadIdentity
+	return *r.Name
 }
 
-// GetIsContainer returns the IsContainer field if it's non-nil, zero value otherwise.
-func (i *IdentityRef) GetIsContainer() bool {
-	if i == nil || i.IsContainer == nil {
-		return false
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *ReleaseShallowReference) GetPath() string {
+	if r == nil || r.Path == nil {
+		return ""
 	}
-	return *i.IsContainer
+	return *r.Path
 }
 
-// GetIsDeletedInOrigin returns the IsDeletedInOrigin field if it's non-nil, zero value otherwise.
-func (i *IdentityRef) GetIsDeletedInOrigin() bool {
-	if i == nil || i.IsDeletedInOrigin == nil {
-		return false
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *ReleaseShallowReference) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return *i.IsDeletedInOrigin
+	return *r.URL
 }
 
-// GetLinks returns the Links field if it's non-nil, zero value otherwise.
-func (i *IdentityRef) GetLinks() map[string]Link {
-	if i == nil || i.Links == nil {
-		return map[string]Link{}
+// GetDefinitionID returns the DefinitionID field if it's non-nil, zero value otherwise.
+func (r *ReleaseStartMetadata) GetDefinitionID() int {
+	if r == nil || r.DefinitionID == nil {
+		return 0
 	}
-	return *i.Links
+	return *r.DefinitionID
 }
 
-// GetProfileURL returns the ProfileURL field if it's non-nil, zero value otherwise.
-func (i *IdentityRef) GetProfileURL() string {
-	if i == nil || i.ProfileURL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *ReleaseStartMetadata) GetDescription() string {
+	if r == nil || r.Description == nil {
 		return ""
 	}
-	return *i.ProfileURL
+	return *r.Description
 }
 
-// GetUniqueName returns the UniqueName field if it's non-nil, zero value otherwise.
-func (i *IdentityRef) GetUniqueName() string {
-	if i == nil || i.UniqueName == nil {
+// GetIsDraft returns the IsDraft field if it's non-nil, zero value otherwise.
+func (r *ReleaseStartMetadata) GetIsDraft() bool {
+	if r == nil || r.IsDraft == nil {
+		return false
+	}
+	return *r.IsDraft
+}
+
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (r *ReleaseStartMetadata) GetReason() string {
+	if r == nil || r.Reason == nil {
 		return ""
 	}
-	return *i.UniqueName
+	return *r.Reason
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *IdentityRef) GetURL() string {
-	if i == nil || i.URL == nil {
+// GetComment returns the Comment field if it's non-nil, zero value otherwise.
+func (r *ReleaseUpdateMetadata) GetComment() string {
+	if r == nil || r.Comment == nil {
 		return ""
 	}
-	return *i.URL
+	return *r.Comment
 }
 
-// GetIsRequired returns the IsRequired field if it's non-nil, zero value otherwise.
-func (i *IdentityRefWithVote) GetIsRequired() bool {
-	if i == nil || i.IsRequired == nil {
+// GetKeepForever returns the KeepForever field if it's non-nil, zero value otherwise.
+func (r *ReleaseUpdateMetadata) GetKeepForever() bool {
+	if r == nil || r.KeepForever == nil {
 		return false
 	}
-	return *i.IsRequired
+	return *r.KeepForever
 }
 
-// GetReviewerURL returns the ReviewerURL field if it's non-nil, zero value otherwise.
-func (i *IdentityRefWithVote) GetReviewerURL() string {
-	if i == nil || i.ReviewerURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *ReleaseUpdateMetadata) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *i.ReviewerURL
+	return *r.Name
 }
 
-// GetVote returns the Vote field if it's non-nil, zero value otherwise.
-func (i *IdentityRefWithVote) GetVote() int {
-	if i == nil || i.Vote == nil {
-		return 0
+// GetStatus returns the Status field.
+func (r *ReleaseUpdateMetadata) GetStatus() *ReleaseStatus {
+	if r == nil {
+		return nil
 	}
-	return *i.Vote
+	return r.Status
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (i *ItemContent) GetContent() string {
-	if i == nil || i.Content == nil {
+// GetRefName returns the RefName field if it's non-nil, zero value otherwise.
+func (r *RepositoryResource) GetRefName() string {
+	if r == nil || r.RefName == nil {
 		return ""
 	}
-	return *i.Content
+	return *r.RefName
 }
 
-// GetContentType returns the ContentType field.
-func (i *ItemContent) GetContentType() *ItemContentType {
-	if i == nil {
+// GetRepository returns the Repository field.
+func (r *RepositoryResource) GetRepository() *PipelineRepository {
+	if r == nil {
 		return nil
 	}
-	return i.ContentType
+	return r.Repository
 }
 
-// GetBase64Encoded returns the Base64Encoded field if it's non-nil, zero value otherwise.
-func (i *ItemContentType) GetBase64Encoded() string {
-	if i == nil || i.Base64Encoded == nil {
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (r *RepositoryResource) GetVersion() string {
+	if r == nil || r.Version == nil {
 		return ""
 	}
-	return *i.Base64Encoded
+	return *r.Version
 }
 
-// GetRawText returns the RawText field if it's non-nil, zero value otherwise.
-func (i *ItemContentType) GetRawText() string {
-	if i == nil || i.RawText == nil {
+// GetRefName returns the RefName field if it's non-nil, zero value otherwise.
+func (r *RepositoryResourceParameters) GetRefName() string {
+	if r == nil || r.RefName == nil {
 		return ""
 	}
-	return *i.RawText
+	return *r.RefName
 }
 
-// GetEndDate returns the EndDate field if it's non-nil, zero value otherwise.
-func (i *Iteration) GetEndDate() string {
-	if i == nil || i.EndDate == nil {
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (r *RepositoryResourceParameters) GetVersion() string {
+	if r == nil || r.Version == nil {
 		return ""
 	}
-	return *i.EndDate
+	return *r.Version
+}
+
+// GetAccount returns the Account field.
+func (r *ResourceContainers) GetAccount() *ResourceRef {
+	if r == nil {
+		return nil
+	}
+	return r.Account
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Iteration) GetID() string {
-	if i == nil || i.ID == nil {
-		return ""
+// GetCollection returns the Collection field.
+func (r *ResourceContainers) GetCollection() *ResourceRef {
+	if r == nil {
+		return nil
 	}
-	return *i.ID
+	return r.Collection
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (i *Iteration) GetName() string {
-	if i == nil || i.Name == nil {
-		return ""
+// GetProject returns the Project field.
+func (r *ResourceContainers) GetProject() *ResourceRef {
+	if r == nil {
+		return nil
 	}
-	return *i.Name
+	return r.Project
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (i *Iteration) GetPath() string {
-	if i == nil || i.Path == nil {
+// GetBaseURL returns the BaseURL field if it's non-nil, zero value otherwise.
+func (r *ResourceRef) GetBaseURL() string {
+	if r == nil || r.BaseURL == nil {
 		return ""
 	}
-	return *i.Path
+	return *r.BaseURL
 }
 
-// GetStartDate returns the StartDate field if it's non-nil, zero value otherwise.
-func (i *Iteration) GetStartDate() string {
-	if i == nil || i.StartDate == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *ResourceRef) GetID() string {
+	if r == nil || r.ID == nil {
 		return ""
 	}
-	return *i.StartDate
+	return *r.ID
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *Iteration) GetURL() string {
-	if i == nil || i.URL == nil {
+func (r *ResourceRef) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *i.URL
+	return *r.URL
 }
 
-// GetLinks returns the Links field if it's non-nil, zero value otherwise.
-func (i *IterationWorkItems) GetLinks() map[string]Link {
-	if i == nil || i.Links == nil {
-		return map[string]Link{}
+// GetDaysToKeep returns the DaysToKeep field if it's non-nil, zero value otherwise.
+func (r *RetentionPolicy) GetDaysToKeep() int {
+	if r == nil || r.DaysToKeep == nil {
+		return 0
 	}
-	return *i.Links
+	return *r.DaysToKeep
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *IterationWorkItems) GetURL() string {
-	if i == nil || i.URL == nil {
-		return ""
+// GetDeleteBuildRecord returns the DeleteBuildRecord field if it's non-nil, zero value otherwise.
+func (r *RetentionPolicy) GetDeleteBuildRecord() bool {
+	if r == nil || r.DeleteBuildRecord == nil {
+		return false
 	}
-	return *i.URL
+	return *r.DeleteBuildRecord
 }
 
-// GetHref returns the Href field if it's non-nil, zero value otherwise.
-func (l *Link) GetHref() string {
-	if l == nil || l.Href == nil {
-		return ""
+// GetDeleteTestResults returns the DeleteTestResults field if it's non-nil, zero value otherwise.
+func (r *RetentionPolicy) GetDeleteTestResults() bool {
+	if r == nil || r.DeleteTestResults == nil {
+		return false
 	}
-	return *l.Href
+	return *r.DeleteTestResults
 }
 
-// GetCount returns the Count field if it's non-nil, zero value otherwise.
-func (l *ListBoardsResponse) GetCount() int {
-	if l == nil || l.Count == nil {
+// GetMinimumToKeep returns the MinimumToKeep field if it's non-nil, zero value otherwise.
+func (r *RetentionPolicy) GetMinimumToKeep() int {
+	if r == nil || r.MinimumToKeep == nil {
 		return 0
 	}
-	return *l.Count
+	return *r.MinimumToKeep
 }
 
-// GetHTML returns the HTML field if it's non-nil, zero value otherwise.
-func (m *Message) GetHTML() string {
-	if m == nil || m.HTML == nil {
-		return ""
+// GetPreviewRun returns the PreviewRun field if it's non-nil, zero value otherwise.
+func (r *RunPipelineParameters) GetPreviewRun() bool {
+	if r == nil || r.PreviewRun == nil {
+		return false
 	}
-	return *m.HTML
+	return *r.PreviewRun
 }
 
-// GetMarkdown returns the Markdown field if it's non-nil, zero value otherwise.
-func (m *Message) GetMarkdown() string {
-	if m == nil || m.Markdown == nil {
-		return ""
+// GetResources returns the Resources field.
+func (r *RunPipelineParameters) GetResources() *RunResourcesParameters {
+	if r == nil {
+		return nil
 	}
-	return *m.Markdown
+	return r.Resources
 }
 
-// GetText returns the Text field if it's non-nil, zero value otherwise.
-func (m *Message) GetText() string {
-	if m == nil || m.Text == nil {
+// GetYamlOverride returns the YamlOverride field if it's non-nil, zero value otherwise.
+func (r *RunPipelineParameters) GetYamlOverride() string {
+	if r == nil || r.YamlOverride == nil {
 		return ""
 	}
-	return *m.Text
+	return *r.YamlOverride
 }
 
-// GetCreatedBy returns the CreatedBy field.
-func (p *PolicyConfiguration) GetCreatedBy() *IdentityRef {
-	if p == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (t *TaskAgentPoolReference) GetID() int {
+	if t == nil || t.ID == nil {
+		return 0
 	}
-	return p.CreatedBy
+	return *t.ID
 }
 
-// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
-func (p *PolicyConfiguration) GetCreatedDate() string {
-	if p == nil || p.CreatedDate == nil {
+// GetIsHosted returns the IsHosted field if it's non-nil, zero value otherwise.
+func (t *TaskAgentPoolReference) GetIsHosted() bool {
+	if t == nil || t.IsHosted == nil {
+		return false
+	}
+	return *t.IsHosted
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *TaskAgentPoolReference) GetName() string {
+	if t == nil || t.Name == nil {
 		return ""
 	}
-	return *p.CreatedDate
+	return *t.Name
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PolicyConfiguration) GetID() int {
-	if p == nil || p.ID == nil {
-		return 0
+// GetDefinitionType returns the DefinitionType field if it's non-nil, zero value otherwise.
+func (t *TaskDefinitionReference) GetDefinitionType() string {
+	if t == nil || t.DefinitionType == nil {
+		return ""
 	}
-	return *p.ID
+	return *t.DefinitionType
 }
 
-// GetIsBlocking returns the IsBlocking field if it's non-nil, zero value otherwise.
-func (p *PolicyConfiguration) GetIsBlocking() bool {
-	if p == nil || p.IsBlocking == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (t *TaskDefinitionReference) GetID() string {
+	if t == nil || t.ID == nil {
+		return ""
 	}
-	return *p.IsBlocking
+	return *t.ID
 }
 
-// GetIsDeleted returns the IsDeleted field if it's non-nil, zero value otherwise.
-func (p *PolicyConfiguration) GetIsDeleted() bool {
-	if p == nil || p.IsDeleted == nil {
-		return false
+// GetVersionSpec returns the VersionSpec field if it's non-nil, zero value otherwise.
+func (t *TaskDefinitionReference) GetVersionSpec() string {
+	if t == nil || t.VersionSpec == nil {
+		return ""
 	}
-	return *p.IsDeleted
+	return *t.VersionSpec
 }
 
-// GetIsEnabled returns the IsEnabled field if it's non-nil, zero value otherwise.
-func (p *PolicyConfiguration) GetIsEnabled() bool {
-	if p == nil || p.IsEnabled == nil {
-		return false
+// GetPlanID returns the PlanID field if it's non-nil, zero value otherwise.
+func (t *TaskOrchestrationPlanReference) GetPlanID() string {
+	if t == nil || t.PlanID == nil {
+		return ""
 	}
-	return *p.IsEnabled
+	return *t.PlanID
 }
 
-// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
-func (p *PolicyConfiguration) GetRevision() int {
-	if p == nil || p.Revision == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *TaskOrchestrationPlanReference) GetType() int {
+	if t == nil || t.Type == nil {
 		return 0
 	}
-	return *p.Revision
+	return *t.Type
 }
 
-// GetType returns the Type field.
-func (p *PolicyConfiguration) GetType() *PolicyTypeRef {
-	if p == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (t *TaskReference) GetID() string {
+	if t == nil || t.ID == nil {
+		return ""
 	}
-	return p.Type
+	return *t.ID
 }
 
-// GetUrl returns the Url field if it's non-nil, zero value otherwise.
-func (p *PolicyConfiguration) GetUrl() string {
-	if p == nil || p.Url == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *TaskReference) GetName() string {
+	if t == nil || t.Name == nil {
 		return ""
 	}
-	return *p.Url
+	return *t.Name
 }
 
-// GetArtifactID returns the ArtifactID field if it's non-nil, zero value otherwise.
-func (p *PolicyEvaluationRecord) GetArtifactID() string {
-	if p == nil || p.ArtifactID == nil {
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (t *TaskReference) GetVersion() string {
+	if t == nil || t.Version == nil {
 		return ""
 	}
-	return *p.ArtifactID
+	return *t.Version
 }
 
-// GetCompletedDate returns the CompletedDate field if it's non-nil, zero value otherwise.
-func (p *PolicyEvaluationRecord) GetCompletedDate() string {
-	if p == nil || p.CompletedDate == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *Team) GetDescription() string {
+	if t == nil || t.Description == nil {
 		return ""
 	}
-	return *p.CompletedDate
+	return *t.Description
 }
 
-// GetConfiguration returns the Configuration field.
-func (p *PolicyEvaluationRecord) GetConfiguration() *PolicyConfiguration {
-	if p == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (t *Team) GetID() string {
+	if t == nil || t.ID == nil {
+		return ""
 	}
-	return p.Configuration
+	return *t.ID
 }
 
-// GetEvaluationID returns the EvaluationID field if it's non-nil, zero value otherwise.
-func (p *PolicyEvaluationRecord) GetEvaluationID() string {
-	if p == nil || p.EvaluationID == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *Team) GetName() string {
+	if t == nil || t.Name == nil {
 		return ""
 	}
-	return *p.EvaluationID
+	return *t.Name
 }
 
-// GetLinks returns the Links field if it's non-nil, zero value otherwise.
-func (p *PolicyEvaluationRecord) GetLinks() map[string]Link {
-	if p == nil || p.Links == nil {
-		return map[string]Link{}
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *Team) GetURL() string {
+	if t == nil || t.URL == nil {
+		return ""
 	}
-	return *p.Links
+	return *t.URL
 }
 
-// GetStartedDate returns the StartedDate field if it's non-nil, zero value otherwise.
-func (p *PolicyEvaluationRecord) GetStartedDate() string {
-	if p == nil || p.StartedDate == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (t *TeamProjectCollectionReference) GetID() string {
+	if t == nil || t.ID == nil {
 		return ""
 	}
-	return *p.StartedDate
+	return *t.ID
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (p *PolicyEvaluationRecord) GetStatus() string {
-	if p == nil || p.Status == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *TeamProjectCollectionReference) GetName() string {
+	if t == nil || t.Name == nil {
 		return ""
 	}
-	return *p.Status
+	return *t.Name
 }
 
-// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
-func (p *PolicyTypeRef) GetDisplayName() string {
-	if p == nil || p.DisplayName == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *TeamProjectCollectionReference) GetURL() string {
+	if t == nil || t.URL == nil {
 		return ""
 	}
-	return *p.DisplayName
+	return *t.URL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PolicyTypeRef) GetID() string {
-	if p == nil || p.ID == nil {
+// GetAbbreviation returns the Abbreviation field if it's non-nil, zero value otherwise.
+func (t *TeamProjectReference) GetAbbreviation() string {
+	if t == nil || t.Abbreviation == nil {
 		return ""
 	}
-	return *p.ID
+	return *t.Abbreviation
 }
 
-// GetUrl returns the Url field if it's non-nil, zero value otherwise.
-func (p *PolicyTypeRef) GetUrl() string {
-	if p == nil || p.Url == nil {
+// GetDefaultTeamImageURL returns the DefaultTeamImageURL field if it's non-nil, zero value otherwise.
+func (t *TeamProjectReference) GetDefaultTeamImageURL() string {
+	if t == nil || t.DefaultTeamImageURL == nil {
 		return ""
 	}
-	return *p.Url
+	return *t.DefaultTeamImageURL
 }
 
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (p *Project) GetDescription() string {
-	if p == nil || p.Description == nil {
+func (t *TeamProjectReference) GetDescription() string {
+	if t == nil || t.Description == nil {
 		return ""
 	}
-	return *p.Description
+	return *t.Description
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *Project) GetID() string {
-	if p == nil || p.ID == nil {
+func (t *TeamProjectReference) GetID() string {
+	if t == nil || t.ID == nil {
 		return ""
 	}
-	return *p.ID
+	return *t.ID
 }
 
 // GetLastUpdateTime returns the LastUpdateTime field.
-func (p *Project) GetLastUpdateTime() *Time {
-	if p == nil {
+func (t *TeamProjectReference) GetLastUpdateTime() *Time {
+	if t == nil {
 		return nil
 	}
-	return p.LastUpdateTime
+	return t.LastUpdateTime
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Project) GetName() string {
-	if p == nil || p.Name == nil {
+func (t *TeamProjectReference) GetName() string {
+	if t == nil || t.Name == nil {
 		return ""
 	}
-	return *p.Name
+	return *t.Name
 }
 
 // GetRevision returns the Revision field if it's non-nil, zero value otherwise.
-func (p *Project) GetRevision() int {
-	if p == nil || p.Revision == nil {
+func (t *TeamProjectReference) GetRevision() int {
+	if t == nil || t.Revision == nil {
 		return 0
 	}
-	return *p.Revision
+	return *t.Revision
 }
 
 // GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *Project) GetState() string {
-	if p == nil || p.State == nil {
+func (t *TeamProjectReference) GetState() string {
+	if t == nil || t.State == nil {
 		return ""
 	}
-	return *p.State
+	return *t.State
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *Project) GetURL() string {
-	if p == nil || p.URL == nil {
+func (t *TeamProjectReference) GetURL() string {
+	if t == nil || t.URL == nil {
 		return ""
 	}
-	return *p.URL
+	return *t.URL
 }
 
 // GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
-func (p *Project) GetVisibility() string {
-	if p == nil || p.Visibility == nil {
+func (t *TeamProjectReference) GetVisibility() string {
+	if t == nil || t.Visibility == nil {
 		return ""
 	}
-	return *p.Visibility
+	return *t.Visibility
 }
 
-// GetAccount returns the Account field.
-func (r *ResourceContainers) GetAccount() *ResourceRef {
-	if r == nil {
-		return nil
+// GetMine returns the Mine field if it's non-nil, zero value otherwise.
+func (t *TeamsListOptions) GetMine() bool {
+	if t == nil || t.Mine == nil {
+		return false
 	}
-	return r.Account
+	return *t.Mine
 }
 
-// GetCollection returns the Collection field.
-func (r *ResourceContainers) GetCollection() *ResourceRef {
-	if r == nil {
-		return nil
+// GetSkip returns the Skip field if it's non-nil, zero value otherwise.
+func (t *TeamsListOptions) GetSkip() int {
+	if t == nil || t.Skip == nil {
+		return 0
 	}
-	return r.Collection
+	return *t.Skip
 }
 
-// GetProject returns the Project field.
-func (r *ResourceContainers) GetProject() *ResourceRef {
-	if r == nil {
-		return nil
+// GetTop returns the Top field if it's non-nil, zero value otherwise.
+func (t *TeamsListOptions) GetTop() int {
+	if t == nil || t.Top == nil {
+		return 0
 	}
-	return r.Project
+	return *t.Top
 }
 
-// GetBaseURL returns the BaseURL field if it's non-nil, zero value otherwise.
-func (r *ResourceRef) GetBaseURL() string {
-	if r == nil || r.BaseURL == nil {
+// GetCompletedDate returns the CompletedDate field if it's non-nil, zero value otherwise.
+func (t *Test) GetCompletedDate() string {
+	if t == nil || t.CompletedDate == nil {
 		return ""
 	}
-	return *r.BaseURL
+	return *t.CompletedDate
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *ResourceRef) GetID() string {
-	if r == nil || r.ID == nil {
+func (t *Test) GetID() int {
+	if t == nil || t.ID == nil {
+		return 0
+	}
+	return *t.ID
+}
+
+// GetIsAutomated returns the IsAutomated field if it's non-nil, zero value otherwise.
+func (t *Test) GetIsAutomated() bool {
+	if t == nil || t.IsAutomated == nil {
+		return false
+	}
+	return *t.IsAutomated
+}
+
+// GetIteration returns the Iteration field if it's non-nil, zero value otherwise.
+func (t *Test) GetIteration() string {
+	if t == nil || t.Iteration == nil {
 		return ""
 	}
-	return *r.ID
+	return *t.Iteration
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *ResourceRef) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *Test) GetName() string {
+	if t == nil || t.Name == nil {
 		return ""
 	}
-	return *r.URL
+	return *t.Name
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (t *TaskAgentPoolReference) GetID() int {
-	if t == nil || t.ID == nil {
+// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
+func (t *Test) GetRevision() int {
+	if t == nil || t.Revision == nil {
 		return 0
 	}
-	return *t.ID
+	return *t.Revision
 }
 
-// GetIsHosted returns the IsHosted field if it's non-nil, zero value otherwise.
-func (t *TaskAgentPoolReference) GetIsHosted() bool {
-	if t == nil || t.IsHosted == nil {
-		return false
+// GetStartedDate returns the StartedDate field if it's non-nil, zero value otherwise.
+func (t *Test) GetStartedDate() string {
+	if t == nil || t.StartedDate == nil {
+		return ""
 	}
-	return *t.IsHosted
+	return *t.StartedDate
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (t *TaskAgentPoolReference) GetName() string {
-	if t == nil || t.Name == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (t *Test) GetState() string {
+	if t == nil || t.State == nil {
 		return ""
 	}
-	return *t.Name
+	return *t.State
 }
 
-// GetPlanID returns the PlanID field if it's non-nil, zero value otherwise.
-func (t *TaskOrchestrationPlanReference) GetPlanID() string {
-	if t == nil || t.PlanID == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *Test) GetURL() string {
+	if t == nil || t.URL == nil {
 		return ""
 	}
-	return *t.PlanID
+	return *t.URL
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (t *TaskOrchestrationPlanReference) GetType() int {
-	if t == nil || t.Type == nil {
+// GetBuildURI returns the BuildURI field if it's non-nil, zero value otherwise.
+func (t *TestsListOptions) GetBuildURI() string {
+	if t == nil || t.BuildURI == nil {
+		return ""
+	}
+	return *t.BuildURI
+}
+
+// GetCount returns the Count field if it's non-nil, zero value otherwise.
+func (t *TestsListOptions) GetCount() int {
+	if t == nil || t.Count == nil {
 		return 0
 	}
-	return *t.Type
+	return *t.Count
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (t *Team) GetDescription() string {
-	if t == nil || t.Description == nil {
-		return ""
+// GetChangeID returns the ChangeID field if it's non-nil, zero value otherwise.
+func (t *Timeline) GetChangeID() int {
+	if t == nil || t.ChangeID == nil {
+		return 0
 	}
-	return *t.Description
+	return *t.ChangeID
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (t *Team) GetID() string {
+func (t *Timeline) GetID() string {
 	if t == nil || t.ID == nil {
 		return ""
 	}
 	return *t.ID
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (t *Team) GetName() string {
-	if t == nil || t.Name == nil {
+// GetLastChangedBy returns the LastChangedBy field if it's non-nil, zero value otherwise.
+func (t *Timeline) GetLastChangedBy() string {
+	if t == nil || t.LastChangedBy == nil {
 		return ""
 	}
-	return *t.Name
+	return *t.LastChangedBy
+}
+
+// GetLastChangedOn returns the LastChangedOn field.
+func (t *Timeline) GetLastChangedOn() *Time {
+	if t == nil {
+		return nil
+	}
+	return t.LastChangedOn
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (t *Team) GetURL() string {
+func (t *Timeline) GetURL() string {
 	if t == nil || t.URL == nil {
 		return ""
 	}
 	return *t.URL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (t *TeamProjectCollectionReference) GetID() string {
-	if t == nil || t.ID == nil {
-		return ""
+// GetJob returns the Job field.
+func (t *TimelineFailure) GetJob() *TimelineRecord {
+	if t == nil {
+		return nil
 	}
-	return *t.ID
+	return t.Job
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (t *TeamProjectCollectionReference) GetName() string {
-	if t == nil || t.Name == nil {
-		return ""
+// GetLogLines returns the LogLines field.
+func (t *TimelineFailure) GetLogLines() *BuildLogOptions {
+	if t == nil {
+		return nil
 	}
-	return *t.Name
+	return t.LogLines
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (t *TeamProjectCollectionReference) GetURL() string {
-	if t == nil || t.URL == nil {
-		return ""
+// GetStage returns the Stage field.
+func (t *TimelineFailure) GetStage() *TimelineRecord {
+	if t == nil {
+		return nil
 	}
-	return *t.URL
+	return t.Stage
 }
 
-// GetAbbreviation returns the Abbreviation field if it's non-nil, zero value otherwise.
-func (t *TeamProjectReference) GetAbbreviation() string {
-	if t == nil || t.Abbreviation == nil {
-		return ""
+// GetTask returns the Task field.
+func (t *TimelineFailure) GetTask() *TimelineRecord {
+	if t == nil {
+		return nil
 	}
-	return *t.Abbreviation
+	return t.Task
 }
 
-// GetDefaultTeamImageURL returns the DefaultTeamImageURL field if it's non-nil, zero value otherwise.
-func (t *TeamProjectReference) GetDefaultTeamImageURL() string {
-	if t == nil || t.DefaultTeamImageURL == nil {
-		return ""
+// GetParent returns the Parent field.
+func (t *TimelineNode) GetParent() *TimelineNode {
+	if t == nil {
+		return nil
 	}
-	return *t.DefaultTeamImageURL
+	return t.Parent
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (t *TeamProjectReference) GetDescription() string {
-	if t == nil || t.Description == nil {
+// GetRecord returns the Record field.
+func (t *TimelineNode) GetRecord() *TimelineRecord {
+	if t == nil {
+		return nil
+	}
+	return t.Record
+}
+
+// GetAttempt returns the Attempt field if it's non-nil, zero value otherwise.
+func (t *TimelineRecord) GetAttempt() int {
+	if t == nil || t.Attempt == nil {
+		return 0
+	}
+	return *t.Attempt
+}
+
+// GetChangeID returns the ChangeID field if it's non-nil, zero value otherwise.
+func (t *TimelineRecord) GetChangeID() int {
+	if t == nil || t.ChangeID == nil {
+		return 0
+	}
+	return *t.ChangeID
+}
+
+// GetCurrentOperation returns the CurrentOperation field if it's non-nil, zero value otherwise.
+func (t *TimelineRecord) GetCurrentOperation() string {
+	if t == nil || t.CurrentOperation == nil {
 		return ""
 	}
-	return *t.Description
+	return *t.CurrentOperation
+}
+
+// GetDetails returns the Details field.
+func (t *TimelineRecord) GetDetails() *TimelineReference {
+	if t == nil {
+		return nil
+	}
+	return t.Details
+}
+
+// GetErrorCount returns the ErrorCount field if it's non-nil, zero value otherwise.
+func (t *TimelineRecord) GetErrorCount() int {
+	if t == nil || t.ErrorCount == nil {
+		return 0
+	}
+	return *t.ErrorCount
+}
+
+// GetFinishTime returns the FinishTime field.
+func (t *TimelineRecord) GetFinishTime() *Time {
+	if t == nil {
+		return nil
+	}
+	return t.FinishTime
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (t *TeamProjectReference) GetID() string {
+func (t *TimelineRecord) GetID() string {
 	if t == nil || t.ID == nil {
 		return ""
 	}
 	return *t.ID
 }
 
-// GetLastUpdateTime returns the LastUpdateTime field.
-func (t *TeamProjectReference) GetLastUpdateTime() *Time {
+// GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
+func (t *TimelineRecord) GetIdentifier() string {
+	if t == nil || t.Identifier == nil {
+		return ""
+	}
+	return *t.Identifier
+}
+
+// GetLastModified returns the LastModified field.
+func (t *TimelineRecord) GetLastModified() *Time {
+	if t == nil {
+		return nil
+	}
+	return t.LastModified
+}
+
+// GetLinks returns the Links field if it's non-nil, zero value otherwise.
+func (t *TimelineRecord) GetLinks() map[string]Link {
+	if t == nil || t.Links == nil {
+		return map[string]Link{}
+	}
+	return *t.Links
+}
+
+// GetLog returns the Log field.
+func (t *TimelineRecord) GetLog() *BuildLogReference {
 	if t == nil {
 		return nil
 	}
-	return t.LastUpdateTime
+	return t.Log
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (t *TeamProjectReference) GetName() string {
+func (t *TimelineRecord) GetName() string {
 	if t == nil || t.Name == nil {
 		return ""
 	}
 	return *t.Name
 }
 
-// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
-func (t *TeamProjectReference) GetRevision() int {
-	if t == nil || t.Revision == nil {
+// GetOrder returns the Order field if it's non-nil, zero value otherwise.
+func (t *TimelineRecord) GetOrder() int {
+	if t == nil || t.Order == nil {
 		return 0
 	}
-	return *t.Revision
+	return *t.Order
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (t *TeamProjectReference) GetState() string {
-	if t == nil || t.State == nil {
+// GetParentID returns the ParentID field if it's non-nil, zero value otherwise.
+func (t *TimelineRecord) GetParentID() string {
+	if t == nil || t.ParentID == nil {
 		return ""
 	}
-	return *t.State
+	return *t.ParentID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (t *TeamProjectReference) GetURL() string {
-	if t == nil || t.URL == nil {
-		return ""
+// GetPercentComplete returns the PercentComplete field if it's non-nil, zero value otherwise.
+func (t *TimelineRecord) GetPercentComplete() int {
+	if t == nil || t.PercentComplete == nil {
+		return 0
 	}
-	return *t.URL
+	return *t.PercentComplete
 }
 
-// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
-func (t *TeamProjectReference) GetVisibility() string {
-	if t == nil || t.Visibility == nil {
-		return ""
+// GetResult returns the Result field.
+func (t *TimelineRecord) GetResult() *TaskResult {
+	if t == nil {
+		return nil
 	}
-	return *t.Visibility
+	return t.Result
 }
 
-// GetMine returns the Mine field if it's non-nil, zero value otherwise.
-func (t *TeamsListOptions) GetMine() bool {
-	if t == nil || t.Mine == nil {
-		return false
+// GetResultCode returns the ResultCode field if it's non-nil, zero value otherwise.
+func (t *TimelineRecord) GetResultCode() string {
+	if t == nil || t.ResultCode == nil {
+		return ""
 	}
-	return *t.Mine
+	return *t.ResultCode
 }
 
-// GetSkip returns the Skip field if it's non-nil, zero value otherwise.
-func (t *TeamsListOptions) GetSkip() int {
-	if t == nil || t.Skip == nil {
-		return 0
+// GetStartTime returns the StartTime field.
+func (t *TimelineRecord) GetStartTime() *Time {
+	if t == nil {
+		return nil
 	}
-	return *t.Skip
+	return t.StartTime
 }
 
-// GetTop returns the Top field if it's non-nil, zero value otherwise.
-func (t *TeamsListOptions) GetTop() int {
-	if t == nil || t.Top == nil {
-		return 0
+// GetState returns the State field.
+func (t *TimelineRecord) GetState() *TimelineRecordState {
+	if t == nil {
+		return nil
 	}
-	return *t.Top
+	return t.State
 }
 
-// GetCompletedDate returns the CompletedDate field if it's non-nil, zero value otherwise.
-func (t *Test) GetCompletedDate() string {
-	if t == nil || t.CompletedDate == nil {
-		return ""
+// GetTask returns the Task field.
+func (t *TimelineRecord) GetTask() *TaskReference {
+	if t == nil {
+		return nil
 	}
-	return *t.CompletedDate
+	return t.Task
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (t *Test) GetID() int {
-	if t == nil || t.ID == nil {
-		return 0
+// GetType returns the Type field.
+func (t *TimelineRecord) GetType() *TimelineRecordType {
+	if t == nil {
+		return nil
 	}
-	return *t.ID
+	return t.Type
 }
 
-// GetIsAutomated returns the IsAutomated field if it's non-nil, zero value otherwise.
-func (t *Test) GetIsAutomated() bool {
-	if t == nil || t.IsAutomated == nil {
-		return false
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *TimelineRecord) GetURL() string {
+	if t == nil || t.URL == nil {
+		return ""
 	}
-	return *t.IsAutomated
+	return *t.URL
 }
 
-// GetIteration returns the Iteration field if it's non-nil, zero value otherwise.
-func (t *Test) GetIteration() string {
-	if t == nil || t.Iteration == nil {
-		return ""
+// GetWarningCount returns the WarningCount field if it's non-nil, zero value otherwise.
+func (t *TimelineRecord) GetWarningCount() int {
+	if t == nil || t.WarningCount == nil {
+		return 0
 	}
-	return *t.Iteration
+	return *t.WarningCount
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (t *Test) GetName() string {
-	if t == nil || t.Name == nil {
+// GetWorkerName returns the WorkerName field if it's non-nil, zero value otherwise.
+func (t *TimelineRecord) GetWorkerName() string {
+	if t == nil || t.WorkerName == nil {
 		return ""
 	}
-	return *t.Name
+	return *t.WorkerName
 }
 
-// GetRevision returns the Revision field if it's non-nil, zero value otherwise.
-func (t *Test) GetRevision() int {
-	if t == nil || t.Revision == nil {
+// GetChangeID returns the ChangeID field if it's non-nil, zero value otherwise.
+func (t *TimelineReference) GetChangeID() int {
+	if t == nil || t.ChangeID == nil {
 		return 0
 	}
-	return *t.Revision
-}
-
-// GetStartedDate returns the StartedDate field if it's non-nil, zero value otherwise.
-func (t *Test) GetStartedDate() string {
-	if t == nil || t.StartedDate == nil {
-		return ""
-	}
-	return *t.StartedDate
+	return *t.ChangeID
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (t *Test) GetState() string {
-	if t == nil || t.State == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (t *TimelineReference) GetID() string {
+	if t == nil || t.ID == nil {
 		return ""
 	}
-	return *t.State
+	return *t.ID
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (t *Test) GetURL() string {
+func (t *TimelineReference) GetURL() string {
 	if t == nil || t.URL == nil {
 		return ""
 	}
 	return *t.URL
 }
 
-// GetBuildURI returns the BuildURI field if it's non-nil, zero value otherwise.
-func (t *TestsListOptions) GetBuildURI() string {
-	if t == nil || t.BuildURI == nil {
-		return ""
-	}
-	return *t.BuildURI
-}
-
-// GetCount returns the Count field if it's non-nil, zero value otherwise.
-func (t *TestsListOptions) GetCount() int {
-	if t == nil || t.Count == nil {
-		return 0
-	}
-	return *t.Count
-}
-
 // GetCiMessage returns the CiMessage field if it's non-nil, zero value otherwise.
 func (t *TriggerInfo) GetCiMessage() string {
 	if t == nil || t.CiMessage == nil {
@@ -3583,6 +7375,22 @@ func (u *UpdateRefsBody) GetRepositoryID() string {
 	return *u.RepositoryID
 }
 
+// GetForceRetryAllJobs returns the ForceRetryAllJobs field if it's non-nil, zero value otherwise.
+func (u *UpdateStageParameters) GetForceRetryAllJobs() bool {
+	if u == nil || u.ForceRetryAllJobs == nil {
+		return false
+	}
+	return *u.ForceRetryAllJobs
+}
+
+// GetState returns the State field.
+func (u *UpdateStageParameters) GetState() *StageUpdateType {
+	if u == nil {
+		return nil
+	}
+	return u.State
+}
+
 // GetMessage returns the Message field if it's non-nil, zero value otherwise.
 func (v *ValidationResult) GetMessage() string {
 	if v == nil || v.Message == nil {
@@ -3599,6 +7407,54 @@ func (v *ValidationResult) GetResult() string {
 	return *v.Result
 }
 
+// GetAlias returns the Alias field if it's non-nil, zero value otherwise.
+func (v *VariableGroup) GetAlias() string {
+	if v == nil || v.Alias == nil {
+		return ""
+	}
+	return *v.Alias
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (v *VariableGroup) GetDescription() string {
+	if v == nil || v.Description == nil {
+		return ""
+	}
+	return *v.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (v *VariableGroup) GetID() int {
+	if v == nil || v.ID == nil {
+		return 0
+	}
+	return *v.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (v *VariableGroup) GetName() string {
+	if v == nil || v.Name == nil {
+		return ""
+	}
+	return *v.Name
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (v *VariableGroup) GetType() string {
+	if v == nil || v.Type == nil {
+		return ""
+	}
+	return *v.Type
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (w *WebAPICreateTagRequestData) GetName() string {
+	if w == nil || w.Name == nil {
+		return ""
+	}
+	return *w.Name
+}
+
 // GetActive returns the Active field if it's non-nil, zero value otherwise.
 func (w *WebAPITagDefinition) GetActive() bool {
 	if w == nil || w.Active == nil {
@@ -3791,6 +7647,110 @@ func (w *WorkItemCommentList) GetURL() string {
 	return *w.URL
 }
 
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (w *WorkItemDelete) GetCode() int {
+	if w == nil || w.Code == nil {
+		return 0
+	}
+	return *w.Code
+}
+
+// GetDeletedBy returns the DeletedBy field if it's non-nil, zero value otherwise.
+func (w *WorkItemDelete) GetDeletedBy() string {
+	if w == nil || w.DeletedBy == nil {
+		return ""
+	}
+	return *w.DeletedBy
+}
+
+// GetDeletedDate returns the DeletedDate field if it's non-nil, zero value otherwise.
+func (w *WorkItemDelete) GetDeletedDate() string {
+	if w == nil || w.DeletedDate == nil {
+		return ""
+	}
+	return *w.DeletedDate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (w *WorkItemDelete) GetID() int {
+	if w == nil || w.ID == nil {
+		return 0
+	}
+	return *w.ID
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (w *WorkItemDelete) GetMessage() string {
+	if w == nil || w.Message == nil {
+		return ""
+	}
+	return *w.Message
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (w *WorkItemDelete) GetName() string {
+	if w == nil || w.Name == nil {
+		return ""
+	}
+	return *w.Name
+}
+
+// GetProject returns the Project field if it's non-nil, zero value otherwise.
+func (w *WorkItemDelete) GetProject() string {
+	if w == nil || w.Project == nil {
+		return ""
+	}
+	return *w.Project
+}
+
+// GetResource returns the Resource field.
+func (w *WorkItemDelete) GetResource() *WorkItem {
+	if w == nil {
+		return nil
+	}
+	return w.Resource
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (w *WorkItemDelete) GetType() string {
+	if w == nil || w.Type == nil {
+		return ""
+	}
+	return *w.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (w *WorkItemDelete) GetURL() string {
+	if w == nil || w.URL == nil {
+		return ""
+	}
+	return *w.URL
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (w *WorkItemFieldReference) GetName() string {
+	if w == nil || w.Name == nil {
+		return ""
+	}
+	return *w.Name
+}
+
+// GetReferenceName returns the ReferenceName field if it's non-nil, zero value otherwise.
+func (w *WorkItemFieldReference) GetReferenceName() string {
+	if w == nil || w.ReferenceName == nil {
+		return ""
+	}
+	return *w.ReferenceName
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (w *WorkItemFieldReference) GetURL() string {
+	if w == nil || w.URL == nil {
+		return ""
+	}
+	return *w.URL
+}
+
 // GetRel returns the Rel field if it's non-nil, zero value otherwise.
 func (w *WorkItemLink) GetRel() string {
 	if w == nil || w.Rel == nil {
@@ -3815,6 +7775,54 @@ func (w *WorkItemLink) GetTarget() *WorkItemReference {
 	return w.Target
 }
 
+// GetWorkItem returns the WorkItem field.
+func (w *WorkItemNode) GetWorkItem() *WorkItem {
+	if w == nil {
+		return nil
+	}
+	return w.WorkItem
+}
+
+// GetAsOf returns the AsOf field.
+func (w *WorkItemQueryResult) GetAsOf() *Time {
+	if w == nil {
+		return nil
+	}
+	return w.AsOf
+}
+
+// GetQueryResultType returns the QueryResultType field.
+func (w *WorkItemQueryResult) GetQueryResultType() *QueryResultType {
+	if w == nil {
+		return nil
+	}
+	return w.QueryResultType
+}
+
+// GetQueryType returns the QueryType field.
+func (w *WorkItemQueryResult) GetQueryType() *QueryType {
+	if w == nil {
+		return nil
+	}
+	return w.QueryType
+}
+
+// GetDescending returns the Descending field if it's non-nil, zero value otherwise.
+func (w *WorkItemQuerySortColumn) GetDescending() bool {
+	if w == nil || w.Descending == nil {
+		return false
+	}
+	return *w.Descending
+}
+
+// GetField returns the Field field.
+func (w *WorkItemQuerySortColumn) GetField() *WorkItemFieldReference {
+	if w == nil {
+		return nil
+	}
+	return w.Field
+}
+
 // GetID returns the ID field if it's non-nil, zero value otherwise.
 func (w *WorkItemReference) GetID() int {
 	if w == nil || w.ID == nil {
@@ -8,9 +8,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"path"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -21,8 +21,14 @@ const (
 	DefaultBaseURL string = "https://dev.azure.com/"
 	// DefaultVsspsBaseURL is the default URI base for some Azure Devops REST API endpoints
 	DefaultVsspsBaseURL string = "https://vssps.dev.azure.com/"
+	// DefaultVsrmBaseURL is the default URI base for release management REST API endpoints
+	DefaultVsrmBaseURL string = "https://vsrm.dev.azure.com/"
 	// userAgent our HTTP client's user-agent
 	userAgent string = "go-azuredevops"
+	// mediaTypeJSONPatch is the content type of JSON Patch request bodies
+	mediaTypeJSONPatch string = "application/json-patch+json"
+	// mediaTypeOctetStream is the content type of raw binary request bodies
+	mediaTypeOctetStream string = "application/octet-stream"
 )
 
 // Client for interacting with the Azure DevOps API
@@ -34,6 +40,8 @@ type Client struct {
 
 	VsspsBaseURL url.URL
 
+	VsrmBaseURL url.URL
+
 	UserAgent string
 
 	// Account Default tenant identifier
@@ -47,8 +55,11 @@ type Client struct {
 	Favourites        *FavouritesService
 	Git               *GitService
 	Iterations        *IterationsService
+	Pipelines         *PipelinesService
 	PolicyEvaluations *PolicyEvaluationsService
+	Projects          *ProjectsService
 	PullRequests      *PullRequestsService
+	Releases          *ReleasesService
 	Teams             *TeamsService
 	Tests             *TestsService
 	Users             *UsersService
@@ -67,10 +78,12 @@ func NewClient(httpClient *http.Client) (*Client, error) {
 	c := &Client{}
 	baseURL, _ := url.Parse(DefaultBaseURL)
 	vsspsBaseURL, _ := url.Parse(DefaultVsspsBaseURL)
+	vsrmBaseURL, _ := url.Parse(DefaultVsrmBaseURL)
 
 	c.client = httpClient
 	c.BaseURL = *baseURL
 	c.VsspsBaseURL = *vsspsBaseURL
+	c.VsrmBaseURL = *vsrmBaseURL
 	c.UserAgent = userAgent
 
 	c.Boards = &BoardsService{client: c}
@@ -80,8 +93,11 @@ func NewClient(httpClient *http.Client) (*Client, error) {
 	c.Favourites = &FavouritesService{client: c}
 	c.Git = &GitService{client: c}
 	c.Iterations = &IterationsService{client: c}
+	c.Pipelines = &PipelinesService{client: c}
 	c.PolicyEvaluations = &PolicyEvaluationsService{client: c}
+	c.Projects = &ProjectsService{client: c}
 	c.PullRequests = &PullRequestsService{client: c}
+	c.Releases = &ReleasesService{client: c}
 	c.Teams = &TeamsService{client: c}
 	c.Tests = &TestsService{client: c}
 	c.Users = &UsersService{client: c}
@@ -130,15 +146,70 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	return req, nil
 }
 
-// Execute sends an API request and returns the API response. The API response is
-// JSON decoded and stored in the value pointed to by r, or returned as an
-// error if an API error has occurred. If r implements the io.Writer
-// interface, the raw response body will be written to r, without attempting to
-// first decode it.
+// NewUploadRequest creates an upload request. A relative URL can be provided in
+// urlStr, in which case it is resolved relative to the BaseURL of the Client.
+// Relative URLs should always be specified without a preceding slash. The
+// request body is streamed from reader. If size is not negative it is used as
+// the request's content length.
+func (c *Client) NewUploadRequest(urlStr string, reader io.Reader, size int64, mediaType string) (*http.Request, error) {
+	if !strings.HasSuffix(c.BaseURL.Path, "/") {
+		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL.String())
+	}
+
+	u, err := c.BaseURL.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	if size >= 0 {
+		req.ContentLength = size
+	}
+
+	if mediaType == "" {
+		mediaType = mediaTypeOctetStream
+	}
+	req.Header.Set("Content-Type", mediaType)
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	return req, nil
+}
+
+// NewJSONPatchRequest creates an API request whose body is a JSON Patch
+// document, sent with the application/json-patch+json content type. A
+// relative URL can be provided in urlStr, in which case it is resolved
+// relative to the BaseURL of the Client.
+func (c *Client) NewJSONPatchRequest(method, urlStr string, patch []*JSONPatchOperation) (*http.Request, error) {
+	req, err := c.NewRequest(method, urlStr, patch)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", mediaTypeJSONPatch)
+	return req, nil
+}
+
+// ErrorResponse reports a response with an unexpected status code from the
+// API.
+type ErrorResponse struct {
+	Response *http.Response
+}
+
+func (r *ErrorResponse) Error() string {
+	return fmt.Sprintf("Request to %s responded with status %d", r.Response.Request.URL, r.Response.StatusCode)
+}
+
+// BareDo sends an API request and returns the API response without reading
+// its body. The caller must close the response body. A response with an
+// unexpected status code is returned alongside an *ErrorResponse, with its
+// body already closed.
 //
 // The provided ctx must be non-nil. If it is canceled or times out,
 // ctx.Err() will be returned.
-func (c *Client) Execute(ctx context.Context, req *http.Request, r interface{}) (*http.Response, error) {
+func (c *Client) BareDo(ctx context.Context, req *http.Request) (*http.Response, error) {
 	req = req.WithContext(ctx)
 	debugReq(req)
 	resp, err := c.client.Do(req)
@@ -161,15 +232,33 @@ func (c *Client) Execute(ctx context.Context, req *http.Request, r interface{})
 
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return nil, fmt.Errorf("Request to %s responded with status %d", req.URL, resp.StatusCode)
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent, http.StatusPartialContent:
+		return resp, nil
+	}
+	resp.Body.Close()
+	return resp, &ErrorResponse{Response: resp}
+}
+
+// Execute sends an API request and returns the API response. The API response is
+// JSON decoded and stored in the value pointed to by r, or returned as an
+// error if an API error has occurred. If r implements the io.Writer
+// interface, the raw response body will be written to r, without attempting to
+// first decode it.
+//
+// The provided ctx must be non-nil. If it is canceled or times out,
+// ctx.Err() will be returned.
+func (c *Client) Execute(ctx context.Context, req *http.Request, r interface{}) (*http.Response, error) {
+	resp, err := c.BareDo(ctx, req)
+	if err != nil {
+		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if r != nil {
 		if w, ok := r.(io.Writer); ok {
-			io.Copy(w, resp.Body)
+			_, err = io.Copy(w, resp.Body)
 		} else {
 			decErr := json.NewDecoder(resp.Body).Decode(r)
 			if decErr == io.EOF {
@@ -259,20 +348,71 @@ func addOptions(s string, opt interface{}) (string, error) {
 	return u.String(), nil
 }
 
+// forEach calls fn for the indexes 0 to n-1 from at most workers
+// goroutines. The first error cancels the context passed to the remaining
+// calls and is returned with the response fn returned alongside it.
+// Otherwise the response is the one returned by the call that finished
+// last.
+func forEach(ctx context.Context, n, workers int, fn func(ctx context.Context, i int) (*http.Response, error)) (*http.Response, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var mu sync.Mutex
+	var lastResp, errResp *http.Response
+	var firstErr error
+
+	for i := 0; i < workers && i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				resp, err := fn(ctx, j)
+				if err != nil {
+					once.Do(func() {
+						errResp, firstErr = resp, err
+						cancel()
+					})
+					continue
+				}
+				mu.Lock()
+				lastResp = resp
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for j := 0; j < n; j++ {
+		select {
+		case jobs <- j:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return errResp, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return lastResp, err
+	}
+	return lastResp, nil
+}
+
 // formatRef helper function for API calls that need a branch reference
 // as an input parameter.  Doesn't do much error checking.
 // Examples:
 // *ref = "mybranch" => *ref = "refs/heads/mybranch"
-// *ref = "refs/heads/abranch" => *ref = "refs/heads/abranch"
-func formatRef(ref *string) error {
-	matched, err := path.Match("refs/heads/*", *ref)
-	if matched && err == nil {
-		return nil
-	} else if err != nil {
-		return err
-	} else {
-		*ref = fmt.Sprintf("refs/heads/%s", *ref)
-		return nil
+// *ref = "feature/x" => *ref = "refs/heads/feature/x"
+// *ref = "refs/heads/feature/x" => *ref = "refs/heads/feature/x"
+func formatRef(ref *string) {
+	if !strings.HasPrefix(*ref, "refs/") {
+		*ref = "refs/heads/" + *ref
 	}
 }
 
@@ -2,7 +2,9 @@ package azuredevops_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -73,6 +75,12 @@ func testFormValues(t *testing.T, r *http.Request, values values) {
 	}
 }
 
+func testHeader(t *testing.T, r *http.Request, header string, want string) {
+	if got := r.Header.Get(header); got != want {
+		t.Errorf("Header.Get(%q) returned %q, want %q", header, got, want)
+	}
+}
+
 func testMethod(t *testing.T, r *http.Request, want string) {
 	if got := r.Method; got != want {
 		t.Errorf("Request method: %v, want %v", got, want)
@@ -244,6 +252,127 @@ func TestNewRequest_errorForNoTrailingSlash(t *testing.T) {
 	}
 }
 
+func TestExecute_statusCodes(t *testing.T) {
+	tt := []struct {
+		status  int
+		wantErr bool
+	}{
+		{status: http.StatusOK},
+		{status: http.StatusCreated},
+		{status: http.StatusNoContent},
+		{status: http.StatusPartialContent},
+		{status: http.StatusBadRequest, wantErr: true},
+		{status: http.StatusNotFound, wantErr: true},
+		{status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(http.StatusText(tc.status), func(t *testing.T) {
+			c, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+			})
+
+			req, _ := c.NewRequest("GET", "status", nil)
+			_, err := c.Execute(context.Background(), req, nil)
+			if !tc.wantErr {
+				if err != nil {
+					t.Errorf("Execute returned error: %v", err)
+				}
+				return
+			}
+
+			errResp, ok := err.(*azuredevops.ErrorResponse)
+			if !ok {
+				t.Fatalf("Execute returned error %v, want *ErrorResponse", err)
+			}
+			if got := errResp.Response.StatusCode; got != tc.status {
+				t.Errorf("ErrorResponse status is %d, want %d", got, tc.status)
+			}
+		})
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestExecute_writerError(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/file", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "content")
+	})
+
+	req, _ := c.NewRequest("GET", "file", nil)
+	if _, err := c.Execute(context.Background(), req, failingWriter{}); err == nil || err.Error() != "disk full" {
+		t.Errorf("Execute returned error %v, want disk full", err)
+	}
+}
+
+func TestBareDo(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/file", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusPartialContent)
+		fmt.Fprint(w, "tent")
+	})
+
+	req, _ := c.NewRequest("GET", "file", nil)
+	resp, err := c.BareDo(context.Background(), req)
+	if err != nil {
+		t.Fatalf("BareDo returned error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	if got, want := string(body), "tent"; got != want {
+		t.Errorf("BareDo body is %q, want %q", got, want)
+	}
+}
+
+func TestNewUploadRequest(t *testing.T) {
+	c, _ := azuredevops.NewClient(nil)
+	req, err := c.NewUploadRequest("upload", bytes.NewReader([]byte("data")), 4, "")
+	if err != nil {
+		t.Fatalf("NewUploadRequest returned unexpected error: %v", err)
+	}
+
+	if got, want := req.Method, "POST"; got != want {
+		t.Errorf("NewUploadRequest() Method is %v, want %v", got, want)
+	}
+	if got, want := req.ContentLength, int64(4); got != want {
+		t.Errorf("NewUploadRequest() ContentLength is %v, want %v", got, want)
+	}
+	if got, want := req.Header.Get("Content-Type"), "application/octet-stream"; got != want {
+		t.Errorf("NewUploadRequest() Content-Type is %v, want %v", got, want)
+	}
+}
+
+func TestNewJSONPatchRequest(t *testing.T) {
+	c, _ := azuredevops.NewClient(nil)
+	patch := []*azuredevops.JSONPatchOperation{{Op: azuredevops.PatchOpRemove, Path: "/1"}}
+	req, err := c.NewJSONPatchRequest("PATCH", "patch", patch)
+	if err != nil {
+		t.Fatalf("NewJSONPatchRequest returned unexpected error: %v", err)
+	}
+
+	if got, want := req.Method, "PATCH"; got != want {
+		t.Errorf("NewJSONPatchRequest() Method is %v, want %v", got, want)
+	}
+	if got, want := req.Header.Get("Content-Type"), "application/json-patch+json"; got != want {
+		t.Errorf("NewJSONPatchRequest() Content-Type is %v, want %v", got, want)
+	}
+	body, _ := ioutil.ReadAll(req.Body)
+	if got, want := string(body), `[{"op":"remove","path":"/1"}]`+"\n"; got != want {
+		t.Errorf("NewJSONPatchRequest() Body is %v, want %v", got, want)
+	}
+}
+
 // Bool is a helper routine that allocates a new bool value
 // to store v and returns a pointer to it.
 func Bool(v bool) *bool { return &v }
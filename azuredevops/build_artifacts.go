@@ -0,0 +1,139 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Artifact resource types
+const (
+	// ArtifactTypeContainer An artifact stored in a file container.
+	ArtifactTypeContainer = "Container"
+	// ArtifactTypePipelineArtifact An artifact stored as a pipeline artifact.
+	ArtifactTypePipelineArtifact = "PipelineArtifact"
+	// ArtifactTypeFilePath An artifact stored on a file share.
+	ArtifactTypeFilePath = "FilePath"
+)
+
+// ArtifactResource Represents the location of a build artifact.
+type ArtifactResource struct {
+	Links       *map[string]Link  `json:"_links,omitempty"`
+	Data        *string           `json:"data,omitempty"`
+	DownloadURL *string           `json:"downloadUrl,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	Type        *string           `json:"type,omitempty"`
+	URL         *string           `json:"url,omitempty"`
+}
+
+// BuildArtifact Represents an artifact produced by a build.
+type BuildArtifact struct {
+	ID       *int              `json:"id,omitempty"`
+	Name     *string           `json:"name,omitempty"`
+	Resource *ArtifactResource `json:"resource,omitempty"`
+	Source   *string           `json:"source,omitempty"`
+}
+
+// BuildArtifactsListResponse describes a build artifacts list response
+type BuildArtifactsListResponse struct {
+	Count     int              `json:"count"`
+	Artifacts []*BuildArtifact `json:"value"`
+}
+
+// ListArtifacts returns the artifacts of a build
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/artifacts/list?view=azure-devops-rest-5.1
+func (s *BuildsService) ListArtifacts(ctx context.Context, owner string, project string, buildID int) ([]*BuildArtifact, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/artifacts?api-version=5.1",
+		owner,
+		project,
+		buildID,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildArtifactsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Artifacts, resp, err
+}
+
+// GetArtifact returns a single artifact of a build by name
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/artifacts/get%20artifact?view=azure-devops-rest-5.1
+func (s *BuildsService) GetArtifact(ctx context.Context, owner string, project string, buildID int, artifactName string) (*BuildArtifact, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/artifacts?artifactName=%s&api-version=5.1",
+		owner,
+		project,
+		buildID,
+		url.QueryEscape(artifactName),
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildArtifact)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// CreateArtifact associates an artifact stored elsewhere, e.g. on a file
+// share, with a build. artifact must have a Name and a Resource.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/artifacts/create?view=azure-devops-rest-5.1
+func (s *BuildsService) CreateArtifact(ctx context.Context, owner string, project string, buildID int, artifact *BuildArtifact) (*BuildArtifact, *http.Response, error) {
+	if artifact.GetName() == "" || artifact.GetResource() == nil {
+		return nil, nil, errors.New("Builds.CreateArtifact: Must supply a Name and Resource")
+	}
+
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/artifacts?api-version=5.1",
+		owner,
+		project,
+		buildID,
+	)
+
+	req, err := s.client.NewRequest("POST", URL, artifact)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildArtifact)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// DownloadArtifact writes a zip archive of an artifact to w, resuming the
+// download after transient failures.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/artifacts/get%20artifact?view=azure-devops-rest-5.1
+func (s *BuildsService) DownloadArtifact(ctx context.Context, owner string, project string, buildID int, artifactName string, w io.Writer, opts *DownloadOptions) (*http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/artifacts?artifactName=%s&$format=zip&api-version=5.1",
+		owner,
+		project,
+		buildID,
+		url.QueryEscape(artifactName),
+	)
+
+	return s.client.download(ctx, URL, "application/zip", w, opts)
+}
+
+// DownloadArtifactFile writes a single file of an artifact to w, resuming
+// the download after transient failures. fileID identifies the file within
+// the artifact's container and fileName is the name the service reports
+// for it.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/artifacts/get%20file?view=azure-devops-rest-5.1
+func (s *BuildsService) DownloadArtifactFile(ctx context.Context, owner string, project string, buildID int, artifactName string, fileID string, fileName string, w io.Writer, opts *DownloadOptions) (*http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/artifacts?artifactName=%s&fileId=%s&fileName=%s&api-version=5.1",
+		owner,
+		project,
+		buildID,
+		url.QueryEscape(artifactName),
+		url.QueryEscape(fileID),
+		url.QueryEscape(fileName),
+	)
+
+	return s.client.download(ctx, URL, mediaTypeOctetStream, w, opts)
+}
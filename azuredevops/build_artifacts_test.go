@@ -0,0 +1,235 @@
+package azuredevops_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+const artifactsURL = "/o/p/_apis/build/builds/1/artifacts"
+
+func TestBuildsService_ListArtifacts(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(artifactsURL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"count": 1, "value": [{"id": 1, "name": "drop", "resource": {"type": "Container", "data": "#/1/drop"}}]}`)
+	})
+
+	artifacts, _, err := c.Builds.ListArtifacts(context.Background(), "o", "p", 1)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if len(artifacts) != 1 || artifacts[0].GetResource().GetType() != azuredevops.ArtifactTypeContainer {
+		t.Fatalf("unexpected artifacts %+v", artifacts)
+	}
+}
+
+func TestBuildsService_GetArtifact(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(artifactsURL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"artifactName": "my drop",
+		})
+		fmt.Fprint(w, `{"id": 1, "name": "my drop"}`)
+	})
+
+	artifact, _, err := c.Builds.GetArtifact(context.Background(), "o", "p", 1, "my drop")
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if artifact.GetName() != "my drop" {
+		t.Fatalf("expected artifact my drop, got %s", artifact.GetName())
+	}
+}
+
+func TestBuildsService_CreateArtifact(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(artifactsURL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"share","resource":{"data":"\\\\server\\drop","type":"FilePath"}}`+"\n")
+		fmt.Fprint(w, `{"id": 2, "name": "share"}`)
+	})
+
+	artifact := &azuredevops.BuildArtifact{
+		Name: String("share"),
+		Resource: &azuredevops.ArtifactResource{
+			Data: String(`\\server\drop`),
+			Type: String(azuredevops.ArtifactTypeFilePath),
+		},
+	}
+	got, _, err := c.Builds.CreateArtifact(context.Background(), "o", "p", 1, artifact)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if got.GetID() != 2 {
+		t.Fatalf("expected artifact ID 2, got %d", got.GetID())
+	}
+
+	_, _, err = c.Builds.CreateArtifact(context.Background(), "o", "p", 1, &azuredevops.BuildArtifact{})
+	if err == nil {
+		t.Fatalf("expected error for an artifact without a name")
+	}
+}
+
+// interruptedHandler serves content, failing the first response after
+// half of it has been sent. ranged controls whether Range requests are
+// honoured when resuming.
+func interruptedHandler(t *testing.T, content string, ranged bool) http.HandlerFunc {
+	attempts := 0
+	return func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+			fmt.Fprint(w, content[:len(content)/2])
+			return
+		}
+
+		want := fmt.Sprintf("bytes=%d-", len(content)/2)
+		testHeader(t, r, "Range", want)
+		if !ranged {
+			fmt.Fprint(w, content)
+			return
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", len(content)/2, len(content)-1, len(content)))
+		w.WriteHeader(http.StatusPartialContent)
+		fmt.Fprint(w, content[len(content)/2:])
+	}
+}
+
+func TestBuildsService_DownloadArtifact(t *testing.T) {
+	tt := []struct {
+		name   string
+		ranged bool
+	}{
+		{name: "resumes with a range request", ranged: true},
+		{name: "resumes when the range is ignored", ranged: false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			c, mux, _, teardown := setup()
+			defer teardown()
+
+			content := "0123456789"
+			handler := interruptedHandler(t, content, tc.ranged)
+			mux.HandleFunc(artifactsURL, func(w http.ResponseWriter, r *http.Request) {
+				testHeader(t, r, "Accept", "application/zip")
+				testFormValues(t, r, values{
+					"artifactName": "drop",
+					"$format":      "zip",
+				})
+				handler(w, r)
+			})
+
+			var buf bytes.Buffer
+			var lastWritten, lastTotal int64
+			opts := &azuredevops.DownloadOptions{
+				RetryDelay: time.Millisecond,
+				Progress: func(written, total int64) {
+					lastWritten, lastTotal = written, total
+				},
+			}
+			_, err := c.Builds.DownloadArtifact(context.Background(), "o", "p", 1, "drop", &buf, opts)
+			if err != nil {
+				t.Fatalf("returned error: %v", err)
+			}
+
+			if buf.String() != content {
+				t.Fatalf("expected %q, got %q", content, buf.String())
+			}
+			if lastWritten != 10 || lastTotal != 10 {
+				t.Fatalf("expected progress 10/10, got %d/%d", lastWritten, lastTotal)
+			}
+		})
+	}
+}
+
+func TestBuildsService_DownloadArtifactFile(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc(artifactsURL, func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{
+			"artifactName": "drop",
+			"fileId":       "ABC",
+			"fileName":     "app.exe",
+		})
+		attempts++
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	var buf bytes.Buffer
+	opts := &azuredevops.DownloadOptions{RetryDelay: time.Millisecond}
+	_, err := c.Builds.DownloadArtifactFile(context.Background(), "o", "p", 1, "drop", "ABC", "app.exe", &buf, opts)
+	if _, ok := err.(*azuredevops.ErrorResponse); !ok {
+		t.Fatalf("expected an *ErrorResponse, got %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected a not found response not to be retried, got %d attempts", attempts)
+	}
+}
+
+func TestBuildsService_DownloadArtifact_retries(t *testing.T) {
+	tt := []struct {
+		name         string
+		status       int
+		wantAttempts int
+	}{
+		{name: "server error", status: http.StatusServiceUnavailable, wantAttempts: 2},
+		{name: "rate limited", status: http.StatusTooManyRequests, wantAttempts: 2},
+		{name: "redirect loop", status: http.StatusFound, wantAttempts: 1},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			c, mux, _, teardown := setup()
+			defer teardown()
+
+			attempts := 0
+			mux.HandleFunc(artifactsURL, func(w http.ResponseWriter, r *http.Request) {
+				// Only the first request of each attempt has no redirect
+				if r.Header.Get("Referer") == "" {
+					attempts++
+				}
+				if tc.status == http.StatusFound {
+					http.Redirect(w, r, r.URL.String(), http.StatusFound)
+					return
+				}
+				if attempts == 1 {
+					w.WriteHeader(tc.status)
+					return
+				}
+				fmt.Fprint(w, "0123456789")
+			})
+
+			var buf bytes.Buffer
+			opts := &azuredevops.DownloadOptions{RetryDelay: time.Millisecond}
+			_, err := c.Builds.DownloadArtifact(context.Background(), "o", "p", 1, "drop", &buf, opts)
+			if tc.wantAttempts == 1 && err == nil {
+				t.Fatalf("expected an error")
+			}
+			if tc.wantAttempts > 1 && (err != nil || buf.String() != "0123456789") {
+				t.Fatalf("expected the download to succeed when retried, got %q and %v", buf.String(), err)
+			}
+			if attempts != tc.wantAttempts {
+				t.Fatalf("expected %d attempts, got %d", tc.wantAttempts, attempts)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// BuildChange Represents a change associated with a build, such as a commit.
+type BuildChange struct {
+	Author           *IdentityRef `json:"author,omitempty"`
+	DisplayURI       *string      `json:"displayUri,omitempty"`
+	ID               *string      `json:"id,omitempty"`
+	Location         *string      `json:"location,omitempty"`
+	Message          *string      `json:"message,omitempty"`
+	MessageTruncated *bool        `json:"messageTruncated,omitempty"`
+	Pusher           *string      `json:"pusher,omitempty"`
+	Timestamp        *Time        `json:"timestamp,omitempty"`
+	Type             *string      `json:"type,omitempty"`
+}
+
+// BuildChangesListResponse describes a build changes list response
+type BuildChangesListResponse struct {
+	Count   int            `json:"count"`
+	Changes []*BuildChange `json:"value"`
+}
+
+// BuildChangesOptions describes what the request to the API should look like
+type BuildChangesOptions struct {
+	// Top The maximum number of changes to return.
+	Top               int    `url:"$top,omitempty"`
+	ContinuationToken string `url:"continuationToken,omitempty"`
+	// IncludeSourceChange Include the source change of the build, even if
+	// it is not new since the previous build.
+	IncludeSourceChange bool `url:"includeSourceChange,omitempty"`
+}
+
+// BuildWorkItemRefsResponse describes a build work item references list response
+type BuildWorkItemRefsResponse struct {
+	Count     int            `json:"count"`
+	WorkItems []*ResourceRef `json:"value"`
+}
+
+// betweenBuildsOptions describes what the request to the API should look like
+type betweenBuildsOptions struct {
+	FromBuildID int `url:"fromBuildId"`
+	ToBuildID   int `url:"toBuildId"`
+	Top         int `url:"$top,omitempty"`
+}
+
+// releaseNotesFields are the work item fields retrieved by GetReleaseNotes
+var releaseNotesFields = []string{
+	"System.Id", "System.Title", "System.State", "System.WorkItemType", "System.AssignedTo",
+}
+
+// ReleaseNotes Describes what went into a range of builds.
+type ReleaseNotes struct {
+	FromBuildID int
+	ToBuildID   int
+	// Changes The changes between the builds.
+	Changes []*BuildChange
+	// WorkItems The associated work items, keyed by their work item type,
+	// e.g. Bug or User Story.
+	WorkItems map[string][]*WorkItem
+}
+
+// WorkItemTypes returns the work item types of the release notes in
+// alphabetical order.
+func (n *ReleaseNotes) WorkItemTypes() []string {
+	types := make([]string, 0, len(n.WorkItems))
+	for t := range n.WorkItems {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// GetChanges returns the changes associated with a build
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/get%20build%20changes?view=azure-devops-rest-5.1
+func (s *BuildsService) GetChanges(ctx context.Context, owner string, project string, buildID int, opts *BuildChangesOptions) ([]*BuildChange, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/changes?api-version=5.1",
+		owner,
+		project,
+		buildID,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildChangesListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Changes, resp, err
+}
+
+// GetChangesBetweenBuilds returns the changes made to the repository
+// between two builds. top limits the number of changes if positive.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/get%20changes%20between%20builds?view=azure-devops-rest-5.1
+func (s *BuildsService) GetChangesBetweenBuilds(ctx context.Context, owner string, project string, fromBuildID int, toBuildID int, top int) ([]*BuildChange, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/changes?api-version=5.1-preview.2",
+		owner,
+		project,
+	)
+	URL, err := addOptions(URL, &betweenBuildsOptions{FromBuildID: fromBuildID, ToBuildID: toBuildID, Top: top})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildChangesListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Changes, resp, err
+}
+
+// GetWorkItemRefs returns references to the work items associated with a
+// build. top limits the number of work items if positive.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/get%20build%20work%20items%20refs?view=azure-devops-rest-5.1
+func (s *BuildsService) GetWorkItemRefs(ctx context.Context, owner string, project string, buildID int, top int) ([]*ResourceRef, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/workitems?api-version=5.1",
+		owner,
+		project,
+		buildID,
+	)
+	if top > 0 {
+		URL = fmt.Sprintf("%s&$top=%d", URL, top)
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildWorkItemRefsResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.WorkItems, resp, err
+}
+
+// GetWorkItemsBetweenBuilds returns references to the work items associated
+// with the changes between two builds. top limits the number of work items
+// if positive.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/get%20work%20items%20between%20builds?view=azure-devops-rest-5.1
+func (s *BuildsService) GetWorkItemsBetweenBuilds(ctx context.Context, owner string, project string, fromBuildID int, toBuildID int, top int) ([]*ResourceRef, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/workitems?api-version=5.1-preview.2",
+		owner,
+		project,
+	)
+	URL, err := addOptions(URL, &betweenBuildsOptions{FromBuildID: fromBuildID, ToBuildID: toBuildID, Top: top})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildWorkItemRefsResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.WorkItems, resp, err
+}
+
+// GetReleaseNotes collects the changes and work items between two builds
+// and groups the work items by their type. The response is the one to the
+// last request made.
+func (s *BuildsService) GetReleaseNotes(ctx context.Context, owner string, project string, fromBuildID int, toBuildID int) (*ReleaseNotes, *http.Response, error) {
+	changes, resp, err := s.GetChangesBetweenBuilds(ctx, owner, project, fromBuildID, toBuildID, 0)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	refs, resp, err := s.GetWorkItemsBetweenBuilds(ctx, owner, project, fromBuildID, toBuildID, 0)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var ids []int
+	for _, ref := range refs {
+		id, err := strconv.Atoi(ref.GetID())
+		if err != nil {
+			return nil, resp, fmt.Errorf("Builds.GetReleaseNotes: invalid work item ID %q", ref.GetID())
+		}
+		ids = append(ids, id)
+	}
+
+	notes := &ReleaseNotes{
+		FromBuildID: fromBuildID,
+		ToBuildID:   toBuildID,
+		Changes:     changes,
+		WorkItems:   map[string][]*WorkItem{},
+	}
+	for start := 0; start < len(ids); start += maxWorkItemsPerRequest {
+		end := start + maxWorkItemsPerRequest
+		if end > len(ids) {
+			end = len(ids)
+		}
+		opts := &WorkItemListOptions{Fields: releaseNotesFields}
+		var workItems []*WorkItem
+		workItems, resp, err = s.client.WorkItems.List(ctx, owner, project, ids[start:end], opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, workItem := range workItems {
+			workItemType := workItem.WorkItemType()
+			notes.WorkItems[workItemType] = append(notes.WorkItems[workItemType], workItem)
+		}
+	}
+
+	return notes, resp, nil
+}
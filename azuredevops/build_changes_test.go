@@ -0,0 +1,111 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+func TestBuildsService_GetChanges(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1/changes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"$top":                "10",
+			"includeSourceChange": "true",
+		})
+		fmt.Fprint(w, `{"count": 1, "value": [{
+			"id": "abc",
+			"message": "Fix login",
+			"type": "TfsGit",
+			"author": {"displayName": "Jamal Hartnett"},
+			"timestamp": "2019-10-01T10:00:00Z"
+		}]}`)
+	})
+
+	opts := &azuredevops.BuildChangesOptions{Top: 10, IncludeSourceChange: true}
+	changes, _, err := c.Builds.GetChanges(context.Background(), "o", "p", 1, opts)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if len(changes) != 1 || changes[0].GetMessage() != "Fix login" || changes[0].GetAuthor().GetDisplayName() != "Jamal Hartnett" {
+		t.Fatalf("unexpected changes %+v", changes)
+	}
+	if changes[0].GetTimestamp().Time.Year() != 2019 {
+		t.Fatalf("expected timestamp in 2019, got %v", changes[0].GetTimestamp())
+	}
+}
+
+func TestBuildsService_GetWorkItemRefs(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1/workitems", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"count": 1, "value": [{"id": "5", "url": "u"}]}`)
+	})
+
+	refs, _, err := c.Builds.GetWorkItemRefs(context.Background(), "o", "p", 1, 0)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if len(refs) != 1 || refs[0].GetID() != "5" {
+		t.Fatalf("unexpected work item refs %+v", refs)
+	}
+}
+
+func TestBuildsService_GetReleaseNotes(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/changes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"fromBuildId": "1",
+			"toBuildId":   "3",
+		})
+		fmt.Fprint(w, `{"count": 2, "value": [{"id": "b"}, {"id": "a"}]}`)
+	})
+	mux.HandleFunc("/o/p/_apis/build/workitems", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"fromBuildId": "1",
+			"toBuildId":   "3",
+		})
+		fmt.Fprint(w, `{"count": 3, "value": [{"id": "5"}, {"id": "6"}, {"id": "7"}]}`)
+	})
+	mux.HandleFunc("/o/p/_apis/wit/workitems", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"ids":    "5,6,7",
+			"fields": "System.Id,System.Title,System.State,System.WorkItemType,System.AssignedTo",
+		})
+		fmt.Fprint(w, `{"count": 3, "value": [
+			{"id": 5, "fields": {"System.WorkItemType": "Bug", "System.Title": "Crash"}},
+			{"id": 6, "fields": {"System.WorkItemType": "User Story", "System.Title": "Login"}},
+			{"id": 7, "fields": {"System.WorkItemType": "Bug", "System.Title": "Typo"}}
+		]}`)
+	})
+
+	notes, _, err := c.Builds.GetReleaseNotes(context.Background(), "o", "p", 1, 3)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if len(notes.Changes) != 2 {
+		t.Fatalf("expected 2 changes, got %d", len(notes.Changes))
+	}
+	if fmt.Sprint(notes.WorkItemTypes()) != "[Bug User Story]" {
+		t.Fatalf("unexpected work item types %v", notes.WorkItemTypes())
+	}
+	if len(notes.WorkItems["Bug"]) != 2 || len(notes.WorkItems["User Story"]) != 1 {
+		t.Fatalf("unexpected work item grouping %+v", notes.WorkItems)
+	}
+}
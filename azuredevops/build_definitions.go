@@ -2,8 +2,12 @@ package azuredevops
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
+	"strings"
 )
 
 // BuildDefinitionsService handles communication with the build definitions methods on the API
@@ -18,24 +22,395 @@ type BuildDefinitionsListResponse struct {
 	BuildDefinitions []*BuildDefinition `json:"value"`
 }
 
+// BuildDefinitionRevisionsListResponse describes the build definition revisions list response
+type BuildDefinitionRevisionsListResponse struct {
+	Count     int                        `json:"count"`
+	Revisions []*BuildDefinitionRevision `json:"value"`
+}
+
 // BuildRepository represents a repository used by a build definition
 type BuildRepository struct {
 	ID                 *string                `json:"id,omitempty"`
 	Type               *string                `json:"type,omitempty"`
 	Name               *string                `json:"name,omitempty"`
 	URL                *string                `json:"url,omitempty"`
-	RootFolder         *string                `json:"root_folder"`
-	Properties         map[string]interface{} `json:"properties"`
-	Clean              *string                `json:"clean"`
-	DefaultBranch      *string                `json:"default_branch"`
-	CheckoutSubmodules *bool                  `json:"checkout_submodules"`
+	RootFolder         *string                `json:"rootFolder,omitempty"`
+	Properties         map[string]interface{} `json:"properties,omitempty"`
+	Clean              *string                `json:"clean,omitempty"`
+	DefaultBranch      *string                `json:"defaultBranch,omitempty"`
+	CheckoutSubmodules *bool                  `json:"checkoutSubmodules,omitempty"`
+}
+
+// Build process types
+const (
+	// BuildProcessDesigner A process made of phases and steps defined in the
+	// classic designer.
+	BuildProcessDesigner = 1
+	// BuildProcessYAML A process defined by a YAML file in the repository.
+	BuildProcessYAML = 2
+)
+
+// BuildProcess Represents the process of a build definition. Type
+// determines which of the remaining fields apply: YamlFilename for
+// BuildProcessYAML, Phases and Target for BuildProcessDesigner.
+type BuildProcess struct {
+	Type         *int                `json:"type,omitempty"`
+	YamlFilename *string             `json:"yamlFilename,omitempty"`
+	Phases       []*BuildPhase       `json:"phases,omitempty"`
+	Target       *BuildProcessTarget `json:"target,omitempty"`
+}
+
+// BuildProcessTarget Represents the target of a designer process.
+type BuildProcessTarget struct {
+	AgentSpecification *AgentSpecification `json:"agentSpecification,omitempty"`
+}
+
+// AgentSpecification Specifies the agent image a process runs on.
+type AgentSpecification struct {
+	Identifier *string `json:"identifier,omitempty"`
+}
+
+// BuildPhase Represents a phase of a designer process.
+type BuildPhase struct {
+	Condition                 *string                             `json:"condition,omitempty"`
+	Dependencies              []*BuildPhaseDependency             `json:"dependencies,omitempty"`
+	JobAuthorizationScope     *string                             `json:"jobAuthorizationScope,omitempty"`
+	JobCancelTimeoutInMinutes *int                                `json:"jobCancelTimeoutInMinutes,omitempty"`
+	JobTimeoutInMinutes       *int                                `json:"jobTimeoutInMinutes,omitempty"`
+	Name                      *string                             `json:"name,omitempty"`
+	RefName                   *string                             `json:"refName,omitempty"`
+	Steps                     []*BuildDefinitionStep              `json:"steps,omitempty"`
+	Variables                 map[string]*BuildDefinitionVariable `json:"variables,omitempty"`
+	// Target The agent, server or deployment target of the phase. Its
+	// shape depends on its "type" and is kept as returned by the service.
+	Target map[string]interface{} `json:"target,omitempty"`
+}
+
+// BuildPhaseDependency Represents a dependency of a phase on another phase.
+type BuildPhaseDependency struct {
+	Event *string `json:"event,omitempty"`
+	Scope *string `json:"scope,omitempty"`
+}
+
+// BuildDefinitionStep Represents a step in a phase.
+type BuildDefinitionStep struct {
+	AlwaysRun        *bool                    `json:"alwaysRun,omitempty"`
+	Condition        *string                  `json:"condition,omitempty"`
+	ContinueOnError  *bool                    `json:"continueOnError,omitempty"`
+	DisplayName      *string                  `json:"displayName,omitempty"`
+	Enabled          *bool                    `json:"enabled,omitempty"`
+	Environment      map[string]string        `json:"environment,omitempty"`
+	Inputs           map[string]string        `json:"inputs,omitempty"`
+	RefName          *string                  `json:"refName,omitempty"`
+	Task             *TaskDefinitionReference `json:"task,omitempty"`
+	TimeoutInMinutes *int                     `json:"timeoutInMinutes,omitempty"`
+}
+
+// TaskDefinitionReference A reference to a task definition.
+type TaskDefinitionReference struct {
+	DefinitionType *string `json:"definitionType,omitempty"`
+	ID             *string `json:"id,omitempty"`
+	VersionSpec    *string `json:"versionSpec,omitempty"`
+}
+
+// DefinitionTriggerType is enum type for the type of a build trigger
+type DefinitionTriggerType string
+
+const (
+	// TriggerContinuousIntegration Builds when changes are pushed.
+	TriggerContinuousIntegration DefinitionTriggerType = "continuousIntegration"
+	// TriggerBatchedContinuousIntegration Builds when changes are pushed,
+	// batching changes while a build is running.
+	TriggerBatchedContinuousIntegration DefinitionTriggerType = "batchedContinuousIntegration"
+	// TriggerSchedule Builds on a schedule.
+	TriggerSchedule DefinitionTriggerType = "schedule"
+	// TriggerGatedCheckIn Builds before changes are checked in.
+	TriggerGatedCheckIn DefinitionTriggerType = "gatedCheckIn"
+	// TriggerPullRequest Builds when a pull request is created or updated.
+	TriggerPullRequest DefinitionTriggerType = "pullRequest"
+	// TriggerBuildCompletion Builds when another build completes.
+	TriggerBuildCompletion DefinitionTriggerType = "buildCompletion"
+)
+
+// Trigger settings source types
+const (
+	// TriggerSettingsFromDefinition The trigger settings are taken from the
+	// build definition.
+	TriggerSettingsFromDefinition = 1
+	// TriggerSettingsFromProcess The trigger settings are taken from the
+	// YAML file of the process.
+	TriggerSettingsFromProcess = 2
+)
+
+// BuildTrigger Represents a trigger of a build definition. TriggerType
+// determines which of the remaining fields apply.
+type BuildTrigger struct {
+	TriggerType *DefinitionTriggerType `json:"triggerType,omitempty"`
+
+	// Continuous integration and pull request triggers
+	BranchFilters      []string `json:"branchFilters,omitempty"`
+	PathFilters        []string `json:"pathFilters,omitempty"`
+	SettingsSourceType *int     `json:"settingsSourceType,omitempty"`
+
+	// Continuous integration triggers
+	BatchChanges                 *bool   `json:"batchChanges,omitempty"`
+	MaxConcurrentBuildsPerBranch *int    `json:"maxConcurrentBuildsPerBranch,omitempty"`
+	PollingInterval              *int    `json:"pollingInterval,omitempty"`
+	PollingJobID                 *string `json:"pollingJobId,omitempty"`
+
+	// Schedule triggers
+	Schedules []*BuildSchedule `json:"schedules,omitempty"`
+
+	// Pull request triggers
+	AutoCancel                           *bool  `json:"autoCancel,omitempty"`
+	Forks                                *Forks `json:"forks,omitempty"`
+	IsCommentRequiredForPullRequest      *bool  `json:"isCommentRequiredForPullRequest,omitempty"`
+	RequireCommentsForNonTeamMembersOnly *bool  `json:"requireCommentsForNonTeamMembersOnly,omitempty"`
+
+	// Build completion triggers
+	Definition              *BuildDefinition `json:"definition,omitempty"`
+	RequiresSuccessfulBuild *bool            `json:"requiresSuccessfulBuild,omitempty"`
+}
+
+// Forks Controls building pull requests from forks.
+type Forks struct {
+	AllowSecrets *bool `json:"allowSecrets,omitempty"`
+	Enabled      *bool `json:"enabled,omitempty"`
+}
+
+// BuildSchedule Represents a schedule of a schedule trigger.
+type BuildSchedule struct {
+	BranchFilters           []string      `json:"branchFilters,omitempty"`
+	DaysToBuild             *ScheduleDays `json:"daysToBuild,omitempty"`
+	ScheduleJobID           *string       `json:"scheduleJobId,omitempty"`
+	ScheduleOnlyWithChanges *bool         `json:"scheduleOnlyWithChanges,omitempty"`
+	StartHours              *int          `json:"startHours,omitempty"`
+	StartMinutes            *int          `json:"startMinutes,omitempty"`
+	TimeZoneID              *string       `json:"timeZoneId,omitempty"`
+}
+
+// ScheduleDays is a set of days of the week a schedule builds on
+type ScheduleDays int
+
+const (
+	// ScheduleNone Do not build.
+	ScheduleNone ScheduleDays = 0
+	// ScheduleMonday Build on Monday.
+	ScheduleMonday ScheduleDays = 1
+	// ScheduleTuesday Build on Tuesday.
+	ScheduleTuesday ScheduleDays = 2
+	// ScheduleWednesday Build on Wednesday.
+	ScheduleWednesday ScheduleDays = 4
+	// ScheduleThursday Build on Thursday.
+	ScheduleThursday ScheduleDays = 8
+	// ScheduleFriday Build on Friday.
+	ScheduleFriday ScheduleDays = 16
+	// ScheduleSaturday Build on Saturday.
+	ScheduleSaturday ScheduleDays = 32
+	// ScheduleSunday Build on Sunday.
+	ScheduleSunday ScheduleDays = 64
+	// ScheduleWeekdays Build Monday through Friday.
+	ScheduleWeekdays ScheduleDays = 31
+	// ScheduleAll Build every day.
+	ScheduleAll ScheduleDays = 127
+)
+
+var scheduleDayNames = map[string]ScheduleDays{
+	"none":      ScheduleNone,
+	"monday":    ScheduleMonday,
+	"tuesday":   ScheduleTuesday,
+	"wednesday": ScheduleWednesday,
+	"thursday":  ScheduleThursday,
+	"friday":    ScheduleFriday,
+	"saturday":  ScheduleSaturday,
+	"sunday":    ScheduleSunday,
+	"weekdays":  ScheduleWeekdays,
+	"all":       ScheduleAll,
+}
+
+// UnmarshalJSON accepts both the numeric form of the days and the comma
+// separated names, e.g. "monday, friday", that the service may return.
+func (d *ScheduleDays) UnmarshalJSON(b []byte) error {
+	var n int
+	if err := json.Unmarshal(b, &n); err == nil {
+		*d = ScheduleDays(n)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	var days ScheduleDays
+	for _, name := range strings.Split(s, ",") {
+		day, ok := scheduleDayNames[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return fmt.Errorf("unknown schedule day %q", name)
+		}
+		days |= day
+	}
+	*d = days
+	return nil
+}
+
+// BuildDefinitionVariable Represents a variable of a build definition.
+type BuildDefinitionVariable struct {
+	AllowOverride *bool   `json:"allowOverride,omitempty"`
+	IsSecret      *bool   `json:"isSecret,omitempty"`
+	Value         *string `json:"value,omitempty"`
+}
+
+// VariableGroup Represents a variable group linked to a build definition.
+type VariableGroup struct {
+	Alias       *string                             `json:"alias,omitempty"`
+	Description *string                             `json:"description,omitempty"`
+	ID          *int                                `json:"id,omitempty"`
+	Name        *string                             `json:"name,omitempty"`
+	Type        *string                             `json:"type,omitempty"`
+	Variables   map[string]*BuildDefinitionVariable `json:"variables,omitempty"`
+}
+
+// RetentionPolicy Represents a retention policy for a build definition.
+type RetentionPolicy struct {
+	Artifacts             []string `json:"artifacts,omitempty"`
+	ArtifactTypesToDelete []string `json:"artifactTypesToDelete,omitempty"`
+	Branches              []string `json:"branches,omitempty"`
+	DaysToKeep            *int     `json:"daysToKeep,omitempty"`
+	DeleteBuildRecord     *bool    `json:"deleteBuildRecord,omitempty"`
+	DeleteTestResults     *bool    `json:"deleteTestResults,omitempty"`
+	MinimumToKeep         *int     `json:"minimumToKeep,omitempty"`
+}
+
+// BuildOption Represents the application of an optional behavior to a
+// build definition.
+type BuildOption struct {
+	Definition *BuildOptionDefinitionReference `json:"definition,omitempty"`
+	Enabled    *bool                           `json:"enabled,omitempty"`
+	Inputs     map[string]string               `json:"inputs,omitempty"`
+}
+
+// BuildOptionDefinitionReference A reference to a build option definition.
+type BuildOptionDefinitionReference struct {
+	ID *string `json:"id,omitempty"`
 }
 
 // BuildDefinition represents a build definition
 type BuildDefinition struct {
-	ID         *int             `json:"id,omitempty"`
-	Name       *string          `json:"name,omitempty"`
-	Repository *BuildRepository `json:"repository,omitempty"`
+	Links                     *map[string]Link                    `json:"_links,omitempty"`
+	AuthoredBy                *IdentityRef                        `json:"authoredBy,omitempty"`
+	BadgeEnabled              *bool                               `json:"badgeEnabled,omitempty"`
+	BuildNumberFormat         *string                             `json:"buildNumberFormat,omitempty"`
+	Comment                   *string                             `json:"comment,omitempty"`
+	CreatedDate               *Time                               `json:"createdDate,omitempty"`
+	Demands                   []*BuildDemand                      `json:"demands,omitempty"`
+	Description               *string                             `json:"description,omitempty"`
+	DropLocation              *string                             `json:"dropLocation,omitempty"`
+	ID                        *int                                `json:"id,omitempty"`
+	JobAuthorizationScope     *string                             `json:"jobAuthorizationScope,omitempty"`
+	JobCancelTimeoutInMinutes *int                                `json:"jobCancelTimeoutInMinutes,omitempty"`
+	JobTimeoutInMinutes       *int                                `json:"jobTimeoutInMinutes,omitempty"`
+	LatestBuild               *Build                              `json:"latestBuild,omitempty"`
+	LatestCompletedBuild      *Build                              `json:"latestCompletedBuild,omitempty"`
+	Name                      *string                             `json:"name,omitempty"`
+	Options                   []*BuildOption                      `json:"options,omitempty"`
+	Path                      *string                             `json:"path,omitempty"`
+	Process                   *BuildProcess                       `json:"process,omitempty"`
+	ProcessParameters         map[string]interface{}              `json:"processParameters,omitempty"`
+	Project                   *TeamProjectReference               `json:"project,omitempty"`
+	Properties                PropertiesCollection                `json:"properties,omitempty"`
+	Quality                   *string                             `json:"quality,omitempty"`
+	Queue                     *AgentPoolQueue                     `json:"queue,omitempty"`
+	QueueStatus               *string                             `json:"queueStatus,omitempty"`
+	Repository                *BuildRepository                    `json:"repository,omitempty"`
+	RetentionRules            []*RetentionPolicy                  `json:"retentionRules,omitempty"`
+	Revision                  *int                                `json:"revision,omitempty"`
+	Tags                      []string                            `json:"tags,omitempty"`
+	Triggers                  []*BuildTrigger                     `json:"triggers,omitempty"`
+	Type                      *string                             `json:"type,omitempty"`
+	URI                       *string                             `json:"uri,omitempty"`
+	URL                       *string                             `json:"url,omitempty"`
+	VariableGroups            []*VariableGroup                    `json:"variableGroups,omitempty"`
+	Variables                 map[string]*BuildDefinitionVariable `json:"variables,omitempty"`
+	// AdditionalProperties The properties returned by the service that
+	// are not modelled above, kept as raw JSON so that an updated
+	// definition is sent back without losing them.
+	AdditionalProperties map[string]json.RawMessage `json:"-"`
+}
+
+// buildDefinition has the fields of BuildDefinition without its JSON
+// methods.
+type buildDefinition BuildDefinition
+
+// buildDefinitionProperties holds the JSON property names modelled by
+// BuildDefinition.
+var buildDefinitionProperties = jsonPropertyNames(reflect.TypeOf(BuildDefinition{}))
+
+// jsonPropertyNames returns the JSON property names of the fields of t.
+func jsonPropertyNames(t reflect.Type) map[string]bool {
+	names := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// UnmarshalJSON decodes a build definition, keeping the properties it does
+// not model in AdditionalProperties.
+func (d *BuildDefinition) UnmarshalJSON(b []byte) error {
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(b, &properties); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, (*buildDefinition)(d)); err != nil {
+		return err
+	}
+
+	d.AdditionalProperties = nil
+	for name, value := range properties {
+		if buildDefinitionProperties[name] {
+			continue
+		}
+		if d.AdditionalProperties == nil {
+			d.AdditionalProperties = map[string]json.RawMessage{}
+		}
+		d.AdditionalProperties[name] = value
+	}
+	return nil
+}
+
+// MarshalJSON encodes a build definition including its
+// AdditionalProperties. Modelled fields take precedence over additional
+// properties of the same name.
+func (d BuildDefinition) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(buildDefinition(d))
+	if err != nil || len(d.AdditionalProperties) == 0 {
+		return b, err
+	}
+
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(b, &properties); err != nil {
+		return nil, err
+	}
+	for name, value := range d.AdditionalProperties {
+		if _, ok := properties[name]; !ok && !buildDefinitionProperties[name] {
+			properties[name] = value
+		}
+	}
+	return json.Marshal(properties)
+}
+
+// BuildDefinitionRevision Represents a revision of a build definition.
+type BuildDefinitionRevision struct {
+	ChangedBy     *IdentityRef `json:"changedBy,omitempty"`
+	ChangedDate   *Time        `json:"changedDate,omitempty"`
+	ChangeType    *string      `json:"changeType,omitempty"`
+	Comment       *string      `json:"comment,omitempty"`
+	DefinitionURL *string      `json:"definitionUrl,omitempty"`
+	Name          *string      `json:"name,omitempty"`
+	Revision      *int         `json:"revision,omitempty"`
 }
 
 // BuildDefinitionsListOptions describes what the request to the API should look like
@@ -44,6 +419,29 @@ type BuildDefinitionsListOptions struct {
 	IncludeAllProperties *bool   `url:"includeAllProperties,omitempty"`
 }
 
+// BuildDefinitionGetOptions describes what the request to the API should look like
+type BuildDefinitionGetOptions struct {
+	// Revision The revision to return. Defaults to the latest revision.
+	Revision            int      `url:"revision,omitempty"`
+	PropertyFilters     []string `url:"propertyFilters,comma,omitempty"`
+	IncludeLatestBuilds bool     `url:"includeLatestBuilds,omitempty"`
+}
+
+// BuildDefinitionCreateOptions describes what the request to the API should look like
+type BuildDefinitionCreateOptions struct {
+	// DefinitionToCloneID The definition the new definition is a clone of,
+	// whose secret variables are copied to it.
+	DefinitionToCloneID       int `url:"definitionToCloneId,omitempty"`
+	DefinitionToCloneRevision int `url:"definitionToCloneRevision,omitempty"`
+}
+
+// BuildDefinitionUpdateOptions describes what the request to the API should look like
+type BuildDefinitionUpdateOptions struct {
+	// SecretsSourceDefinitionID The definition to copy secret variables from.
+	SecretsSourceDefinitionID       int `url:"secretsSourceDefinitionId,omitempty"`
+	SecretsSourceDefinitionRevision int `url:"secretsSourceDefinitionRevision,omitempty"`
+}
+
 // List returns a list of build definitions
 // utilising https://docs.microsoft.com/en-gb/rest/api/vsts/build/definitions/list
 func (s *BuildDefinitionsService) List(ctx context.Context, owner string, project string, opts *BuildDefinitionsListOptions) ([]*BuildDefinition, *http.Response, error) {
@@ -52,6 +450,9 @@ func (s *BuildDefinitionsService) List(ctx context.Context, owner string, projec
 		project,
 	)
 	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := s.client.NewRequest("GET", URL, nil)
 	if err != nil {
@@ -62,3 +463,166 @@ func (s *BuildDefinitionsService) List(ctx context.Context, owner string, projec
 
 	return r.BuildDefinitions, resp, err
 }
+
+// Get returns a build definition, optionally at a given revision
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/definitions/get?view=azure-devops-rest-5.1
+func (s *BuildDefinitionsService) Get(ctx context.Context, owner string, project string, definitionID int, opts *BuildDefinitionGetOptions) (*BuildDefinition, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/definitions/%d?api-version=5.1",
+		owner,
+		project,
+		definitionID,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildDefinition)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// Create creates a new build definition
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/definitions/create?view=azure-devops-rest-5.1
+func (s *BuildDefinitionsService) Create(ctx context.Context, owner string, project string, definition *BuildDefinition, opts *BuildDefinitionCreateOptions) (*BuildDefinition, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/definitions?api-version=5.1",
+		owner,
+		project,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("POST", URL, definition)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildDefinition)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// Update replaces a build definition. definition must have its ID and the
+// Revision it was read at; the update fails if the definition has been
+// changed since. Properties the BuildDefinition does not model are sent
+// from AdditionalProperties, so a definition read with Get is updated
+// without losing them.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/definitions/update?view=azure-devops-rest-5.1
+func (s *BuildDefinitionsService) Update(ctx context.Context, owner string, project string, definition *BuildDefinition, opts *BuildDefinitionUpdateOptions) (*BuildDefinition, *http.Response, error) {
+	if definition.GetID() == 0 || definition.GetRevision() == 0 {
+		return nil, nil, errors.New("BuildDefinitions.Update: Must supply an ID and Revision")
+	}
+
+	URL := fmt.Sprintf("%s/%s/_apis/build/definitions/%d?api-version=5.1",
+		owner,
+		project,
+		definition.GetID(),
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("PUT", URL, definition)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildDefinition)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// Delete deletes a build definition and all associated builds
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/definitions/delete?view=azure-devops-rest-5.1
+func (s *BuildDefinitionsService) Delete(ctx context.Context, owner string, project string, definitionID int) (*http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/definitions/%d?api-version=5.1",
+		owner,
+		project,
+		definitionID,
+	)
+
+	req, err := s.client.NewRequest("DELETE", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Execute(ctx, req, nil)
+}
+
+// Restore restores a deleted build definition
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/definitions/restore%20definition?view=azure-devops-rest-5.1
+func (s *BuildDefinitionsService) Restore(ctx context.Context, owner string, project string, definitionID int) (*BuildDefinition, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/definitions/%d?deleted=false&api-version=5.1",
+		owner,
+		project,
+		definitionID,
+	)
+
+	req, err := s.client.NewRequest("PATCH", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildDefinition)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// ListRevisions returns the revision history of a build definition
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/definitions/get%20definition%20revisions?view=azure-devops-rest-5.1
+func (s *BuildDefinitionsService) ListRevisions(ctx context.Context, owner string, project string, definitionID int) ([]*BuildDefinitionRevision, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/definitions/%d/revisions?api-version=5.1",
+		owner,
+		project,
+		definitionID,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildDefinitionRevisionsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Revisions, resp, err
+}
+
+// Clone creates a copy of a build definition, including its secret
+// variables, with a new name. The copy is created in the same folder
+// unless path is set.
+func (s *BuildDefinitionsService) Clone(ctx context.Context, owner string, project string, definitionID int, name string, path string) (*BuildDefinition, *http.Response, error) {
+	source, resp, err := s.Get(ctx, owner, project, definitionID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	opts := &BuildDefinitionCreateOptions{
+		DefinitionToCloneID:       definitionID,
+		DefinitionToCloneRevision: source.GetRevision(),
+	}
+
+	clone := *source
+	clone.Links = nil
+	clone.AuthoredBy = nil
+	clone.CreatedDate = nil
+	clone.ID = nil
+	clone.LatestBuild = nil
+	clone.LatestCompletedBuild = nil
+	clone.Revision = nil
+	clone.URI = nil
+	clone.URL = nil
+	clone.Name = &name
+	if path != "" {
+		clone.Path = &path
+	}
+
+	return s.Create(ctx, owner, project, &clone, opts)
+}
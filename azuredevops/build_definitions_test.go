@@ -73,3 +73,229 @@ func TestBuildDefinitionsService_List(t *testing.T) {
 		})
 	}
 }
+
+const buildDefinitionGetResponse = `{
+	"id": 5,
+	"name": "ci",
+	"path": "\\services",
+	"revision": 7,
+	"process": {"type": 2, "yamlFilename": "azure-pipelines.yml"},
+	"queue": {"id": 11, "name": "Hosted Ubuntu 1604", "pool": {"id": 3, "isHosted": true}},
+	"repository": {"id": "r", "type": "TfsGit", "defaultBranch": "refs/heads/master", "clean": "true", "checkoutSubmodules": false},
+	"triggers": [
+		{"triggerType": "continuousIntegration", "branchFilters": ["+master"], "batchChanges": true, "settingsSourceType": 2},
+		{"triggerType": "schedule", "schedules": [{"branchFilters": ["+master"], "daysToBuild": "monday, friday", "startHours": 3}]},
+		{"triggerType": "pullRequest", "forks": {"enabled": true, "allowSecrets": false}, "autoCancel": true}
+	],
+	"variables": {"config": {"value": "release", "allowOverride": true}, "token": {"isSecret": true}},
+	"variableGroups": [{"id": 2, "name": "shared"}],
+	"retentionRules": [{"branches": ["+refs/heads/*"], "daysToKeep": 10, "minimumToKeep": 1}]
+}`
+
+func TestBuildDefinitionsService_Get(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/definitions/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"revision": "6"})
+		fmt.Fprint(w, buildDefinitionGetResponse)
+	})
+
+	opts := &azuredevops.BuildDefinitionGetOptions{Revision: 6}
+	def, _, err := c.BuildDefinitions.Get(context.Background(), "o", "p", 5, opts)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if def.GetPath() != `\services` || def.GetRevision() != 7 {
+		t.Errorf("expected path and revision, got %q and %d", def.GetPath(), def.GetRevision())
+	}
+	if def.GetProcess().GetType() != azuredevops.BuildProcessYAML || def.GetProcess().GetYamlFilename() != "azure-pipelines.yml" {
+		t.Errorf("unexpected process %+v", def.GetProcess())
+	}
+	if !def.GetQueue().GetPool().GetIsHosted() {
+		t.Errorf("expected hosted pool")
+	}
+	if def.GetRepository().GetDefaultBranch() != "refs/heads/master" {
+		t.Errorf("expected default branch, got %q", def.GetRepository().GetDefaultBranch())
+	}
+	if len(def.Triggers) != 3 {
+		t.Fatalf("expected 3 triggers, got %d", len(def.Triggers))
+	}
+	if *def.Triggers[0].GetTriggerType() != azuredevops.TriggerContinuousIntegration || !def.Triggers[0].GetBatchChanges() {
+		t.Errorf("unexpected CI trigger %+v", def.Triggers[0])
+	}
+	days := def.Triggers[1].Schedules[0].GetDaysToBuild()
+	if *days != azuredevops.ScheduleMonday|azuredevops.ScheduleFriday {
+		t.Errorf("expected monday and friday, got %d", *days)
+	}
+	if !def.Triggers[2].GetForks().GetEnabled() || !def.Triggers[2].GetAutoCancel() {
+		t.Errorf("unexpected PR trigger %+v", def.Triggers[2])
+	}
+	if def.Variables["config"].GetValue() != "release" || !def.Variables["token"].GetIsSecret() {
+		t.Errorf("unexpected variables %+v", def.Variables)
+	}
+	if def.VariableGroups[0].GetID() != 2 || def.RetentionRules[0].GetDaysToKeep() != 10 {
+		t.Errorf("unexpected variable groups or retention rules")
+	}
+}
+
+func TestBuildDefinitionsService_Create(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/definitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"ci","path":"\\services","process":{"type":2,"yamlFilename":"azure-pipelines.yml"},"queue":{"id":11}}`+"\n")
+		fmt.Fprint(w, `{"id": 5, "name": "ci", "revision": 1}`)
+	})
+
+	def := &azuredevops.BuildDefinition{
+		Name: azuredevops.String("ci"),
+		Path: azuredevops.String(`\services`),
+		Process: &azuredevops.BuildProcess{
+			Type:         azuredevops.Int(azuredevops.BuildProcessYAML),
+			YamlFilename: azuredevops.String("azure-pipelines.yml"),
+		},
+		Queue: &azuredevops.AgentPoolQueue{ID: azuredevops.Int(11)},
+	}
+	got, _, err := c.BuildDefinitions.Create(context.Background(), "o", "p", def, nil)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if got.GetID() != 5 || got.GetRevision() != 1 {
+		t.Errorf("expected created definition, got %+v", got)
+	}
+}
+
+func TestBuildDefinitionsService_Update(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/definitions/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"id":5,"name":"ci-renamed","revision":7}`+"\n")
+		fmt.Fprint(w, `{"id": 5, "name": "ci-renamed", "revision": 8}`)
+	})
+
+	_, _, err := c.BuildDefinitions.Update(context.Background(), "o", "p", &azuredevops.BuildDefinition{ID: azuredevops.Int(5)}, nil)
+	if err == nil {
+		t.Fatal("expected an error without a revision")
+	}
+
+	def := &azuredevops.BuildDefinition{
+		ID:       azuredevops.Int(5),
+		Name:     azuredevops.String("ci-renamed"),
+		Revision: azuredevops.Int(7),
+	}
+	got, _, err := c.BuildDefinitions.Update(context.Background(), "o", "p", def, nil)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if got.GetRevision() != 8 {
+		t.Errorf("expected revision 8, got %d", got.GetRevision())
+	}
+}
+
+func TestBuildDefinitionsService_Update_keepsUnknownProperties(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/definitions/5", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id": 5, "name": "ci", "revision": 7, "badgeEnabled": true, "jobSettings": {"parallel": 2}, "tagsToKeep": ["a"]}`)
+		case "PUT":
+			testBody(t, r, `{"badgeEnabled":true,"id":5,"jobSettings":{"parallel":2},"name":"ci-renamed","revision":7,"tagsToKeep":["a"]}`+"\n")
+			fmt.Fprint(w, `{"id": 5, "name": "ci-renamed", "revision": 8, "jobSettings": {"parallel": 2}}`)
+		}
+	})
+
+	def, _, err := c.BuildDefinitions.Get(context.Background(), "o", "p", 5, nil)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if len(def.AdditionalProperties) != 2 || string(def.AdditionalProperties["jobSettings"]) != `{"parallel": 2}` {
+		t.Fatalf("expected jobSettings and tagsToKeep to be kept, got %v", def.AdditionalProperties)
+	}
+
+	def.Name = azuredevops.String("ci-renamed")
+	got, _, err := c.BuildDefinitions.Update(context.Background(), "o", "p", def, nil)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if _, ok := got.AdditionalProperties["jobSettings"]; !ok {
+		t.Errorf("expected jobSettings in the updated definition, got %v", got.AdditionalProperties)
+	}
+}
+
+func TestBuildDefinitionsService_DeleteRestore(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/definitions/5", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		case "PATCH":
+			testFormValues(t, r, values{"deleted": "false"})
+			fmt.Fprint(w, `{"id": 5, "name": "ci"}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	if _, err := c.BuildDefinitions.Delete(context.Background(), "o", "p", 5); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	def, _, err := c.BuildDefinitions.Restore(context.Background(), "o", "p", 5)
+	if err != nil {
+		t.Fatalf("Restore returned error: %v", err)
+	}
+	if def.GetName() != "ci" {
+		t.Errorf("expected restored definition, got %+v", def)
+	}
+}
+
+func TestBuildDefinitionsService_ListRevisions(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/definitions/5/revisions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"count": 2, "value": [{"revision": 2, "changeType": "update"}, {"revision": 1, "changeType": "add"}]}`)
+	})
+
+	revisions, _, err := c.BuildDefinitions.ListRevisions(context.Background(), "o", "p", 5)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if len(revisions) != 2 || revisions[1].GetChangeType() != "add" {
+		t.Errorf("unexpected revisions %+v", revisions)
+	}
+}
+
+func TestBuildDefinitionsService_Clone(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/definitions/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 5, "name": "ci", "path": "\\services", "revision": 7, "url": "u", "process": {"type": 2, "yamlFilename": "a.yml"}}`)
+	})
+	mux.HandleFunc("/o/p/_apis/build/definitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testFormValues(t, r, values{"definitionToCloneId": "5", "definitionToCloneRevision": "7"})
+		testBody(t, r, `{"name":"ci-copy","path":"\\services","process":{"type":2,"yamlFilename":"a.yml"}}`+"\n")
+		fmt.Fprint(w, `{"id": 6, "name": "ci-copy"}`)
+	})
+
+	def, _, err := c.BuildDefinitions.Clone(context.Background(), "o", "p", 5, "ci-copy", "")
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if def.GetID() != 6 {
+		t.Errorf("expected cloned definition 6, got %d", def.GetID())
+	}
+}
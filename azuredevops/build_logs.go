@@ -0,0 +1,198 @@
+package azuredevops
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const defaultTailLogPollInterval = 5 * time.Second
+
+// BuildLog Represents a build log.
+type BuildLog struct {
+	ID            *int    `json:"id,omitempty"`
+	Type          *string `json:"type,omitempty"`
+	URL           *string `json:"url,omitempty"`
+	CreatedOn     *Time   `json:"createdOn,omitempty"`
+	LastChangedOn *Time   `json:"lastChangedOn,omitempty"`
+	LineCount     *int64  `json:"lineCount,omitempty"`
+}
+
+// BuildLogsListResponse describes a build logs list response
+type BuildLogsListResponse struct {
+	Count int         `json:"count"`
+	Logs  []*BuildLog `json:"value"`
+}
+
+// BuildLogOptions describes what the request to the API should look like
+type BuildLogOptions struct {
+	// StartLine The first line to return, starting at 1.
+	StartLine int64 `url:"startLine,omitempty"`
+	// EndLine The last line to return.
+	EndLine int64 `url:"endLine,omitempty"`
+}
+
+// TailLogOptions controls how TailLog polls a build
+type TailLogOptions struct {
+	// PollInterval The delay between polls. Defaults to 5 seconds.
+	PollInterval time.Duration
+}
+
+// BuildLogLine A single line of a build log
+type BuildLogLine struct {
+	LogID int
+	Line  string
+}
+
+// ListLogs returns the logs of a build
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/get%20build%20logs?view=azure-devops-rest-5.1
+func (s *BuildsService) ListLogs(ctx context.Context, owner string, project string, buildID int) ([]*BuildLog, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/logs?api-version=5.1",
+		owner,
+		project,
+		buildID,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildLogsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Logs, resp, err
+}
+
+// GetLog writes the content of a build log to w. opts may restrict the
+// lines returned.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/get%20build%20log?view=azure-devops-rest-5.1
+func (s *BuildsService) GetLog(ctx context.Context, owner string, project string, buildID int, logID int, opts *BuildLogOptions, w io.Writer) (*http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/logs/%d?api-version=5.1",
+		owner,
+		project,
+		buildID,
+		logID,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/plain")
+
+	return s.client.Execute(ctx, req, w)
+}
+
+// GetLogsZip writes a zip archive of all logs of a build to w
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/get%20build%20logs?view=azure-devops-rest-5.1
+func (s *BuildsService) GetLogsZip(ctx context.Context, owner string, project string, buildID int, w io.Writer) (*http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/logs?api-version=5.1",
+		owner,
+		project,
+		buildID,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/zip")
+
+	return s.client.Execute(ctx, req, w)
+}
+
+// TailLog follows the logs of a build, writing new lines to w as they
+// appear, until the build completes or ctx is done. Logs are written in the
+// order of their IDs.
+func (s *BuildsService) TailLog(ctx context.Context, owner string, project string, buildID int, w io.Writer, opts *TailLogOptions) error {
+	return s.tailLog(ctx, owner, project, buildID, opts, func(line BuildLogLine) error {
+		_, err := fmt.Fprintln(w, line.Line)
+		return err
+	})
+}
+
+// TailLogLines is like TailLog, but sends each new line to lines. lines is
+// not closed.
+func (s *BuildsService) TailLogLines(ctx context.Context, owner string, project string, buildID int, lines chan<- BuildLogLine, opts *TailLogOptions) error {
+	return s.tailLog(ctx, owner, project, buildID, opts, func(line BuildLogLine) error {
+		select {
+		case lines <- line:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+func (s *BuildsService) tailLog(ctx context.Context, owner string, project string, buildID int, opts *TailLogOptions, emit func(BuildLogLine) error) error {
+	interval := defaultTailLogPollInterval
+	if opts != nil && opts.PollInterval > 0 {
+		interval = opts.PollInterval
+	}
+
+	// seen holds the number of lines already emitted per log
+	seen := map[int]int64{}
+	for {
+		// The build status is read before the logs, so that once the build
+		// is completed the logs read afterwards are known to be final.
+		build, _, err := s.Get(ctx, owner, project, buildID, nil)
+		if err != nil {
+			return err
+		}
+		completed := build.GetStatus() != nil && *build.GetStatus() == BuildStatusCompleted
+
+		logs, _, err := s.ListLogs(ctx, owner, project, buildID)
+		if err != nil {
+			return err
+		}
+		sort.Slice(logs, func(i, j int) bool { return logs[i].GetID() < logs[j].GetID() })
+
+		for _, log := range logs {
+			id := log.GetID()
+			if log.GetLineCount() <= seen[id] {
+				continue
+			}
+
+			var buf bytes.Buffer
+			logOpts := &BuildLogOptions{StartLine: seen[id] + 1, EndLine: log.GetLineCount()}
+			if _, err := s.GetLog(ctx, owner, project, buildID, id, logOpts, &buf); err != nil {
+				return err
+			}
+
+			// Lines are read without a length limit, unlike bufio.Scanner,
+			// since a single log line may be very long.
+			for {
+				line, err := buf.ReadString('\n')
+				if line == "" && err == io.EOF {
+					break
+				}
+				line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+				if err := emit(BuildLogLine{LogID: id, Line: line}); err != nil {
+					return err
+				}
+				seen[id]++
+			}
+		}
+
+		if completed {
+			return nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
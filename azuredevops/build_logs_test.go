@@ -0,0 +1,180 @@
+package azuredevops_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+func TestBuildsService_ListLogs(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1/logs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"count": 2, "value": [{"id": 1, "lineCount": 10}, {"id": 2, "lineCount": 3}]}`)
+	})
+
+	logs, _, err := c.Builds.ListLogs(context.Background(), "o", "p", 1)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if len(logs) != 2 || logs[0].GetLineCount() != 10 {
+		t.Fatalf("unexpected logs %+v", logs)
+	}
+}
+
+func TestBuildsService_GetLog(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1/logs/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "text/plain")
+		testFormValues(t, r, values{
+			"startLine": "5",
+			"endLine":   "6",
+		})
+		fmt.Fprint(w, "line 5\nline 6\n")
+	})
+
+	var buf bytes.Buffer
+	opts := &azuredevops.BuildLogOptions{StartLine: 5, EndLine: 6}
+	_, err := c.Builds.GetLog(context.Background(), "o", "p", 1, 2, opts, &buf)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if buf.String() != "line 5\nline 6\n" {
+		t.Fatalf("expected log content, got %q", buf.String())
+	}
+}
+
+func TestBuildsService_GetLogsZip(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1/logs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/zip")
+		fmt.Fprint(w, "PK")
+	})
+
+	var buf bytes.Buffer
+	_, err := c.Builds.GetLogsZip(context.Background(), "o", "p", 1, &buf)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if buf.String() != "PK" {
+		t.Fatalf("expected zip content, got %q", buf.String())
+	}
+}
+
+// tailLogSetup serves a build whose single log grows from 2 to 3 lines
+// while the build goes from in progress to completed.
+func tailLogSetup(t *testing.T, mux *http.ServeMux) {
+	log := []string{"one", "two", "three"}
+	polls := 0
+	mux.HandleFunc("/o/p/_apis/build/builds/1", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls == 1 {
+			fmt.Fprint(w, `{"id": 1, "status": "inProgress"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1, "status": "completed"}`)
+	})
+	mux.HandleFunc("/o/p/_apis/build/builds/1/logs", func(w http.ResponseWriter, r *http.Request) {
+		lines := 2
+		if polls > 1 {
+			lines = 3
+		}
+		fmt.Fprintf(w, `{"count": 1, "value": [{"id": 4, "lineCount": %d}]}`, lines)
+	})
+	mux.HandleFunc("/o/p/_apis/build/builds/1/logs/4", func(w http.ResponseWriter, r *http.Request) {
+		start, _ := strconv.Atoi(r.URL.Query().Get("startLine"))
+		end, _ := strconv.Atoi(r.URL.Query().Get("endLine"))
+		fmt.Fprint(w, strings.Join(log[start-1:end], "\n"))
+	})
+}
+
+func TestBuildsService_TailLog(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	tailLogSetup(t, mux)
+
+	var buf bytes.Buffer
+	opts := &azuredevops.TailLogOptions{PollInterval: time.Millisecond}
+	err := c.Builds.TailLog(context.Background(), "o", "p", 1, &buf, opts)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if buf.String() != "one\ntwo\nthree\n" {
+		t.Fatalf("expected every line once, got %q", buf.String())
+	}
+}
+
+func TestBuildsService_TailLogLines(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	tailLogSetup(t, mux)
+
+	lines := make(chan azuredevops.BuildLogLine, 10)
+	opts := &azuredevops.TailLogOptions{PollInterval: time.Millisecond}
+	err := c.Builds.TailLogLines(context.Background(), "o", "p", 1, lines, opts)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	close(lines)
+
+	var got []string
+	for line := range lines {
+		if line.LogID != 4 {
+			t.Errorf("expected log ID 4, got %d", line.LogID)
+		}
+		got = append(got, line.Line)
+	}
+	if strings.Join(got, ",") != "one,two,three" {
+		t.Fatalf("expected every line once, got %v", got)
+	}
+}
+
+func TestBuildsService_TailLogLines_longLine(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	long := strings.Repeat("x", 100*1024)
+	mux.HandleFunc("/o/p/_apis/build/builds/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1, "status": "completed"}`)
+	})
+	mux.HandleFunc("/o/p/_apis/build/builds/1/logs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 1, "value": [{"id": 4, "lineCount": 2}]}`)
+	})
+	mux.HandleFunc("/o/p/_apis/build/builds/1/logs/4", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, long+"\r\nshort")
+	})
+
+	lines := make(chan azuredevops.BuildLogLine, 10)
+	err := c.Builds.TailLogLines(context.Background(), "o", "p", 1, lines, nil)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	close(lines)
+
+	var got []string
+	for line := range lines {
+		got = append(got, line.Line)
+	}
+	if len(got) != 2 || got[0] != long || got[1] != "short" {
+		t.Fatalf("expected a %d byte line and %q, got %d lines", len(long), "short", len(got))
+	}
+}
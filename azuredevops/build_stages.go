@@ -0,0 +1,63 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// StageUpdateType is enum type for the change to make to a stage
+type StageUpdateType string
+
+const (
+	// StageCancel Cancels the stage.
+	StageCancel StageUpdateType = "cancel"
+	// StageRetry Retries the stage.
+	StageRetry StageUpdateType = "retry"
+)
+
+// UpdateStageParameters describes the change to make to a stage
+type UpdateStageParameters struct {
+	// ForceRetryAllJobs If true, a retry reruns every job of the stage
+	// rather than only the failed jobs.
+	ForceRetryAllJobs *bool            `json:"forceRetryAllJobs,omitempty"`
+	State             *StageUpdateType `json:"state,omitempty"`
+}
+
+// UpdateStage retries or cancels a stage of a multi-stage build.
+// stageRefName is the Identifier of the stage's timeline record.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/stages/update
+func (s *BuildsService) UpdateStage(ctx context.Context, owner string, project string, buildID int, stageRefName string, params *UpdateStageParameters) (*http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/stages/%s?api-version=6.0-preview.1",
+		owner,
+		project,
+		buildID,
+		url.PathEscape(stageRefName),
+	)
+
+	req, err := s.client.NewRequest("PATCH", URL, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Execute(ctx, req, nil)
+}
+
+// RetryStage retries a failed or canceled stage of a multi-stage build.
+// If forceRetryAllJobs is false only the jobs that did not succeed are
+// run again.
+func (s *BuildsService) RetryStage(ctx context.Context, owner string, project string, buildID int, stageRefName string, forceRetryAllJobs bool) (*http.Response, error) {
+	state := StageRetry
+	params := &UpdateStageParameters{State: &state}
+	if forceRetryAllJobs {
+		params.ForceRetryAllJobs = Bool(true)
+	}
+	return s.UpdateStage(ctx, owner, project, buildID, stageRefName, params)
+}
+
+// CancelStage cancels a running stage of a multi-stage build
+func (s *BuildsService) CancelStage(ctx context.Context, owner string, project string, buildID int, stageRefName string) (*http.Response, error) {
+	state := StageCancel
+	return s.UpdateStage(ctx, owner, project, buildID, stageRefName, &UpdateStageParameters{State: &state})
+}
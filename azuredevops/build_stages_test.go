@@ -0,0 +1,37 @@
+package azuredevops_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestBuildsService_RetryStage(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1/stages/deploy_prod", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"forceRetryAllJobs":true,"state":"retry"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := c.Builds.RetryStage(context.Background(), "o", "p", 1, "deploy_prod", true); err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+}
+
+func TestBuildsService_CancelStage(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1/stages/build", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"cancel"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := c.Builds.CancelStage(context.Background(), "o", "p", 1, "build"); err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+}
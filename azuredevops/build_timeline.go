@@ -0,0 +1,268 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// TimelineRecordType is enum type for the type of a timeline record
+type TimelineRecordType string
+
+const (
+	// TimelineRecordStage A stage of a multi-stage pipeline.
+	TimelineRecordStage TimelineRecordType = "Stage"
+	// TimelineRecordPhase A phase, which groups the jobs of a stage.
+	TimelineRecordPhase TimelineRecordType = "Phase"
+	// TimelineRecordJob A job run by an agent.
+	TimelineRecordJob TimelineRecordType = "Job"
+	// TimelineRecordTask A task step of a job.
+	TimelineRecordTask TimelineRecordType = "Task"
+	// TimelineRecordCheckpoint A checkpoint, such as an approval.
+	TimelineRecordCheckpoint TimelineRecordType = "Checkpoint"
+)
+
+// TimelineRecordState is enum type for the state of a timeline record
+type TimelineRecordState string
+
+const (
+	// TimelineRecordPending The record has not started.
+	TimelineRecordPending TimelineRecordState = "pending"
+	// TimelineRecordInProgress The record is running.
+	TimelineRecordInProgress TimelineRecordState = "inProgress"
+	// TimelineRecordCompleted The record has completed.
+	TimelineRecordCompleted TimelineRecordState = "completed"
+)
+
+// TaskResult is enum type for the result of a timeline record
+type TaskResult string
+
+const (
+	// TaskResultSucceeded The record succeeded.
+	TaskResultSucceeded TaskResult = "succeeded"
+	// TaskResultSucceededWithIssues The record succeeded with warnings.
+	TaskResultSucceededWithIssues TaskResult = "succeededWithIssues"
+	// TaskResultFailed The record failed.
+	TaskResultFailed TaskResult = "failed"
+	// TaskResultCanceled The record was canceled.
+	TaskResultCanceled TaskResult = "canceled"
+	// TaskResultSkipped The record was skipped.
+	TaskResultSkipped TaskResult = "skipped"
+	// TaskResultAbandoned The record was abandoned.
+	TaskResultAbandoned TaskResult = "abandoned"
+)
+
+// IssueType is enum type for the type of an issue
+type IssueType string
+
+const (
+	// IssueError An error.
+	IssueError IssueType = "error"
+	// IssueWarning A warning.
+	IssueWarning IssueType = "warning"
+)
+
+// Issue Represents an issue (error, warning) associated with a timeline record.
+type Issue struct {
+	Category *string           `json:"category,omitempty"`
+	Data     map[string]string `json:"data,omitempty"`
+	Message  *string           `json:"message,omitempty"`
+	Type     *IssueType        `json:"type,omitempty"`
+}
+
+// TaskReference A reference to a task.
+type TaskReference struct {
+	ID      *string `json:"id,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
+
+// TimelineReference A reference to a timeline.
+type TimelineReference struct {
+	ChangeID *int    `json:"changeId,omitempty"`
+	ID       *string `json:"id,omitempty"`
+	URL      *string `json:"url,omitempty"`
+}
+
+// TimelineRecord Represents an entry in a build's timeline.
+type TimelineRecord struct {
+	Links            *map[string]Link     `json:"_links,omitempty"`
+	Attempt          *int                 `json:"attempt,omitempty"`
+	ChangeID         *int                 `json:"changeId,omitempty"`
+	CurrentOperation *string              `json:"currentOperation,omitempty"`
+	Details          *TimelineReference   `json:"details,omitempty"`
+	ErrorCount       *int                 `json:"errorCount,omitempty"`
+	FinishTime       *Time                `json:"finishTime,omitempty"`
+	ID               *string              `json:"id,omitempty"`
+	Identifier       *string              `json:"identifier,omitempty"`
+	Issues           []*Issue             `json:"issues,omitempty"`
+	LastModified     *Time                `json:"lastModified,omitempty"`
+	Log              *BuildLogReference   `json:"log,omitempty"`
+	Name             *string              `json:"name,omitempty"`
+	Order            *int                 `json:"order,omitempty"`
+	ParentID         *string              `json:"parentId,omitempty"`
+	PercentComplete  *int                 `json:"percentComplete,omitempty"`
+	Result           *TaskResult          `json:"result,omitempty"`
+	ResultCode       *string              `json:"resultCode,omitempty"`
+	StartTime        *Time                `json:"startTime,omitempty"`
+	State            *TimelineRecordState `json:"state,omitempty"`
+	Task             *TaskReference       `json:"task,omitempty"`
+	Type             *TimelineRecordType  `json:"type,omitempty"`
+	URL              *string              `json:"url,omitempty"`
+	WarningCount     *int                 `json:"warningCount,omitempty"`
+	WorkerName       *string              `json:"workerName,omitempty"`
+}
+
+// Timeline Represents the timeline of a build.
+type Timeline struct {
+	ChangeID      *int              `json:"changeId,omitempty"`
+	ID            *string           `json:"id,omitempty"`
+	LastChangedBy *string           `json:"lastChangedBy,omitempty"`
+	LastChangedOn *Time             `json:"lastChangedOn,omitempty"`
+	Records       []*TimelineRecord `json:"records,omitempty"`
+	URL           *string           `json:"url,omitempty"`
+}
+
+// TimelineNode A timeline record with its child records, ordered by
+// TimelineRecord.Order.
+type TimelineNode struct {
+	Record   *TimelineRecord
+	Parent   *TimelineNode
+	Children []*TimelineNode
+}
+
+// TimelineFailure Describes the first failing task of a build.
+type TimelineFailure struct {
+	// Task The failing task.
+	Task *TimelineRecord
+	// Job The job the task ran in, if any.
+	Job *TimelineRecord
+	// Stage The stage the task ran in, if any.
+	Stage *TimelineRecord
+	// Errors The error issues of the task.
+	Errors []*Issue
+	// LogLines The range of log lines the errors were reported at, for use
+	// with GetLog and the task's Log ID. It is nil if the errors do not
+	// reference log lines.
+	LogLines *BuildLogOptions
+}
+
+// GetTimeline returns the timeline of a build
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/timeline/get?view=azure-devops-rest-5.1
+func (s *BuildsService) GetTimeline(ctx context.Context, owner string, project string, buildID int) (*Timeline, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/timeline?api-version=5.1",
+		owner,
+		project,
+		buildID,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Timeline)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// Tree reconstructs the hierarchy of the timeline's records, e.g.
+// stage, phase, job and task, and returns the root nodes. Records whose
+// parent is not part of the timeline are treated as roots.
+func (t *Timeline) Tree() []*TimelineNode {
+	nodes := make(map[string]*TimelineNode, len(t.Records))
+	for _, record := range t.Records {
+		nodes[record.GetID()] = &TimelineNode{Record: record}
+	}
+
+	var roots []*TimelineNode
+	for _, record := range t.Records {
+		node := nodes[record.GetID()]
+		parent, ok := nodes[record.GetParentID()]
+		if !ok || parent == node {
+			roots = append(roots, node)
+			continue
+		}
+		node.Parent = parent
+		parent.Children = append(parent.Children, node)
+	}
+
+	sortTimelineNodes(roots)
+	return roots
+}
+
+func sortTimelineNodes(nodes []*TimelineNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].Record.GetOrder() < nodes[j].Record.GetOrder()
+	})
+	for _, node := range nodes {
+		sortTimelineNodes(node.Children)
+	}
+}
+
+// Ancestor returns the closest ancestor of the node with the given type,
+// or nil if there is none.
+func (n *TimelineNode) Ancestor(recordType TimelineRecordType) *TimelineNode {
+	for p := n.Parent; p != nil; p = p.Parent {
+		if p.Record.GetType() != nil && *p.Record.GetType() == recordType {
+			return p
+		}
+	}
+	return nil
+}
+
+// FirstFailure returns the first failed task of the timeline in execution
+// order, or nil if no task failed.
+func (t *Timeline) FirstFailure() *TimelineFailure {
+	node := firstFailedTask(t.Tree())
+	if node == nil {
+		return nil
+	}
+
+	failure := &TimelineFailure{Task: node.Record}
+	if job := node.Ancestor(TimelineRecordJob); job != nil {
+		failure.Job = job.Record
+	}
+	if stage := node.Ancestor(TimelineRecordStage); stage != nil {
+		failure.Stage = stage.Record
+	}
+
+	for _, issue := range node.Record.Issues {
+		if issue.GetType() == nil || *issue.GetType() != IssueError {
+			continue
+		}
+		failure.Errors = append(failure.Errors, issue)
+
+		line, err := strconv.ParseInt(issue.Data["logFileLineNumber"], 10, 64)
+		if err != nil || line <= 0 {
+			continue
+		}
+		if failure.LogLines == nil {
+			failure.LogLines = &BuildLogOptions{StartLine: line, EndLine: line}
+		}
+		if line < failure.LogLines.StartLine {
+			failure.LogLines.StartLine = line
+		}
+		if line > failure.LogLines.EndLine {
+			failure.LogLines.EndLine = line
+		}
+	}
+
+	return failure
+}
+
+func firstFailedTask(nodes []*TimelineNode) *TimelineNode {
+	for _, node := range nodes {
+		record := node.Record
+		if record.GetType() != nil && *record.GetType() == TimelineRecordTask &&
+			record.GetResult() != nil && *record.GetResult() == TaskResultFailed {
+			return node
+		}
+		if failed := firstFailedTask(node.Children); failed != nil {
+			return failed
+		}
+	}
+	return nil
+}
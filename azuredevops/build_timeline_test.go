@@ -0,0 +1,105 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+const timelineResponse = `{
+	"id": "t",
+	"records": [
+		{"id": "task2", "parentId": "job", "type": "Task", "name": "Test", "order": 2, "result": "failed", "log": {"id": 5},
+			"issues": [
+				{"type": "warning", "message": "flaky"},
+				{"type": "error", "message": "test failed", "data": {"logFileLineNumber": "42"}},
+				{"type": "error", "message": "exit 1", "data": {"logFileLineNumber": "40"}}
+			]},
+		{"id": "task1", "parentId": "job", "type": "Task", "name": "Build", "order": 1, "result": "succeeded"},
+		{"id": "job", "parentId": "phase", "type": "Job", "name": "Linux", "order": 1, "result": "failed"},
+		{"id": "phase", "parentId": "stage", "type": "Phase", "order": 1},
+		{"id": "stage2", "type": "Stage", "name": "Deploy", "order": 2, "result": "skipped"},
+		{"id": "stage", "type": "Stage", "name": "CI", "order": 1, "state": "completed", "result": "failed"}
+	]
+}`
+
+func TestBuildsService_GetTimeline(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1/timeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, timelineResponse)
+	})
+
+	timeline, _, err := c.Builds.GetTimeline(context.Background(), "o", "p", 1)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if len(timeline.Records) != 6 {
+		t.Fatalf("expected 6 records, got %d", len(timeline.Records))
+	}
+	stage := timeline.Records[5]
+	if *stage.GetType() != azuredevops.TimelineRecordStage || *stage.GetState() != azuredevops.TimelineRecordCompleted || *stage.GetResult() != azuredevops.TaskResultFailed {
+		t.Fatalf("unexpected stage record %+v", stage)
+	}
+}
+
+func TestTimeline_Tree(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1/timeline", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, timelineResponse)
+	})
+
+	timeline, _, err := c.Builds.GetTimeline(context.Background(), "o", "p", 1)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	roots := timeline.Tree()
+	if len(roots) != 2 || roots[0].Record.GetName() != "CI" || roots[1].Record.GetName() != "Deploy" {
+		t.Fatalf("expected stages CI and Deploy as roots, got %+v", roots)
+	}
+	job := roots[0].Children[0].Children[0]
+	if job.Record.GetName() != "Linux" || len(job.Children) != 2 {
+		t.Fatalf("expected job Linux with two tasks, got %+v", job.Record)
+	}
+	if job.Children[0].Record.GetName() != "Build" || job.Children[1].Record.GetName() != "Test" {
+		t.Fatalf("expected tasks in order, got %s, %s", job.Children[0].Record.GetName(), job.Children[1].Record.GetName())
+	}
+
+	failure := timeline.FirstFailure()
+	if failure == nil {
+		t.Fatalf("expected a failure")
+	}
+	if failure.Task.GetName() != "Test" || failure.Job.GetName() != "Linux" || failure.Stage.GetName() != "CI" {
+		t.Fatalf("unexpected failure %+v", failure)
+	}
+	if len(failure.Errors) != 2 {
+		t.Fatalf("expected 2 errors, got %d", len(failure.Errors))
+	}
+	if failure.LogLines == nil || failure.LogLines.StartLine != 40 || failure.LogLines.EndLine != 42 {
+		t.Fatalf("expected log lines 40-42, got %+v", failure.LogLines)
+	}
+	if failure.Task.Log.GetID() != 5 {
+		t.Fatalf("expected log ID 5, got %d", failure.Task.Log.GetID())
+	}
+}
+
+func TestTimeline_FirstFailure_none(t *testing.T) {
+	succeeded := azuredevops.TaskResultSucceeded
+	task := azuredevops.TimelineRecordTask
+	timeline := &azuredevops.Timeline{Records: []*azuredevops.TimelineRecord{
+		{ID: String("a"), Type: &task, Result: &succeeded},
+	}}
+
+	if failure := timeline.FirstFailure(); failure != nil {
+		t.Fatalf("expected no failure, got %+v", failure)
+	}
+}
@@ -0,0 +1,112 @@
+package azuredevops
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BuildWaitOptions controls how WaitForBuild and WaitForBuilds poll builds
+type BuildWaitOptions struct {
+	// PollInterval The delay before the first poll. It doubles after every
+	// poll, up to MaxPollInterval. Defaults to 2 seconds.
+	PollInterval time.Duration
+	// MaxPollInterval The maximum delay between polls. Defaults to 30 seconds.
+	MaxPollInterval time.Duration
+	// OnStatusChange If set, is called with the build whenever its status
+	// is first seen or changes. When waiting for many builds it may be
+	// called concurrently.
+	OnStatusChange func(build *Build)
+}
+
+// BuildWaitResult describes a build that WaitForBuilds finished waiting for
+type BuildWaitResult struct {
+	BuildID int
+	// Build The build as returned by the last poll.
+	Build *Build
+	// Result The result of the completed build.
+	Result BuildResult
+	// Err The error that stopped waiting for the build, if any.
+	Err error
+}
+
+// WaitForBuild polls a build until it is completed or ctx is done, and
+// returns the completed build and its result. When ctx is done, the build
+// as last seen is returned alongside ctx.Err().
+func (s *BuildsService) WaitForBuild(ctx context.Context, owner string, project string, buildID int, opts *BuildWaitOptions) (*Build, BuildResult, error) {
+	interval, maxInterval := defaultWaitPollInterval, defaultWaitMaxPollInterval
+	var onStatusChange func(*Build)
+	if opts != nil {
+		if opts.PollInterval > 0 {
+			interval = opts.PollInterval
+		}
+		if opts.MaxPollInterval > 0 {
+			maxInterval = opts.MaxPollInterval
+		}
+		onStatusChange = opts.OnStatusChange
+	}
+
+	var last *Build
+	var lastStatus BuildStatus
+	for {
+		build, _, err := s.Get(ctx, owner, project, buildID, nil)
+		if err != nil {
+			return last, BuildResultNone, err
+		}
+		last = build
+
+		var status BuildStatus
+		if build.GetStatus() != nil {
+			status = *build.GetStatus()
+		}
+		if status != lastStatus && onStatusChange != nil {
+			onStatusChange(build)
+		}
+		lastStatus = status
+
+		if status == BuildStatusCompleted {
+			result := BuildResultNone
+			if build.GetResult() != nil {
+				result = *build.GetResult()
+			}
+			return build, result, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, BuildResultNone, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// WaitForBuilds waits for many builds concurrently. A result is sent on
+// the returned channel as each build completes or fails to be retrieved,
+// and the channel is closed once every build has been reported.
+func (s *BuildsService) WaitForBuilds(ctx context.Context, owner string, project string, buildIDs []int, opts *BuildWaitOptions) <-chan BuildWaitResult {
+	results := make(chan BuildWaitResult, len(buildIDs))
+
+	var wg sync.WaitGroup
+	for _, id := range buildIDs {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			build, result, err := s.WaitForBuild(ctx, owner, project, id, opts)
+			results <- BuildWaitResult{BuildID: id, Build: build, Result: result, Err: err}
+		}(id)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
@@ -0,0 +1,99 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+func TestBuildsService_WaitForBuild(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	responses := []string{
+		`{"id": 1, "status": "notStarted"}`,
+		`{"id": 1, "status": "inProgress"}`,
+		`{"id": 1, "status": "inProgress"}`,
+		`{"id": 1, "status": "completed", "result": "partiallySucceeded"}`,
+	}
+	polls := 0
+	mux.HandleFunc("/o/p/_apis/build/builds/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, responses[polls])
+		polls++
+	})
+
+	var statuses []azuredevops.BuildStatus
+	opts := &azuredevops.BuildWaitOptions{
+		PollInterval: time.Millisecond,
+		OnStatusChange: func(build *azuredevops.Build) {
+			if build.GetStatus() == nil {
+				t.Errorf("OnStatusChange called for build %d without a status", build.GetID())
+				return
+			}
+			statuses = append(statuses, *build.GetStatus())
+		},
+	}
+	build, result, err := c.Builds.WaitForBuild(context.Background(), "o", "p", 1, opts)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if result != azuredevops.BuildResultPartiallySucceeded || build.GetID() != 1 {
+		t.Fatalf("expected build 1 to be partially succeeded, got build %d %s", build.GetID(), result)
+	}
+	if fmt.Sprint(statuses) != "[notStarted inProgress completed]" {
+		t.Fatalf("expected each status change once, got %v", statuses)
+	}
+}
+
+func TestBuildsService_WaitForBuild_cancelled(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1, "status": "inProgress"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	opts := &azuredevops.BuildWaitOptions{PollInterval: time.Hour}
+	build, _, err := c.Builds.WaitForBuild(ctx, "o", "p", 1, opts)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if build.GetStatus() == nil || *build.GetStatus() != azuredevops.BuildStatusInProgress {
+		t.Fatalf("expected the last seen build, got %+v", build)
+	}
+}
+
+func TestBuildsService_WaitForBuilds(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1, "status": "completed", "result": "succeeded"}`)
+	})
+	mux.HandleFunc("/o/p/_apis/build/builds/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 2, "status": "completed", "result": "failed"}`)
+	})
+	mux.HandleFunc("/o/p/_apis/build/builds/3", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	opts := &azuredevops.BuildWaitOptions{PollInterval: time.Millisecond}
+	var got []string
+	for result := range c.Builds.WaitForBuilds(context.Background(), "o", "p", []int{1, 2, 3}, opts) {
+		got = append(got, fmt.Sprintf("%d:%s:%v", result.BuildID, result.Result, result.Err != nil))
+	}
+	sort.Strings(got)
+
+	if fmt.Sprint(got) != "[1:succeeded:false 2:failed:false 3:none:true]" {
+		t.Fatalf("unexpected results %v", got)
+	}
+}
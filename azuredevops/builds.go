@@ -2,8 +2,10 @@ package azuredevops
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // BuildsService handles communication with the builds methods on the API
@@ -57,8 +59,8 @@ type Build struct {
 	RetainedByRelease   *bool                             `json:"retainedByRelease,omitempty"`
 	Version             *string                           `json:"sourceVersion,omitempty"`
 	StartTime           *string                           `json:"startTime,omitempty"`
-	Status              *string                           `json:"status,omitempty"`
-	Result              *string                           `json:"result,omitempty"`
+	Status              *BuildStatus                      `json:"status,omitempty"`
+	Result              *BuildResult                      `json:"result,omitempty"`
 	ValidationResults   []*ValidationResult               `json:"validationResult,omitempty"`
 	Tags                []*string                         `json:"tags,omitempty"`
 	TriggerBuild        *Build                            `json:"triggeredByBuild,omitempty"`
@@ -67,6 +69,43 @@ type Build struct {
 	TriggerInfo         *TriggerInfo                      `json:"triggerInfo,omitempty"`
 }
 
+// BuildStatus is enum type for the status of a build
+type BuildStatus string
+
+const (
+	// BuildStatusNone No status.
+	BuildStatusNone BuildStatus = "none"
+	// BuildStatusInProgress The build is currently in progress.
+	BuildStatusInProgress BuildStatus = "inProgress"
+	// BuildStatusCompleted The build has completed.
+	BuildStatusCompleted BuildStatus = "completed"
+	// BuildStatusCancelling The build is cancelling.
+	BuildStatusCancelling BuildStatus = "cancelling"
+	// BuildStatusPostponed The build is inactive in the queue.
+	BuildStatusPostponed BuildStatus = "postponed"
+	// BuildStatusNotStarted The build has not yet started.
+	BuildStatusNotStarted BuildStatus = "notStarted"
+	// BuildStatusAll All status.
+	BuildStatusAll BuildStatus = "all"
+)
+
+// BuildResult is enum type for the result of a completed build
+type BuildResult string
+
+const (
+	// BuildResultNone No result.
+	BuildResultNone BuildResult = "none"
+	// BuildResultSucceeded The build completed successfully.
+	BuildResultSucceeded BuildResult = "succeeded"
+	// BuildResultPartiallySucceeded The build completed compilation
+	// successfully but had other errors.
+	BuildResultPartiallySucceeded BuildResult = "partiallySucceeded"
+	// BuildResultFailed The build completed unsuccessfully.
+	BuildResultFailed BuildResult = "failed"
+	// BuildResultCanceled The build was canceled before starting.
+	BuildResultCanceled BuildResult = "canceled"
+)
+
 // AgentPoolQueue The queue. This is only set if the definition type is Build.
 type AgentPoolQueue struct {
 	Links *map[string]Link        `json:"_links,omitempty"`
@@ -79,7 +118,7 @@ type AgentPoolQueue struct {
 // TaskAgentPoolReference Represents a reference to an agent pool.
 type TaskAgentPoolReference struct {
 	ID       *int    `json:"id,omitempty"`
-	IsHosted *bool   `json:"is_hosted,omitempty"`
+	IsHosted *bool   `json:"isHosted,omitempty"`
 	Name     *string `json:"name,omitempty"`
 }
 
@@ -201,3 +240,164 @@ func (s *BuildsService) Queue(ctx context.Context, owner string, project string,
 
 	return r, resp, err
 }
+
+// BuildGetOptions describes what the request to the API should look like
+type BuildGetOptions struct {
+	// PropertyFilters A comma-delimited list of properties to include in
+	// the results.
+	PropertyFilters string `url:"propertyFilters,omitempty"`
+}
+
+// BuildUpdateOptions describes what the request to the API should look like
+type BuildUpdateOptions struct {
+	// Retry Retry the build.
+	Retry bool `url:"retry,omitempty"`
+}
+
+// BuildTagsResponse describes a build tags list response
+type BuildTagsResponse struct {
+	Count int      `json:"count"`
+	Tags  []string `json:"value"`
+}
+
+// Get returns a single build
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/get?view=azure-devops-rest-5.1
+func (s *BuildsService) Get(ctx context.Context, owner string, project string, buildID int, opts *BuildGetOptions) (*Build, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d?api-version=5.1",
+		owner,
+		project,
+		buildID,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Build)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// Update updates a build, e.g. to set KeepForever or RetainedByRelease, or
+// to cancel it by setting Status to BuildStatusCancelling.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/update%20build?view=azure-devops-rest-5.1
+func (s *BuildsService) Update(ctx context.Context, owner string, project string, buildID int, build *Build, opts *BuildUpdateOptions) (*Build, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d?api-version=5.1",
+		owner,
+		project,
+		buildID,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("PATCH", URL, build)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Build)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// Cancel requests the cancellation of a queued or running build
+func (s *BuildsService) Cancel(ctx context.Context, owner string, project string, buildID int) (*Build, *http.Response, error) {
+	status := BuildStatusCancelling
+	return s.Update(ctx, owner, project, buildID, &Build{Status: &status}, nil)
+}
+
+// UpdateBuilds updates multiple builds. Each build must have its ID set.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/update%20builds?view=azure-devops-rest-5.1
+func (s *BuildsService) UpdateBuilds(ctx context.Context, owner string, project string, builds []*Build) ([]*Build, *http.Response, error) {
+	for _, build := range builds {
+		if build.GetID() == 0 {
+			return nil, nil, errors.New("Builds.UpdateBuilds: Every build must have an ID")
+		}
+	}
+
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds?api-version=5.1",
+		owner,
+		project,
+	)
+
+	req, err := s.client.NewRequest("PATCH", URL, builds)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Builds, resp, err
+}
+
+// Delete deletes a build
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/delete?view=azure-devops-rest-5.1
+func (s *BuildsService) Delete(ctx context.Context, owner string, project string, buildID int) (*http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d?api-version=5.1",
+		owner,
+		project,
+		buildID,
+	)
+
+	req, err := s.client.NewRequest("DELETE", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Execute(ctx, req, nil)
+}
+
+// ListTags returns the tags of a build
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/tags/get%20build%20tags?view=azure-devops-rest-5.1
+func (s *BuildsService) ListTags(ctx context.Context, owner string, project string, buildID int) ([]string, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/tags?api-version=5.1",
+		owner,
+		project,
+		buildID,
+	)
+
+	return s.tags(ctx, "GET", URL, nil)
+}
+
+// AddTags adds tags to a build and returns all of the build's tags
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/tags/add%20build%20tags?view=azure-devops-rest-5.1
+func (s *BuildsService) AddTags(ctx context.Context, owner string, project string, buildID int, tags []string) ([]string, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/tags?api-version=5.1",
+		owner,
+		project,
+		buildID,
+	)
+
+	return s.tags(ctx, "POST", URL, tags)
+}
+
+// RemoveTag removes a tag from a build and returns the build's remaining tags
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/build/tags/delete%20build%20tag?view=azure-devops-rest-5.1
+func (s *BuildsService) RemoveTag(ctx context.Context, owner string, project string, buildID int, tag string) ([]string, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/build/builds/%d/tags/%s?api-version=5.1",
+		owner,
+		project,
+		buildID,
+		url.PathEscape(tag),
+	)
+
+	return s.tags(ctx, "DELETE", URL, nil)
+}
+
+func (s *BuildsService) tags(ctx context.Context, method, URL string, body interface{}) ([]string, *http.Response, error) {
+	req, err := s.client.NewRequest(method, URL, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(BuildTagsResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Tags, resp, err
+}
@@ -40,11 +40,11 @@ func TestBuildsService_List(t *testing.T) {
 		response       string
 		count          int
 		index          int
-		status         string
-		result         string
+		status         azuredevops.BuildStatus
+		result         azuredevops.BuildResult
 		definitionName string
 	}{
-		{name: "return two builds", URL: buildListURL, response: buildListResponse, count: 2, index: 0, status: "completed", result: "succeeded", definitionName: "build-one"},
+		{name: "return two builds", URL: buildListURL, response: buildListResponse, count: 2, index: 0, status: azuredevops.BuildStatusCompleted, result: azuredevops.BuildResultSucceeded, definitionName: "build-one"},
 		{name: "can handle no builds returned", URL: buildListURL, response: "{}", count: 0, index: -1},
 	}
 
@@ -92,15 +92,17 @@ func TestBuildsService_Queue(t *testing.T) {
 		defer teardown()
 
 		// *** test invalid enums too
+		status := azuredevops.BuildStatusCompleted
+		result := azuredevops.BuildResultSucceeded
 		requestBuild := &azuredevops.Build{
-			Status: String("completed"),
+			Status: &status,
 			Definition: &azuredevops.BuildDefinition{
 				Name: String("build-one"),
 			},
 		}
 
 		responseBuild := requestBuild
-		responseBuild.Result = String("succeeded")
+		responseBuild.Result = &result
 
 		mux.HandleFunc(queueBuildURL, func(w http.ResponseWriter, r *http.Request) {
 			b, err := json.Marshal(responseBuild)
@@ -137,3 +139,124 @@ func TestBuildsService_Queue(t *testing.T) {
 		}
 	})
 }
+
+func TestBuildsService_GetBuild(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"propertyFilters": "a,b",
+		})
+		fmt.Fprint(w, `{"id": 1, "status": "inProgress"}`)
+	})
+
+	opts := &azuredevops.BuildGetOptions{PropertyFilters: "a,b"}
+	build, _, err := c.Builds.Get(context.Background(), "o", "p", 1, opts)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if *build.GetStatus() != azuredevops.BuildStatusInProgress {
+		t.Fatalf("expected status %s, got %s", azuredevops.BuildStatusInProgress, *build.GetStatus())
+	}
+}
+
+func TestBuildsService_Cancel(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"status":"cancelling"}`+"\n")
+		fmt.Fprint(w, `{"id": 1, "status": "cancelling"}`)
+	})
+
+	build, _, err := c.Builds.Cancel(context.Background(), "o", "p", 1)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if *build.GetStatus() != azuredevops.BuildStatusCancelling {
+		t.Fatalf("expected status %s, got %s", azuredevops.BuildStatusCancelling, *build.GetStatus())
+	}
+}
+
+func TestBuildsService_UpdateBuilds(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `[{"id":1,"keepForever":true},{"id":2,"keepForever":true}]`+"\n")
+		fmt.Fprint(w, `{"count": 2, "value": [{"id": 1, "keepForever": true}, {"id": 2, "keepForever": true}]}`)
+	})
+
+	builds := []*azuredevops.Build{
+		{ID: Int(1), KeepForever: Bool(true)},
+		{ID: Int(2), KeepForever: Bool(true)},
+	}
+	got, _, err := c.Builds.UpdateBuilds(context.Background(), "o", "p", builds)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("expected length of builds to be 2; got %d", len(got))
+	}
+
+	_, _, err = c.Builds.UpdateBuilds(context.Background(), "o", "p", []*azuredevops.Build{{}})
+	if err == nil {
+		t.Fatalf("expected error for a build without an ID")
+	}
+}
+
+func TestBuildsService_Delete(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := c.Builds.Delete(context.Background(), "o", "p", 1)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+}
+
+func TestBuildsService_Tags(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/1/tags", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"count": 1, "value": ["a"]}`)
+		case "POST":
+			testBody(t, r, `["b","c d"]`+"\n")
+			fmt.Fprint(w, `{"count": 3, "value": ["a", "b", "c d"]}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/o/p/_apis/build/builds/1/tags/c d", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{"count": 2, "value": ["a", "b"]}`)
+	})
+
+	tags, _, err := c.Builds.ListTags(context.Background(), "o", "p", 1)
+	if err != nil || len(tags) != 1 {
+		t.Fatalf("ListTags returned %v, %v", tags, err)
+	}
+	tags, _, err = c.Builds.AddTags(context.Background(), "o", "p", 1, []string{"b", "c d"})
+	if err != nil || len(tags) != 3 {
+		t.Fatalf("AddTags returned %v, %v", tags, err)
+	}
+	tags, _, err = c.Builds.RemoveTag(context.Background(), "o", "p", 1, "c d")
+	if err != nil || len(tags) != 2 {
+		t.Fatalf("RemoveTag returned %v, %v", tags, err)
+	}
+}
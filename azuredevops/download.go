@@ -0,0 +1,160 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultDownloadRetries    = 3
+	defaultDownloadRetryDelay = time.Second
+)
+
+// DownloadOptions controls how a file is downloaded
+type DownloadOptions struct {
+	// Progress If set, is called after every write with the number of
+	// bytes written so far and the total size, or -1 if it is unknown.
+	Progress func(written, total int64)
+	// Retries The number of times an interrupted download is resumed after
+	// a transient failure. Defaults to 3; a negative value disables retries.
+	Retries int
+	// RetryDelay The delay before resuming a download. Defaults to 1 second.
+	RetryDelay time.Duration
+}
+
+// downloadWriter counts the bytes written to w and skips the bytes a
+// server resent because it ignored a Range request.
+type downloadWriter struct {
+	w        io.Writer
+	skip     int64
+	written  int64
+	total    int64
+	progress func(written, total int64)
+	err      error
+}
+
+func (d *downloadWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	if d.skip > 0 {
+		k := d.skip
+		if int64(len(p)) < k {
+			k = int64(len(p))
+		}
+		d.skip -= k
+		p = p[k:]
+	}
+	if len(p) == 0 {
+		return n, nil
+	}
+
+	m, err := d.w.Write(p)
+	d.written += int64(m)
+	if err != nil {
+		d.err = err
+		return m, err
+	}
+	if d.progress != nil {
+		d.progress(d.written, d.total)
+	}
+	return n, nil
+}
+
+// download streams the content at URL to w, resuming with a Range request
+// after transient failures.
+func (c *Client) download(ctx context.Context, URL, accept string, w io.Writer, opts *DownloadOptions) (*http.Response, error) {
+	retries, delay := defaultDownloadRetries, defaultDownloadRetryDelay
+	dw := &downloadWriter{w: w, total: -1}
+	if opts != nil {
+		if opts.Retries != 0 {
+			retries = opts.Retries
+		}
+		if opts.RetryDelay > 0 {
+			delay = opts.RetryDelay
+		}
+		dw.progress = opts.Progress
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := c.NewRequest("GET", URL, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", accept)
+		if dw.written > 0 {
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", dw.written))
+		}
+
+		resp, err := c.BareDo(ctx, req)
+		if err == nil {
+			if resp.StatusCode == http.StatusPartialContent {
+				dw.skip = 0
+				if dw.total < 0 {
+					dw.total = contentRangeTotal(resp.Header.Get("Content-Range"))
+				}
+			} else {
+				dw.skip = dw.written
+				if dw.total < 0 && resp.ContentLength >= 0 {
+					dw.total = resp.ContentLength
+				}
+			}
+			_, err = io.Copy(dw, resp.Body)
+			resp.Body.Close()
+			if err == nil {
+				return resp, nil
+			}
+		}
+
+		if ctx.Err() != nil {
+			return resp, ctx.Err()
+		}
+		if dw.err != nil || !isTransient(err) || attempt >= retries {
+			return resp, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// contentRangeTotal returns the complete length from a Content-Range header
+// such as "bytes 100-199/200", or -1 if it is unknown.
+func contentRangeTotal(contentRange string) int64 {
+	i := strings.LastIndex(contentRange, "/")
+	if i < 0 {
+		return -1
+	}
+	total, err := strconv.ParseInt(contentRange[i+1:], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return total
+}
+
+// isTransient reports whether a request that failed with err may succeed
+// when retried: server errors, rate limiting, network errors and responses
+// cut short.
+func isTransient(err error) bool {
+	if e, ok := err.(*ErrorResponse); ok {
+		code := e.Response.StatusCode
+		return code >= 500 || code == http.StatusTooManyRequests
+	}
+	if e, ok := err.(*url.Error); ok {
+		err = e.Err
+	}
+	if _, ok := err.(net.Error); ok {
+		return true
+	}
+	return err == io.ErrUnexpectedEOF || err == io.EOF
+}
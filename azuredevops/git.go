@@ -203,6 +203,7 @@ type GitPullRequest struct {
 
 // GitPullRequestChange Change made in a pull request.
 type GitPullRequestChange struct {
+	GitChange
 	ChangeTrackingID *int `json:"changeTrackingId,omitempty"`
 }
 
@@ -540,10 +541,115 @@ func (s *GitService) CreateStatus(ctx context.Context, owner, project, repoName,
 	return r, resp, err
 }
 
+// FileDiffParams Provides parameters that describe inputs for the file diff.
+type FileDiffParams struct {
+	OriginalPath *string `json:"originalPath,omitempty"`
+	Path         *string `json:"path,omitempty"`
+}
+
+// FileDiffsCriteria Provides properties that describe inputs for the file diffs.
+type FileDiffsCriteria struct {
+	BaseVersionCommit   *string           `json:"baseVersionCommit,omitempty"`
+	FileDiffParams      []*FileDiffParams `json:"fileDiffParams,omitempty"`
+	TargetVersionCommit *string           `json:"targetVersionCommit,omitempty"`
+}
+
+// LineDiffBlock The line diff block. Line numbers are 1-based; a block with
+// a zero lines count on one side describes lines that only exist on the
+// other side.
+type LineDiffBlock struct {
+	ChangeType              *string `json:"changeType,omitempty"`
+	ModifiedLineNumberStart *int    `json:"modifiedLineNumberStart,omitempty"`
+	ModifiedLinesCount      *int    `json:"modifiedLinesCount,omitempty"`
+	OriginalLineNumberStart *int    `json:"originalLineNumberStart,omitempty"`
+	OriginalLinesCount      *int    `json:"originalLinesCount,omitempty"`
+}
+
+// FileDiff Provides properties that describe file differences.
+type FileDiff struct {
+	LineDiffBlocks []*LineDiffBlock `json:"lineDiffBlocks,omitempty"`
+	OriginalPath   *string          `json:"originalPath,omitempty"`
+	Path           *string          `json:"path,omitempty"`
+}
+
+// FileDiffsResponse describes the file diffs response
+type FileDiffsResponse struct {
+	Count     int         `json:"count"`
+	FileDiffs []*FileDiff `json:"value"`
+}
+
+// GetFileDiffs Get line diff blocks for a set of files between two commits.
+// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/file%20diffs/get%20file%20diffs
+func (s *GitService) GetFileDiffs(ctx context.Context, owner, project, repoName string, criteria *FileDiffsCriteria) ([]*FileDiff, *http.Response, error) {
+	URL := fmt.Sprintf(
+		"%s/%s/_apis/git/repositories/%s/filediffs?api-version=5.1-preview.1",
+		owner,
+		project,
+		repoName,
+	)
+
+	req, err := s.client.NewRequest("POST", URL, criteria)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(FileDiffsResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.FileDiffs, resp, err
+}
+
+// GetFileDiff Get line diff blocks for a single file between two commits.
+// Use GetFileDiffs to compare renamed files or several files at once.
+func (s *GitService) GetFileDiff(ctx context.Context, owner, project, repoName, baseVersion, targetVersion, path string) (*FileDiff, *http.Response, error) {
+	criteria := &FileDiffsCriteria{
+		BaseVersionCommit:   String(baseVersion),
+		TargetVersionCommit: String(targetVersion),
+		FileDiffParams: []*FileDiffParams{{
+			OriginalPath: String(path),
+			Path:         String(path),
+		}},
+	}
+
+	diffs, resp, err := s.GetFileDiffs(ctx, owner, project, repoName, criteria)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(diffs) == 0 {
+		return nil, resp, fmt.Errorf("Git.GetFileDiff: no diff returned for %s", path)
+	}
+
+	return diffs[0], resp, nil
+}
+
+// GitDiffsOptions describes what the request to the API should look like
+type GitDiffsOptions struct {
+	// BaseVersionType Version type of baseVersion: branch, commit or tag.
+	// The service default is branch.
+	BaseVersionType string `url:"baseVersionType,omitempty"`
+	// TargetVersionType Version type of targetVersion: branch, commit or
+	// tag. The service default is branch.
+	TargetVersionType string `url:"targetVersionType,omitempty"`
+	// DiffCommonCommit If true, diff between the common and target commits.
+	// If false, diff between the base and target commits.
+	DiffCommonCommit *bool `url:"diffCommonCommit,omitempty"`
+	// Top Maximum number of changes to return. Defaults to 100.
+	Top int `url:"$top,omitempty"`
+	// Skip Number of changes to skip.
+	Skip int `url:"$skip,omitempty"`
+}
+
 // GetDiffs finds the closest common commit (the merge base) between base and target commits,
 // and get the diff between either the base and target commits or common and target commits.
 // https://docs.microsoft.com/en-us/rest/api/azure/devops/git/diffs/get?view=azure-devops-rest-5.1
 func (s *GitService) GetDiffs(ctx context.Context, owner string, project string, repoName string, baseVersion string, targetVersion string) (*GitCommitDiffs, *http.Response, error) {
+	return s.GetDiffsWithOptions(ctx, owner, project, repoName, baseVersion, targetVersion, nil)
+}
+
+// GetDiffsWithOptions is like GetDiffs, but allows the version types and
+// paging of the diff to be set.
+// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/diffs/get?view=azure-devops-rest-5.1
+func (s *GitService) GetDiffsWithOptions(ctx context.Context, owner string, project string, repoName string, baseVersion string, targetVersion string, opts *GitDiffsOptions) (*GitCommitDiffs, *http.Response, error) {
 	URL := fmt.Sprintf(
 		"%s/%s/_apis/git/repositories/%s/diffs/commits?api-version=5.1&baseVersion=%s&targetVersion=%s",
 		owner,
@@ -552,6 +658,10 @@ func (s *GitService) GetDiffs(ctx context.Context, owner string, project string,
 		baseVersion,
 		targetVersion,
 	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := s.client.NewRequest("GET", URL, nil)
 	if err != nil {
@@ -308,3 +308,75 @@ func TestGitService_GetDiffs(t *testing.T) {
 		t.Errorf("expected path %s to be changed, got %s", "/folder/foo.txt", *got.Changes[1].Item.Path)
 	}
 }
+
+func TestGitService_GetFileDiffs(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/git/repositories/r/filediffs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"baseVersionCommit":"a","fileDiffParams":[{"originalPath":"/f.go","path":"/f.go"}],"targetVersionCommit":"b"}`+"\n")
+		fmt.Fprint(w, `{
+			"count": 1,
+			"value": [{
+				"path": "/f.go",
+				"originalPath": "/f.go",
+				"lineDiffBlocks": [{
+					"changeType": "edit",
+					"modifiedLineNumberStart": 3,
+					"modifiedLinesCount": 2,
+					"originalLineNumberStart": 3,
+					"originalLinesCount": 1
+				}]
+			}]
+		}`)
+	})
+
+	criteria := &azuredevops.FileDiffsCriteria{
+		BaseVersionCommit:   String("a"),
+		TargetVersionCommit: String("b"),
+		FileDiffParams: []*azuredevops.FileDiffParams{{
+			OriginalPath: String("/f.go"),
+			Path:         String("/f.go"),
+		}},
+	}
+	got, _, err := c.Git.GetFileDiffs(context.Background(), "o", "p", "r", criteria)
+	if err != nil {
+		t.Fatalf("Git.GetFileDiffs returned error: %v", err)
+	}
+
+	want := []*azuredevops.FileDiff{{
+		Path:         String("/f.go"),
+		OriginalPath: String("/f.go"),
+		LineDiffBlocks: []*azuredevops.LineDiffBlock{{
+			ChangeType:              String("edit"),
+			ModifiedLineNumberStart: Int(3),
+			ModifiedLinesCount:      Int(2),
+			OriginalLineNumberStart: Int(3),
+			OriginalLinesCount:      Int(1),
+		}},
+	}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Git.GetFileDiffs returned %+v, want %+v", got, want)
+	}
+}
+
+func TestGitService_GetFileDiff(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/git/repositories/r/filediffs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"baseVersionCommit":"a","fileDiffParams":[{"originalPath":"/f.go","path":"/f.go"}],"targetVersionCommit":"b"}`+"\n")
+		fmt.Fprint(w, `{"count": 1, "value": [{"path": "/f.go", "lineDiffBlocks": [{"changeType": "delete", "originalLineNumberStart": 1, "originalLinesCount": 1}]}]}`)
+	})
+
+	got, _, err := c.Git.GetFileDiff(context.Background(), "o", "p", "r", "a", "b", "/f.go")
+	if err != nil {
+		t.Fatalf("Git.GetFileDiff returned error: %v", err)
+	}
+
+	if got.GetPath() != "/f.go" || len(got.LineDiffBlocks) != 1 || got.LineDiffBlocks[0].GetChangeType() != "delete" {
+		t.Errorf("Git.GetFileDiff returned %+v", got)
+	}
+}
@@ -0,0 +1,33 @@
+package azuredevops
+
+// JSONPatchOp The patch operation of a JSONPatchOperation.
+type JSONPatchOp string
+
+// JSONPatchOp enum values
+const (
+	PatchOpAdd     JSONPatchOp = "add"
+	PatchOpCopy    JSONPatchOp = "copy"
+	PatchOpMove    JSONPatchOp = "move"
+	PatchOpRemove  JSONPatchOp = "remove"
+	PatchOpReplace JSONPatchOp = "replace"
+	PatchOpTest    JSONPatchOp = "test"
+)
+
+// JSONPatchOperation The JSON model for a JSON Patch operation. A JSON Patch
+// document is a list of operations, sent with the
+// application/json-patch+json content type.
+// https://tools.ietf.org/html/rfc6902
+type JSONPatchOperation struct {
+	// Op The patch operation.
+	Op JSONPatchOp `json:"op"`
+	// Path The path for the operation. In the case of an array, a zero based
+	// index can be used to specify the position in the array (e.g.
+	// /biscuits/0/name). The "-" character can be used instead of an index to
+	// insert at the end of the array (e.g. /biscuits/-).
+	Path string `json:"path"`
+	// From The path to copy from for the Move/Copy operation.
+	From string `json:"from,omitempty"`
+	// Value The value for the operation. This is either a primitive or a
+	// JToken.
+	Value interface{} `json:"value,omitempty"`
+}
@@ -0,0 +1,373 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ApprovalStatus is enum type for the status of an approval or an approval step
+type ApprovalStatus string
+
+const (
+	// ApprovalUndefined No status.
+	ApprovalUndefined ApprovalStatus = "undefined"
+	// ApprovalUninitiated The approval has not been requested yet.
+	ApprovalUninitiated ApprovalStatus = "uninitiated"
+	// ApprovalPending The approval is waiting for approvers.
+	ApprovalPending ApprovalStatus = "pending"
+	// ApprovalApproved The approval was approved.
+	ApprovalApproved ApprovalStatus = "approved"
+	// ApprovalRejected The approval was rejected.
+	ApprovalRejected ApprovalStatus = "rejected"
+	// ApprovalSkipped The approval was skipped.
+	ApprovalSkipped ApprovalStatus = "skipped"
+	// ApprovalCanceled The approval was canceled.
+	ApprovalCanceled ApprovalStatus = "canceled"
+	// ApprovalTimedOut The approval timed out.
+	ApprovalTimedOut ApprovalStatus = "timedOut"
+	// ApprovalFailed The approval failed.
+	ApprovalFailed ApprovalStatus = "failed"
+	// ApprovalCompleted The approval completed.
+	ApprovalCompleted ApprovalStatus = "completed"
+)
+
+// ApprovalExecutionOrder is enum type for the order approvers must approve in
+type ApprovalExecutionOrder string
+
+const (
+	// ApprovalAnyOrder Approvers may approve in any order.
+	ApprovalAnyOrder ApprovalExecutionOrder = "anyOrder"
+	// ApprovalInSequence Approvers must approve in the order they are listed.
+	ApprovalInSequence ApprovalExecutionOrder = "inSequence"
+)
+
+// CheckRunStatus is enum type for the status of a check suite or check run
+type CheckRunStatus string
+
+const (
+	// CheckRunNone No status.
+	CheckRunNone CheckRunStatus = "none"
+	// CheckRunQueued The check is queued.
+	CheckRunQueued CheckRunStatus = "queued"
+	// CheckRunRunning The check is running.
+	CheckRunRunning CheckRunStatus = "running"
+	// CheckRunApproved The check passed.
+	CheckRunApproved CheckRunStatus = "approved"
+	// CheckRunRejected The check was rejected.
+	CheckRunRejected CheckRunStatus = "rejected"
+	// CheckRunCanceled The check was canceled.
+	CheckRunCanceled CheckRunStatus = "canceled"
+	// CheckRunTimedOut The check timed out.
+	CheckRunTimedOut CheckRunStatus = "timedOut"
+	// CheckRunFailed The check failed.
+	CheckRunFailed CheckRunStatus = "failed"
+	// CheckRunCompleted The check completed.
+	CheckRunCompleted CheckRunStatus = "completed"
+)
+
+// ApprovalPipelineOwner The run of a pipeline that is waiting for an approval.
+type ApprovalPipelineOwner struct {
+	Links *map[string]Link `json:"_links,omitempty"`
+	ID    *int             `json:"id,omitempty"`
+	Name  *string          `json:"name,omitempty"`
+}
+
+// ApprovalPipeline The pipeline an approval belongs to.
+type ApprovalPipeline struct {
+	ID    *string                `json:"id,omitempty"`
+	Name  *string                `json:"name,omitempty"`
+	Owner *ApprovalPipelineOwner `json:"owner,omitempty"`
+}
+
+// ApprovalStep Represents the part of an approval assigned to one approver.
+type ApprovalStep struct {
+	ActualApprover   *IdentityRef    `json:"actualApprover,omitempty"`
+	AssignedApprover *IdentityRef    `json:"assignedApprover,omitempty"`
+	Comment          *string         `json:"comment,omitempty"`
+	InitiatedOn      *Time           `json:"initiatedOn,omitempty"`
+	LastModifiedBy   *IdentityRef    `json:"lastModifiedBy,omitempty"`
+	LastModifiedOn   *Time           `json:"lastModifiedOn,omitempty"`
+	Permissions      *int            `json:"permissions,omitempty"`
+	Status           *ApprovalStatus `json:"status,omitempty"`
+}
+
+// Approval Represents a manual approval a pipeline run is waiting for.
+type Approval struct {
+	Links *map[string]Link `json:"_links,omitempty"`
+	// BlockedApprovers The identities that may not approve, such as the
+	// requester of the run.
+	BlockedApprovers []*IdentityRef          `json:"blockedApprovers,omitempty"`
+	CreatedOn        *Time                   `json:"createdOn,omitempty"`
+	ExecutionOrder   *ApprovalExecutionOrder `json:"executionOrder,omitempty"`
+	ID               *string                 `json:"id,omitempty"`
+	Instructions     *string                 `json:"instructions,omitempty"`
+	LastModifiedOn   *Time                   `json:"lastModifiedOn,omitempty"`
+	// MinRequiredApprovers The number of approvers that must approve; all
+	// approvers must approve if it is 0.
+	MinRequiredApprovers *int              `json:"minRequiredApprovers,omitempty"`
+	Permissions          *int              `json:"permissions,omitempty"`
+	Pipeline             *ApprovalPipeline `json:"pipeline,omitempty"`
+	Status               *ApprovalStatus   `json:"status,omitempty"`
+	// Steps The approvers and their responses. Only returned when the
+	// approvals are requested with the "steps" expansion.
+	Steps []*ApprovalStep `json:"steps,omitempty"`
+}
+
+// ApprovalsListResponse describes an approvals list response
+type ApprovalsListResponse struct {
+	Count     int         `json:"count"`
+	Approvals []*Approval `json:"value"`
+}
+
+// ApprovalsListOptions describes what the request to the API should look like
+type ApprovalsListOptions struct {
+	ApprovalIDs []string `url:"approvalIds,comma,omitempty"`
+	// Expand Include additional details: "steps" or "permissions".
+	Expand string `url:"$expand,omitempty"`
+	// UserIDs Only return approvals assigned to these identities.
+	UserIDs []string       `url:"userIds,comma,omitempty"`
+	State   ApprovalStatus `url:"state,omitempty"`
+	Top     int            `url:"top,omitempty"`
+}
+
+// ApprovalUpdateParameters describes a response to an approval
+type ApprovalUpdateParameters struct {
+	ApprovalID *string         `json:"approvalId,omitempty"`
+	Comment    *string         `json:"comment,omitempty"`
+	ReassignTo *IdentityRef    `json:"reassignTo,omitempty"`
+	Status     *ApprovalStatus `json:"status,omitempty"`
+}
+
+// CheckType The type of a check, e.g. Approval or Invoke REST API.
+type CheckType struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// CheckResource The protected resource a check is configured on.
+type CheckResource struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+// CheckConfiguration Represents a check configured on a protected
+// resource such as an environment.
+type CheckConfiguration struct {
+	Links      *map[string]Link `json:"_links,omitempty"`
+	CreatedBy  *IdentityRef     `json:"createdBy,omitempty"`
+	CreatedOn  *Time            `json:"createdOn,omitempty"`
+	ID         *int             `json:"id,omitempty"`
+	IsDeleted  *bool            `json:"isDeleted,omitempty"`
+	ModifiedBy *IdentityRef     `json:"modifiedBy,omitempty"`
+	ModifiedOn *Time            `json:"modifiedOn,omitempty"`
+	Resource   *CheckResource   `json:"resource,omitempty"`
+	// Settings The settings of the check. Their shape depends on Type; for
+	// approvals they hold the approvers and instructions.
+	Settings map[string]interface{} `json:"settings,omitempty"`
+	// Timeout The number of minutes the check waits before timing out.
+	Timeout *int       `json:"timeout,omitempty"`
+	Type    *CheckType `json:"type,omitempty"`
+	URL     *string    `json:"url,omitempty"`
+	Version *int       `json:"version,omitempty"`
+}
+
+// ApprovalSettings The settings of an approval check.
+type ApprovalSettings struct {
+	Approvers                 []*IdentityRef          `json:"approvers,omitempty"`
+	BlockedApprovers          []*IdentityRef          `json:"blockedApprovers,omitempty"`
+	ExecutionOrder            *ApprovalExecutionOrder `json:"executionOrder,omitempty"`
+	Instructions              *string                 `json:"instructions,omitempty"`
+	MinRequiredApprovers      *int                    `json:"minRequiredApprovers,omitempty"`
+	RequesterCannotBeApprover *bool                   `json:"requesterCannotBeApprover,omitempty"`
+}
+
+// ApprovalSettings decodes the settings of an approval check.
+func (c *CheckConfiguration) ApprovalSettings() (*ApprovalSettings, error) {
+	b, err := json.Marshal(c.Settings)
+	if err != nil {
+		return nil, err
+	}
+	settings := new(ApprovalSettings)
+	if err := json.Unmarshal(b, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// CheckRun Represents the evaluation of a single check.
+type CheckRun struct {
+	CheckConfigurationRef *CheckConfiguration `json:"checkConfigurationRef,omitempty"`
+	CompletedDate         *Time               `json:"completedDate,omitempty"`
+	ID                    *string             `json:"id,omitempty"`
+	ResultMessage         *string             `json:"resultMessage,omitempty"`
+	Status                *CheckRunStatus     `json:"status,omitempty"`
+}
+
+// CheckSuite Represents the checks a pipeline run must pass before a
+// stage can start.
+type CheckSuite struct {
+	Links           *map[string]Link `json:"_links,omitempty"`
+	CheckRunResults []*CheckRun      `json:"checkRunResults,omitempty"`
+	CompletedDate   *Time            `json:"completedDate,omitempty"`
+	ID              *string          `json:"id,omitempty"`
+	Message         *string          `json:"message,omitempty"`
+	Status          *CheckRunStatus  `json:"status,omitempty"`
+}
+
+// ListApprovals returns the approvals of a project matching opts, e.g. the
+// pending approvals with State set to ApprovalPending
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/approvalsandchecks/approvals/query
+func (s *PipelinesService) ListApprovals(ctx context.Context, owner string, project string, opts *ApprovalsListOptions) ([]*Approval, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/pipelines/approvals?api-version=6.0-preview.1",
+		owner,
+		project,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(ApprovalsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Approvals, resp, err
+}
+
+// GetApproval returns an approval including its steps
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/approvalsandchecks/approvals/get
+func (s *PipelinesService) GetApproval(ctx context.Context, owner string, project string, approvalID string) (*Approval, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/pipelines/approvals/%s?$expand=steps&api-version=6.0-preview.1",
+		owner,
+		project,
+		url.PathEscape(approvalID),
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Approval)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// UpdateApprovals approves, rejects or reassigns approvals. Each update
+// must have its ApprovalID set.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/approvalsandchecks/approvals/update
+func (s *PipelinesService) UpdateApprovals(ctx context.Context, owner string, project string, updates []*ApprovalUpdateParameters) ([]*Approval, *http.Response, error) {
+	for _, update := range updates {
+		if update.GetApprovalID() == "" {
+			return nil, nil, errors.New("Pipelines.UpdateApprovals: Must supply an ApprovalID for every update")
+		}
+	}
+
+	URL := fmt.Sprintf("%s/%s/_apis/pipelines/approvals?api-version=6.0-preview.1",
+		owner,
+		project,
+	)
+
+	req, err := s.client.NewRequest("PATCH", URL, updates)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(ApprovalsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Approvals, resp, err
+}
+
+// Approve approves an approval with an optional comment
+func (s *PipelinesService) Approve(ctx context.Context, owner string, project string, approvalID string, comment string) (*Approval, *http.Response, error) {
+	return s.respondToApproval(ctx, owner, project, approvalID, ApprovalApproved, comment)
+}
+
+// Reject rejects an approval with an optional comment
+func (s *PipelinesService) Reject(ctx context.Context, owner string, project string, approvalID string, comment string) (*Approval, *http.Response, error) {
+	return s.respondToApproval(ctx, owner, project, approvalID, ApprovalRejected, comment)
+}
+
+func (s *PipelinesService) respondToApproval(ctx context.Context, owner string, project string, approvalID string, status ApprovalStatus, comment string) (*Approval, *http.Response, error) {
+	update := &ApprovalUpdateParameters{ApprovalID: &approvalID, Status: &status}
+	if comment != "" {
+		update.Comment = &comment
+	}
+
+	approvals, resp, err := s.UpdateApprovals(ctx, owner, project, []*ApprovalUpdateParameters{update})
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(approvals) == 0 {
+		return nil, resp, fmt.Errorf("Pipelines.UpdateApprovals: approval %s was not updated", approvalID)
+	}
+
+	return approvals[0], resp, nil
+}
+
+// GetCheckSuite returns the checks of a checkpoint of a pipeline run,
+// including their configuration. checkSuiteID is the ID of the run's
+// Checkpoint timeline record.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/approvalsandchecks/check%20evaluations/get
+func (s *PipelinesService) GetCheckSuite(ctx context.Context, owner string, project string, checkSuiteID string) (*CheckSuite, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/pipelines/checks/runs/%s?$expand=resources&api-version=6.0-preview.1",
+		owner,
+		project,
+		url.PathEscape(checkSuiteID),
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(CheckSuite)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// ListPendingChecks returns the check suites of a run that have not
+// completed yet, in timeline order. The response is the one to the last
+// request made.
+func (s *PipelinesService) ListPendingChecks(ctx context.Context, owner string, project string, runID int) ([]*CheckSuite, *http.Response, error) {
+	timeline, resp, err := s.client.Builds.GetTimeline(ctx, owner, project, runID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var suites []*CheckSuite
+	var pending func(nodes []*TimelineNode) error
+	pending = func(nodes []*TimelineNode) error {
+		for _, node := range nodes {
+			record := node.Record
+			if record.GetType() != nil && *record.GetType() == TimelineRecordCheckpoint &&
+				(record.GetState() == nil || *record.GetState() != TimelineRecordCompleted) {
+				var suite *CheckSuite
+				var err error
+				suite, resp, err = s.GetCheckSuite(ctx, owner, project, record.GetID())
+				if err != nil {
+					return err
+				}
+				suites = append(suites, suite)
+				continue
+			}
+			if err := pending(node.Children); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := pending(timeline.Tree()); err != nil {
+		return nil, resp, err
+	}
+
+	return suites, resp, nil
+}
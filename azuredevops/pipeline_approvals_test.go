@@ -0,0 +1,137 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+func TestPipelinesService_ListApprovals(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/pipelines/approvals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "pending", "$expand": "steps"})
+		fmt.Fprint(w, `{"count": 1, "value": [{
+			"id": "a1",
+			"status": "pending",
+			"instructions": "Check the dashboards",
+			"minRequiredApprovers": 1,
+			"executionOrder": "anyOrder",
+			"pipeline": {"id": "7", "name": "release", "owner": {"id": 42, "name": "20200101.1"}},
+			"steps": [{"assignedApprover": {"displayName": "Ops"}, "status": "pending"}]
+		}]}`)
+	})
+
+	opts := &azuredevops.ApprovalsListOptions{State: azuredevops.ApprovalPending, Expand: "steps"}
+	approvals, _, err := c.Pipelines.ListApprovals(context.Background(), "o", "p", opts)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if len(approvals) != 1 {
+		t.Fatalf("expected 1 approval, got %d", len(approvals))
+	}
+	approval := approvals[0]
+	if approval.GetInstructions() != "Check the dashboards" || *approval.GetExecutionOrder() != azuredevops.ApprovalAnyOrder {
+		t.Errorf("unexpected approval %+v", approval)
+	}
+	if approval.GetPipeline().GetOwner().GetID() != 42 {
+		t.Errorf("expected run 42, got %d", approval.GetPipeline().GetOwner().GetID())
+	}
+	if approval.Steps[0].GetAssignedApprover().GetDisplayName() != "Ops" {
+		t.Errorf("expected approver Ops")
+	}
+}
+
+func TestPipelinesService_ApproveReject(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/pipelines/approvals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		b, _ := ioutil.ReadAll(r.Body)
+		switch body := string(b); body {
+		case `[{"approvalId":"a1","comment":"lgtm","status":"approved"}]` + "\n":
+			fmt.Fprint(w, `{"count": 1, "value": [{"id": "a1", "status": "approved"}]}`)
+		case `[{"approvalId":"a2","status":"rejected"}]` + "\n":
+			fmt.Fprint(w, `{"count": 1, "value": [{"id": "a2", "status": "rejected"}]}`)
+		default:
+			t.Errorf("unexpected body %s", body)
+		}
+	})
+
+	approval, _, err := c.Pipelines.Approve(context.Background(), "o", "p", "a1", "lgtm")
+	if err != nil {
+		t.Fatalf("Approve returned error: %v", err)
+	}
+	if *approval.GetStatus() != azuredevops.ApprovalApproved {
+		t.Errorf("expected approved, got %v", *approval.GetStatus())
+	}
+
+	approval, _, err = c.Pipelines.Reject(context.Background(), "o", "p", "a2", "")
+	if err != nil {
+		t.Fatalf("Reject returned error: %v", err)
+	}
+	if *approval.GetStatus() != azuredevops.ApprovalRejected {
+		t.Errorf("expected rejected, got %v", *approval.GetStatus())
+	}
+
+	_, _, err = c.Pipelines.UpdateApprovals(context.Background(), "o", "p", []*azuredevops.ApprovalUpdateParameters{{}})
+	if err == nil {
+		t.Errorf("expected an error without an approval ID")
+	}
+}
+
+func TestPipelinesService_ListPendingChecks(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/build/builds/42/timeline", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"records": [
+			{"id": "s1", "type": "Stage", "order": 1, "state": "completed"},
+			{"id": "c1", "parentId": "s1", "type": "Checkpoint", "state": "completed"},
+			{"id": "s2", "type": "Stage", "order": 2, "state": "pending"},
+			{"id": "c2", "parentId": "s2", "type": "Checkpoint", "state": "inProgress"}
+		]}`)
+	})
+	mux.HandleFunc("/o/p/_apis/pipelines/checks/runs/c2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"$expand": "resources"})
+		fmt.Fprint(w, `{"id": "c2", "status": "running", "checkRunResults": [{
+			"id": "r1",
+			"status": "running",
+			"checkConfigurationRef": {
+				"id": 3,
+				"timeout": 1440,
+				"type": {"name": "Approval"},
+				"resource": {"type": "environment", "name": "production"},
+				"settings": {"approvers": [{"displayName": "Ops"}], "instructions": "Check the dashboards"}
+			}
+		}]}`)
+	})
+
+	suites, _, err := c.Pipelines.ListPendingChecks(context.Background(), "o", "p", 42)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if len(suites) != 1 || suites[0].GetID() != "c2" {
+		t.Fatalf("expected pending check suite c2, got %+v", suites)
+	}
+
+	config := suites[0].CheckRunResults[0].GetCheckConfigurationRef()
+	if config.GetTimeout() != 1440 || config.GetResource().GetName() != "production" {
+		t.Errorf("unexpected check configuration %+v", config)
+	}
+	settings, err := config.ApprovalSettings()
+	if err != nil {
+		t.Fatalf("ApprovalSettings returned error: %v", err)
+	}
+	if settings.GetInstructions() != "Check the dashboards" || settings.Approvers[0].GetDisplayName() != "Ops" {
+		t.Errorf("unexpected approval settings %+v", settings)
+	}
+}
@@ -0,0 +1,295 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// PipelinesService handles communication with the YAML pipelines methods on the API
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/pipelines
+type PipelinesService struct {
+	client *Client
+}
+
+// PipelinesListResponse describes a pipelines list response
+type PipelinesListResponse struct {
+	Count     int         `json:"count"`
+	Pipelines []*Pipeline `json:"value"`
+}
+
+// PipelineRunsListResponse describes a pipeline runs list response
+type PipelineRunsListResponse struct {
+	Count int            `json:"count"`
+	Runs  []*PipelineRun `json:"value"`
+}
+
+// PipelineRepository A reference to the repository holding a pipeline's YAML.
+type PipelineRepository struct {
+	ID   *string `json:"id,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+// PipelineConfiguration Describes where a pipeline is defined.
+type PipelineConfiguration struct {
+	// Path The path of the YAML file within the repository.
+	Path       *string             `json:"path,omitempty"`
+	Repository *PipelineRepository `json:"repository,omitempty"`
+	// Type The type of the configuration, e.g. yaml or designerJson.
+	Type *string `json:"type,omitempty"`
+}
+
+// Pipeline Represents a pipeline.
+type Pipeline struct {
+	Links         *map[string]Link       `json:"_links,omitempty"`
+	Configuration *PipelineConfiguration `json:"configuration,omitempty"`
+	Folder        *string                `json:"folder,omitempty"`
+	ID            *int                   `json:"id,omitempty"`
+	Name          *string                `json:"name,omitempty"`
+	Revision      *int                   `json:"revision,omitempty"`
+	URL           *string                `json:"url,omitempty"`
+}
+
+// PipelineRunState is enum type for the state of a pipeline run
+type PipelineRunState string
+
+const (
+	// PipelineRunUnknown The state is unknown.
+	PipelineRunUnknown PipelineRunState = "unknown"
+	// PipelineRunInProgress The run is in progress.
+	PipelineRunInProgress PipelineRunState = "inProgress"
+	// PipelineRunCanceling The run is being canceled.
+	PipelineRunCanceling PipelineRunState = "canceling"
+	// PipelineRunCompleted The run has completed.
+	PipelineRunCompleted PipelineRunState = "completed"
+)
+
+// PipelineRunResult is enum type for the result of a completed pipeline run
+type PipelineRunResult string
+
+const (
+	// PipelineRunResultUnknown The result is unknown.
+	PipelineRunResultUnknown PipelineRunResult = "unknown"
+	// PipelineRunSucceeded The run succeeded.
+	PipelineRunSucceeded PipelineRunResult = "succeeded"
+	// PipelineRunFailed The run failed.
+	PipelineRunFailed PipelineRunResult = "failed"
+	// PipelineRunCanceled The run was canceled.
+	PipelineRunCanceled PipelineRunResult = "canceled"
+)
+
+// PipelineVariable Represents a variable of a pipeline run.
+type PipelineVariable struct {
+	IsSecret *bool   `json:"isSecret,omitempty"`
+	Value    *string `json:"value,omitempty"`
+}
+
+// RepositoryResource Represents a repository a pipeline run checked out.
+type RepositoryResource struct {
+	RefName    *string             `json:"refName,omitempty"`
+	Repository *PipelineRepository `json:"repository,omitempty"`
+	Version    *string             `json:"version,omitempty"`
+}
+
+// PipelineResource Represents another pipeline a pipeline run consumed.
+type PipelineResource struct {
+	Pipeline *Pipeline `json:"pipeline,omitempty"`
+	Version  *string   `json:"version,omitempty"`
+}
+
+// PipelineRunResources The resources a pipeline run used, keyed by their
+// alias in the YAML, e.g. "self" for the pipeline's own repository.
+type PipelineRunResources struct {
+	Pipelines    map[string]*PipelineResource   `json:"pipelines,omitempty"`
+	Repositories map[string]*RepositoryResource `json:"repositories,omitempty"`
+}
+
+// PipelineRun Represents a run of a pipeline.
+type PipelineRun struct {
+	Links              *map[string]Link             `json:"_links,omitempty"`
+	CreatedDate        *Time                        `json:"createdDate,omitempty"`
+	FinalYaml          *string                      `json:"finalYaml,omitempty"`
+	FinishedDate       *Time                        `json:"finishedDate,omitempty"`
+	ID                 *int                         `json:"id,omitempty"`
+	Name               *string                      `json:"name,omitempty"`
+	Pipeline           *Pipeline                    `json:"pipeline,omitempty"`
+	Resources          *PipelineRunResources        `json:"resources,omitempty"`
+	Result             *PipelineRunResult           `json:"result,omitempty"`
+	State              *PipelineRunState            `json:"state,omitempty"`
+	TemplateParameters map[string]interface{}       `json:"templateParameters,omitempty"`
+	URL                *string                      `json:"url,omitempty"`
+	Variables          map[string]*PipelineVariable `json:"variables,omitempty"`
+}
+
+// RepositoryResourceParameters Selects the version of a repository
+// resource to run a pipeline with.
+type RepositoryResourceParameters struct {
+	// RefName The branch or tag to check out, e.g. refs/heads/feature.
+	RefName *string `json:"refName,omitempty"`
+	// Version The commit to check out.
+	Version *string `json:"version,omitempty"`
+}
+
+// PipelineResourceParameters Selects the run of a pipeline resource to
+// run a pipeline with.
+type PipelineResourceParameters struct {
+	Version *string `json:"version,omitempty"`
+}
+
+// RunResourcesParameters Selects the versions of the resources of a run,
+// keyed by their alias in the YAML, e.g. "self".
+type RunResourcesParameters struct {
+	Pipelines    map[string]*PipelineResourceParameters   `json:"pipelines,omitempty"`
+	Repositories map[string]*RepositoryResourceParameters `json:"repositories,omitempty"`
+}
+
+// RunPipelineParameters describes how a pipeline should be run
+type RunPipelineParameters struct {
+	// PreviewRun If true, the pipeline is not run; the returned run holds
+	// the fully expanded YAML in FinalYaml.
+	PreviewRun *bool                   `json:"previewRun,omitempty"`
+	Resources  *RunResourcesParameters `json:"resources,omitempty"`
+	// StagesToSkip The names of the stages to skip.
+	StagesToSkip []string `json:"stagesToSkip,omitempty"`
+	// TemplateParameters Values for the runtime parameters of the YAML.
+	TemplateParameters map[string]interface{}       `json:"templateParameters,omitempty"`
+	Variables          map[string]*PipelineVariable `json:"variables,omitempty"`
+	// YamlOverride YAML to use instead of the pipeline's YAML file. It is
+	// only accepted for preview runs.
+	YamlOverride *string `json:"yamlOverride,omitempty"`
+}
+
+// PipelinesListOptions describes what the request to the API should look like
+type PipelinesListOptions struct {
+	// OrderBy A sort expression, e.g. "name asc".
+	OrderBy           string `url:"orderBy,omitempty"`
+	Top               int    `url:"$top,omitempty"`
+	ContinuationToken string `url:"continuationToken,omitempty"`
+}
+
+// ListPipelines returns a page of the pipelines in a project. When more
+// pipelines are available the X-MS-ContinuationToken response header holds
+// the ContinuationToken of the next page.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/pipelines/pipelines/list
+func (s *PipelinesService) ListPipelines(ctx context.Context, owner string, project string, opts *PipelinesListOptions) ([]*Pipeline, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/pipelines?api-version=6.0-preview.1",
+		owner,
+		project,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(PipelinesListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Pipelines, resp, err
+}
+
+// GetPipeline returns a pipeline at a given version, or at its latest
+// version if pipelineVersion is 0
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/pipelines/pipelines/get
+func (s *PipelinesService) GetPipeline(ctx context.Context, owner string, project string, pipelineID int, pipelineVersion int) (*Pipeline, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/pipelines/%d?api-version=6.0-preview.1",
+		owner,
+		project,
+		pipelineID,
+	)
+	if pipelineVersion > 0 {
+		URL = fmt.Sprintf("%s&pipelineVersion=%d", URL, pipelineVersion)
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Pipeline)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// RunPipeline queues a run of a pipeline. params may be nil to run the
+// default branch with default parameters.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/pipelines/runs/run%20pipeline
+func (s *PipelinesService) RunPipeline(ctx context.Context, owner string, project string, pipelineID int, params *RunPipelineParameters) (*PipelineRun, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/pipelines/%d/runs?api-version=6.0-preview.1",
+		owner,
+		project,
+		pipelineID,
+	)
+	if params == nil {
+		params = &RunPipelineParameters{}
+	}
+
+	req, err := s.client.NewRequest("POST", URL, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(PipelineRun)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// PreviewPipeline returns the fully expanded YAML a run of a pipeline with
+// params would use, without running it. Set params.YamlOverride to
+// validate YAML that has not been pushed yet.
+func (s *PipelinesService) PreviewPipeline(ctx context.Context, owner string, project string, pipelineID int, params *RunPipelineParameters) (string, *http.Response, error) {
+	preview := RunPipelineParameters{}
+	if params != nil {
+		preview = *params
+	}
+	preview.PreviewRun = Bool(true)
+
+	run, resp, err := s.RunPipeline(ctx, owner, project, pipelineID, &preview)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return run.GetFinalYaml(), resp, nil
+}
+
+// ListRuns returns the most recent runs of a pipeline
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/pipelines/runs/list
+func (s *PipelinesService) ListRuns(ctx context.Context, owner string, project string, pipelineID int) ([]*PipelineRun, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/pipelines/%d/runs?api-version=6.0-preview.1",
+		owner,
+		project,
+		pipelineID,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(PipelineRunsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Runs, resp, err
+}
+
+// GetRun returns a run of a pipeline
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/pipelines/runs/get
+func (s *PipelinesService) GetRun(ctx context.Context, owner string, project string, pipelineID int, runID int) (*PipelineRun, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/pipelines/%d/runs/%d?api-version=6.0-preview.1",
+		owner,
+		project,
+		pipelineID,
+		runID,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(PipelineRun)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
@@ -0,0 +1,149 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+func TestPipelinesService_ListPipelines(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"$top": "2", "orderBy": "name asc"})
+		w.Header().Set("X-MS-ContinuationToken", "next")
+		fmt.Fprint(w, `{"count": 2, "value": [
+			{"id": 1, "name": "ci", "folder": "\\", "configuration": {"type": "yaml", "path": "azure-pipelines.yml"}},
+			{"id": 2, "name": "release", "folder": "\\deploy"}
+		]}`)
+	})
+
+	opts := &azuredevops.PipelinesListOptions{Top: 2, OrderBy: "name asc"}
+	pipelines, resp, err := c.Pipelines.ListPipelines(context.Background(), "o", "p", opts)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if len(pipelines) != 2 {
+		t.Fatalf("expected 2 pipelines, got %d", len(pipelines))
+	}
+	if pipelines[0].GetConfiguration().GetPath() != "azure-pipelines.yml" {
+		t.Errorf("expected configuration path, got %q", pipelines[0].GetConfiguration().GetPath())
+	}
+	if resp.Header.Get("X-MS-ContinuationToken") != "next" {
+		t.Errorf("expected continuation token header")
+	}
+}
+
+func TestPipelinesService_GetPipeline(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/pipelines/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"pipelineVersion": "3"})
+		fmt.Fprint(w, `{"id": 1, "name": "ci", "revision": 3}`)
+	})
+
+	pipeline, _, err := c.Pipelines.GetPipeline(context.Background(), "o", "p", 1, 3)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if pipeline.GetRevision() != 3 {
+		t.Errorf("expected revision 3, got %d", pipeline.GetRevision())
+	}
+}
+
+func TestPipelinesService_RunPipeline(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/pipelines/1/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"resources":{"repositories":{"self":{"refName":"refs/heads/feature"}}},`+
+			`"stagesToSkip":["deploy"],"templateParameters":{"debug":true,"image":"ubuntu"},`+
+			`"variables":{"config":{"value":"debug"}}}`+"\n")
+		fmt.Fprint(w, `{"id": 42, "name": "20200101.1", "state": "inProgress",
+			"resources": {"repositories": {"self": {"refName": "refs/heads/feature", "version": "abc"}}}}`)
+	})
+
+	params := &azuredevops.RunPipelineParameters{
+		Resources: &azuredevops.RunResourcesParameters{
+			Repositories: map[string]*azuredevops.RepositoryResourceParameters{
+				"self": {RefName: azuredevops.String("refs/heads/feature")},
+			},
+		},
+		StagesToSkip:       []string{"deploy"},
+		TemplateParameters: map[string]interface{}{"image": "ubuntu", "debug": true},
+		Variables: map[string]*azuredevops.PipelineVariable{
+			"config": {Value: azuredevops.String("debug")},
+		},
+	}
+	run, _, err := c.Pipelines.RunPipeline(context.Background(), "o", "p", 1, params)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if run.GetID() != 42 || *run.GetState() != azuredevops.PipelineRunInProgress {
+		t.Errorf("unexpected run %+v", run)
+	}
+	if run.GetResources().Repositories["self"].GetVersion() != "abc" {
+		t.Errorf("expected self repository version abc")
+	}
+}
+
+func TestPipelinesService_PreviewPipeline(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/pipelines/1/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"previewRun":true,"yamlOverride":"steps: []"}`+"\n")
+		fmt.Fprint(w, `{"id": -1, "finalYaml": "stages: []"}`)
+	})
+
+	params := &azuredevops.RunPipelineParameters{YamlOverride: azuredevops.String("steps: []")}
+	yaml, _, err := c.Pipelines.PreviewPipeline(context.Background(), "o", "p", 1, params)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if yaml != "stages: []" {
+		t.Errorf("expected final yaml, got %q", yaml)
+	}
+	if params.PreviewRun != nil {
+		t.Errorf("expected params to be left unchanged")
+	}
+}
+
+func TestPipelinesService_Runs(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/pipelines/1/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"count": 2, "value": [{"id": 2, "state": "inProgress"}, {"id": 1, "state": "completed", "result": "failed"}]}`)
+	})
+	mux.HandleFunc("/o/p/_apis/pipelines/1/runs/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "state": "completed", "result": "succeeded"}`)
+	})
+
+	runs, _, err := c.Pipelines.ListRuns(context.Background(), "o", "p", 1)
+	if err != nil {
+		t.Fatalf("ListRuns returned error: %v", err)
+	}
+	if len(runs) != 2 || *runs[1].GetResult() != azuredevops.PipelineRunFailed {
+		t.Errorf("unexpected runs %+v", runs)
+	}
+
+	run, _, err := c.Pipelines.GetRun(context.Background(), "o", "p", 1, 1)
+	if err != nil {
+		t.Fatalf("GetRun returned error: %v", err)
+	}
+	if *run.GetResult() != azuredevops.PipelineRunSucceeded {
+		t.Errorf("expected succeeded, got %v", *run.GetResult())
+	}
+}
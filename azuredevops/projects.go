@@ -0,0 +1,80 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ProjectsService handles communication with the projects methods on the API
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/core/projects
+type ProjectsService struct {
+	client *Client
+}
+
+// ProjectsListOptions describes what the request to the API should look like
+type ProjectsListOptions struct {
+	// StateFilter Filter on team projects in a specific team project state
+	// (default: wellFormed).
+	StateFilter       string `url:"stateFilter,omitempty"`
+	Top               int    `url:"$top,omitempty"`
+	Skip              int    `url:"$skip,omitempty"`
+	ContinuationToken string `url:"continuationToken,omitempty"`
+}
+
+// ProjectsListResponse describes a projects list response
+type ProjectsListResponse struct {
+	Count    int                     `json:"count"`
+	Projects []*TeamProjectReference `json:"value"`
+}
+
+// List returns a page of the projects in the organization. When more
+// projects are available the X-MS-ContinuationToken response header holds
+// the ContinuationToken of the next page.
+// https://docs.microsoft.com/en-us/rest/api/azure/devops/core/projects/list?view=azure-devops-rest-5.1
+func (s *ProjectsService) List(ctx context.Context, owner string, opts *ProjectsListOptions) ([]*TeamProjectReference, *http.Response, error) {
+	URL := fmt.Sprintf("%s/_apis/projects?api-version=5.1",
+		owner,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(ProjectsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.Projects, resp, err
+}
+
+// ListAll returns every project in the organization, following
+// continuation tokens until the last page.
+func (s *ProjectsService) ListAll(ctx context.Context, owner string, opts *ProjectsListOptions) ([]*TeamProjectReference, *http.Response, error) {
+	pageOpts := &ProjectsListOptions{}
+	if opts != nil {
+		*pageOpts = *opts
+	}
+
+	var projects []*TeamProjectReference
+	for {
+		page, resp, err := s.List(ctx, owner, pageOpts)
+		if err != nil {
+			return nil, resp, err
+		}
+		projects = append(projects, page...)
+
+		token := resp.Header.Get("X-MS-ContinuationToken")
+		if token == "" || len(page) == 0 {
+			return projects, resp, nil
+		}
+		pageOpts.ContinuationToken = token
+	}
+}
@@ -0,0 +1,35 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestProjectsService_ListAll(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/_apis/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("continuationToken") {
+		case "":
+			w.Header().Set("X-MS-ContinuationToken", "2")
+			fmt.Fprint(w, `{"count": 1, "value": [{"id": "1", "name": "one"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"count": 1, "value": [{"id": "2", "name": "two"}]}`)
+		default:
+			t.Errorf("unexpected continuation token %q", r.URL.Query().Get("continuationToken"))
+		}
+	})
+
+	got, _, err := c.Projects.ListAll(context.Background(), "o", nil)
+	if err != nil {
+		t.Fatalf("Projects.ListAll returned error: %v", err)
+	}
+
+	if len(got) != 2 || got[0].GetName() != "one" || got[1].GetName() != "two" {
+		t.Errorf("Projects.ListAll returned %+v", got)
+	}
+}
@@ -0,0 +1,31 @@
+package azuredevops
+
+// PropertyValue A single typed value stored in a PropertiesCollection.
+type PropertyValue struct {
+	Type  *string     `json:"$type,omitempty"`
+	Value interface{} `json:"$value,omitempty"`
+}
+
+// PropertiesCollection The class represents a property bag as a collection
+// of key-value pairs. Values of all primitive types (any type with a
+// TypeCode != TypeCode.Object) except for DBNull are accepted.
+type PropertiesCollection map[string]*PropertyValue
+
+// GetString returns the value stored under key if it is present and is a
+// string.
+func (p PropertiesCollection) GetString(key string) (string, bool) {
+	v, ok := p[key]
+	if !ok || v == nil {
+		return "", false
+	}
+	s, ok := v.Value.(string)
+	return s, ok
+}
+
+// SetString stores value under key as a System.String property.
+func (p PropertiesCollection) SetString(key, value string) {
+	p[key] = &PropertyValue{
+		Type:  String("System.String"),
+		Value: value,
+	}
+}
@@ -0,0 +1,142 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Attachment Meta data for a file attached to an artifact such as a pull
+// request.
+type Attachment struct {
+	Links       *map[string]Link     `json:"_links,omitempty"`
+	Author      *IdentityRef         `json:"author,omitempty"`
+	ContentHash *string              `json:"contentHash,omitempty"`
+	CreatedDate *Time                `json:"createdDate,omitempty"`
+	Description *string              `json:"description,omitempty"`
+	DisplayName *string              `json:"displayName,omitempty"`
+	ID          *int                 `json:"id,omitempty"`
+	Properties  PropertiesCollection `json:"properties,omitempty"`
+	URL         *string              `json:"url,omitempty"`
+}
+
+// PullRequestAttachmentsListResponse describes a pull request attachments list response
+type PullRequestAttachmentsListResponse struct {
+	Count       int           `json:"count"`
+	Attachments []*Attachment `json:"value"`
+}
+
+// imageExtensions are the file extensions rendered inline by Markdown
+var imageExtensions = map[string]bool{
+	".bmp":  true,
+	".gif":  true,
+	".jpeg": true,
+	".jpg":  true,
+	".png":  true,
+	".svg":  true,
+}
+
+// Markdown returns Markdown linking to the attachment, suitable for use in
+// Comment.Content. Images are embedded, other files are linked.
+func (a *Attachment) Markdown() string {
+	name := a.GetDisplayName()
+	link := fmt.Sprintf("[%s](%s)", name, a.GetURL())
+	if imageExtensions[strings.ToLower(path.Ext(name))] {
+		return "!" + link
+	}
+	return link
+}
+
+// CreateAttachment Attach a new file to a pull request. The content is
+// streamed from r; size is its length in bytes, or -1 if unknown.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20attachments/create
+func (s *PullRequestsService) CreateAttachment(ctx context.Context, owner, project, repo string, pullNum int, fileName string, r io.Reader, size int64) (*Attachment, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/attachments/%s?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		url.PathEscape(fileName),
+	)
+
+	req, err := s.client.NewUploadRequest(URL, r, size, mediaTypeOctetStream)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(Attachment)
+	resp, err := s.client.Execute(ctx, req, a)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return a, resp, err
+}
+
+// ListAttachments Get a list of files attached to a given pull request.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20attachments/list
+func (s *PullRequestsService) ListAttachments(ctx context.Context, owner, project, repo string, pullNum int) ([]*Attachment, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/attachments?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(PullRequestAttachmentsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.Attachments, resp, err
+}
+
+// GetAttachment Get the file content of a pull request attachment and
+// write it to w.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20attachments/get
+func (s *PullRequestsService) GetAttachment(ctx context.Context, owner, project, repo string, pullNum int, fileName string, w io.Writer) (*http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/attachments/%s?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		url.PathEscape(fileName),
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", mediaTypeOctetStream)
+
+	return s.client.Execute(ctx, req, w)
+}
+
+// DeleteAttachment Delete a pull request attachment.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20attachments/delete
+func (s *PullRequestsService) DeleteAttachment(ctx context.Context, owner, project, repo string, pullNum int, fileName string) (*http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/attachments/%s?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		url.PathEscape(fileName),
+	)
+
+	req, err := s.client.NewRequest("DELETE", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Execute(ctx, req, nil)
+}
@@ -0,0 +1,110 @@
+package azuredevops_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+const attachmentsURL = "/o/p/_apis/git/repositories/r/pullrequests/1/attachments"
+
+func TestPullRequestsService_CreateAttachment(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(attachmentsURL+"/diff.png", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/octet-stream")
+		testBody(t, r, "PNG")
+		fmt.Fprint(w, `{"id": 1, "displayName": "diff.png", "url": "https://dev.azure.com/o/p/_apis/git/repositories/r/pullRequests/1/attachments/diff.png"}`)
+	})
+
+	got, _, err := c.PullRequests.CreateAttachment(context.Background(), "o", "p", "r", 1, "diff.png", strings.NewReader("PNG"), 3)
+	if err != nil {
+		t.Fatalf("PullRequests.CreateAttachment returned error: %v", err)
+	}
+
+	want := "![diff.png](https://dev.azure.com/o/p/_apis/git/repositories/r/pullRequests/1/attachments/diff.png)"
+	if got.Markdown() != want {
+		t.Errorf("Attachment.Markdown returned %q, want %q", got.Markdown(), want)
+	}
+}
+
+func TestAttachment_Markdown(t *testing.T) {
+	tt := []struct {
+		name string
+		want string
+	}{
+		{name: "diff.PNG", want: "![diff.PNG](u)"},
+		{name: "report.html", want: "[report.html](u)"},
+	}
+
+	for _, tc := range tt {
+		a := &azuredevops.Attachment{DisplayName: String(tc.name), URL: String("u")}
+		if got := a.Markdown(); got != tc.want {
+			t.Errorf("Attachment.Markdown returned %q, want %q", got, tc.want)
+		}
+	}
+}
+
+func TestPullRequestsService_ListAttachments(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(attachmentsURL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"count": 1, "value": [{"id": 1, "displayName": "diff.png"}]}`)
+	})
+
+	got, _, err := c.PullRequests.ListAttachments(context.Background(), "o", "p", "r", 1)
+	if err != nil {
+		t.Fatalf("PullRequests.ListAttachments returned error: %v", err)
+	}
+
+	want := []*azuredevops.Attachment{{ID: Int(1), DisplayName: String("diff.png")}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("PullRequests.ListAttachments returned %+v, want %+v", got, want)
+	}
+}
+
+func TestPullRequestsService_GetAttachment(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(attachmentsURL+"/diff.png", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/octet-stream")
+		fmt.Fprint(w, "PNG")
+	})
+
+	var buf bytes.Buffer
+	_, err := c.PullRequests.GetAttachment(context.Background(), "o", "p", "r", 1, "diff.png", &buf)
+	if err != nil {
+		t.Fatalf("PullRequests.GetAttachment returned error: %v", err)
+	}
+
+	if buf.String() != "PNG" {
+		t.Errorf("PullRequests.GetAttachment wrote %q, want %q", buf.String(), "PNG")
+	}
+}
+
+func TestPullRequestsService_DeleteAttachment(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(attachmentsURL+"/diff.png", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := c.PullRequests.DeleteAttachment(context.Background(), "o", "p", "r", 1, "diff.png")
+	if err != nil {
+		t.Fatalf("PullRequests.DeleteAttachment returned error: %v", err)
+	}
+}
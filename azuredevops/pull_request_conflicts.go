@@ -0,0 +1,314 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// GitConflictType The type of a merge conflict.
+type GitConflictType string
+
+// GitConflictType enum values
+const (
+	ConflictNone           GitConflictType = "none"
+	ConflictAddAdd         GitConflictType = "addAdd"
+	ConflictAddRename      GitConflictType = "addRename"
+	ConflictDeleteEdit     GitConflictType = "deleteEdit"
+	ConflictDeleteRename   GitConflictType = "deleteRename"
+	ConflictDirectoryFile  GitConflictType = "directoryFile"
+	ConflictDirectoryChild GitConflictType = "directoryChild"
+	ConflictEditDelete     GitConflictType = "editDelete"
+	ConflictEditEdit       GitConflictType = "editEdit"
+	ConflictFileDirectory  GitConflictType = "fileDirectory"
+	ConflictRename1to2     GitConflictType = "rename1to2"
+	ConflictRename2to1     GitConflictType = "rename2to1"
+	ConflictRenameAdd      GitConflictType = "renameAdd"
+	ConflictRenameDelete   GitConflictType = "renameDelete"
+	ConflictRenameRename   GitConflictType = "renameRename"
+)
+
+// GitResolutionStatus The resolution status of a merge conflict.
+type GitResolutionStatus string
+
+// GitResolutionStatus enum values
+const (
+	ResolutionUnresolved        GitResolutionStatus = "unresolved"
+	ResolutionPartiallyResolved GitResolutionStatus = "partiallyResolved"
+	ResolutionResolved          GitResolutionStatus = "resolved"
+)
+
+// GitResolutionMergeType How the content of a file conflict is resolved.
+type GitResolutionMergeType string
+
+// GitResolutionMergeType enum values
+const (
+	MergeTypeUndecided         GitResolutionMergeType = "undecided"
+	MergeTypeTakeSourceContent GitResolutionMergeType = "takeSourceContent"
+	MergeTypeTakeTargetContent GitResolutionMergeType = "takeTargetContent"
+	MergeTypeAutoMerged        GitResolutionMergeType = "autoMerged"
+	MergeTypeUserMerged        GitResolutionMergeType = "userMerged"
+)
+
+// GitResolutionWhichAction Which side of a pick-one conflict (such as
+// deleteEdit or editDelete) is kept.
+type GitResolutionWhichAction string
+
+// GitResolutionWhichAction enum values
+const (
+	PickUndecided GitResolutionWhichAction = "undecided"
+	PickLeft      GitResolutionWhichAction = "pickLeft"
+	PickRight     GitResolutionWhichAction = "pickRight"
+)
+
+// GitBlobRef describes a git blob
+type GitBlobRef struct {
+	Links    *map[string]Link `json:"_links,omitempty"`
+	ObjectID *string          `json:"objectId,omitempty"`
+	Size     *int64           `json:"size,omitempty"`
+	URL      *string          `json:"url,omitempty"`
+}
+
+// GitMergeOriginRef describes what caused a merge
+type GitMergeOriginRef struct {
+	PullRequestID *int `json:"pullRequestId,omitempty"`
+}
+
+// GitResolution describes how a merge conflict is resolved. The fields that
+// apply depend on the type of the conflict: content conflicts such as
+// editEdit use MergeType, pick-one conflicts such as deleteEdit use Action,
+// and path conflicts may also set RenamePath.
+type GitResolution struct {
+	Action            *GitResolutionWhichAction `json:"action,omitempty"`
+	Author            *IdentityRef              `json:"author,omitempty"`
+	MergeType         *GitResolutionMergeType   `json:"mergeType,omitempty"`
+	RenamePath        *string                   `json:"renamePath,omitempty"`
+	UserMergedBlob    *GitBlobRef               `json:"userMergedBlob,omitempty"`
+	UserMergedContent []byte                    `json:"userMergedContent,omitempty"`
+}
+
+// GitConflict describes a merge conflict in a pull request
+type GitConflict struct {
+	Links             *map[string]Link     `json:"_links,omitempty"`
+	BaseBlob          *GitBlobRef          `json:"baseBlob,omitempty"`
+	ConflictID        *int                 `json:"conflictId,omitempty"`
+	ConflictPath      *string              `json:"conflictPath,omitempty"`
+	ConflictType      *GitConflictType     `json:"conflictType,omitempty"`
+	MergeBaseCommit   *GitCommitRef        `json:"mergeBaseCommit,omitempty"`
+	MergeOrigin       *GitMergeOriginRef   `json:"mergeOrigin,omitempty"`
+	MergeSourceCommit *GitCommitRef        `json:"mergeSourceCommit,omitempty"`
+	MergeTargetCommit *GitCommitRef        `json:"mergeTargetCommit,omitempty"`
+	Resolution        *GitResolution       `json:"resolution,omitempty"`
+	ResolutionError   *string              `json:"resolutionError,omitempty"`
+	ResolutionStatus  *GitResolutionStatus `json:"resolutionStatus,omitempty"`
+	ResolvedBy        *IdentityRef         `json:"resolvedBy,omitempty"`
+	ResolvedDate      *Time                `json:"resolvedDate,omitempty"`
+	SourceBlob        *GitBlobRef          `json:"sourceBlob,omitempty"`
+	TargetBlob        *GitBlobRef          `json:"targetBlob,omitempty"`
+	URL               *string              `json:"url,omitempty"`
+}
+
+// GitConflictUpdateResult describes the result of updating one conflict
+// with UpdateConflicts
+type GitConflictUpdateResult struct {
+	ConflictID      *int         `json:"conflictId,omitempty"`
+	CustomMessage   *string      `json:"customMessage,omitempty"`
+	UpdatedConflict *GitConflict `json:"updatedConflict,omitempty"`
+	UpdateStatus    *string      `json:"updateStatus,omitempty"`
+}
+
+// PullRequestConflictsListOptions describes what the request to the API should look like
+type PullRequestConflictsListOptions struct {
+	Skip            int  `url:"$skip,omitempty"`
+	Top             int  `url:"$top,omitempty"`
+	IncludeObsolete bool `url:"includeObsolete,omitempty"`
+	ExcludeResolved bool `url:"excludeResolved,omitempty"`
+	OnlyResolved    bool `url:"onlyResolved,omitempty"`
+}
+
+// PullRequestConflictsListResponse describes a pull request conflicts list response
+type PullRequestConflictsListResponse struct {
+	Count     int            `json:"count"`
+	Conflicts []*GitConflict `json:"value"`
+}
+
+// PullRequestConflictUpdateResultsResponse describes the response to UpdateConflicts
+type PullRequestConflictUpdateResultsResponse struct {
+	Count   int                        `json:"count"`
+	Results []*GitConflictUpdateResult `json:"value"`
+}
+
+// TakeSourceResolution returns a resolution that keeps the content from the
+// pull request's source branch. It applies to content conflicts such as
+// editEdit and addAdd.
+func TakeSourceResolution() *GitResolution {
+	t := MergeTypeTakeSourceContent
+	return &GitResolution{MergeType: &t}
+}
+
+// TakeTargetResolution returns a resolution that keeps the content from the
+// pull request's target branch. It applies to content conflicts such as
+// editEdit and addAdd.
+func TakeTargetResolution() *GitResolution {
+	t := MergeTypeTakeTargetContent
+	return &GitResolution{MergeType: &t}
+}
+
+// UserMergedResolution returns a resolution that uploads content as the
+// merged file. It applies to content conflicts such as editEdit and addAdd.
+func UserMergedResolution(content []byte) *GitResolution {
+	t := MergeTypeUserMerged
+	return &GitResolution{MergeType: &t, UserMergedContent: content}
+}
+
+// PickOneResolution returns a resolution for conflicts where one side of
+// the merge must be chosen, such as deleteEdit and editDelete.
+func PickOneResolution(action GitResolutionWhichAction) *GitResolution {
+	return &GitResolution{Action: &action}
+}
+
+// ListConflicts Retrieve all conflicts for a pull request.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20conflicts/list
+func (s *PullRequestsService) ListConflicts(ctx context.Context, owner, project, repo string, pullNum int, opts *PullRequestConflictsListOptions) ([]*GitConflict, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/conflicts?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+	)
+
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(PullRequestConflictsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.Conflicts, resp, err
+}
+
+// GetConflict Retrieve one conflict for a pull request by ID.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20conflicts/get
+func (s *PullRequestsService) GetConflict(ctx context.Context, owner, project, repo string, pullNum, conflictID int) (*GitConflict, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/conflicts/%d?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		conflictID,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(GitConflict)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, resp, err
+}
+
+// UpdateConflict Update merge conflict resolution. conflict must include
+// the ConflictID, ConflictType and Resolution.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20conflicts/update
+func (s *PullRequestsService) UpdateConflict(ctx context.Context, owner, project, repo string, pullNum int, conflict *GitConflict) (*GitConflict, *http.Response, error) {
+	if conflict.GetConflictID() == 0 || conflict.GetResolution() == nil {
+		return nil, nil, errors.New("PullRequests.UpdateConflict: Must supply a ConflictID and Resolution")
+	}
+
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/conflicts/%d?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		conflict.GetConflictID(),
+	)
+
+	req, err := s.client.NewRequest("PATCH", URL, conflict)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(GitConflict)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, resp, err
+}
+
+// UpdateConflicts Update multiple merge conflict resolutions in a single
+// request.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20conflicts/update%20pull%20request%20conflicts
+func (s *PullRequestsService) UpdateConflicts(ctx context.Context, owner, project, repo string, pullNum int, conflicts []*GitConflict) ([]*GitConflictUpdateResult, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/conflicts?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+	)
+
+	if len(conflicts) == 0 {
+		return nil, nil, errors.New("PullRequests.UpdateConflicts: Must supply at least one conflict")
+	}
+
+	req, err := s.client.NewRequest("PATCH", URL, conflicts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(PullRequestConflictUpdateResultsResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.Results, resp, err
+}
+
+// ResolveConflicts calls resolve for every unresolved conflict of a pull
+// request and submits the non-nil resolutions it returns in a single
+// UpdateConflicts request. Conflicts for which resolve returns nil are left
+// untouched, so it can be used to automatically resolve only well known
+// files, e.g. by regenerating lock files. If no conflict was resolved, no
+// update request is made and the returned results are empty.
+func (s *PullRequestsService) ResolveConflicts(ctx context.Context, owner, project, repo string, pullNum int, resolve func(*GitConflict) *GitResolution) ([]*GitConflictUpdateResult, *http.Response, error) {
+	opts := &PullRequestConflictsListOptions{ExcludeResolved: true}
+	conflicts, resp, err := s.ListConflicts(ctx, owner, project, repo, pullNum, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var updates []*GitConflict
+	for _, conflict := range conflicts {
+		resolution := resolve(conflict)
+		if resolution == nil {
+			continue
+		}
+		updates = append(updates, &GitConflict{
+			ConflictID:   conflict.ConflictID,
+			ConflictType: conflict.ConflictType,
+			Resolution:   resolution,
+		})
+	}
+	if len(updates) == 0 {
+		return nil, resp, nil
+	}
+
+	return s.UpdateConflicts(ctx, owner, project, repo, pullNum, updates)
+}
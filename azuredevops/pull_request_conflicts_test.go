@@ -0,0 +1,162 @@
+package azuredevops_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+const (
+	conflictsListURL      = "/o/p/_apis/git/repositories/r/pullrequests/1/conflicts"
+	conflictsListResponse = `{
+		"value": [
+			{ "conflictId": 1, "conflictPath": "/go.sum", "conflictType": "editEdit", "resolutionStatus": "unresolved" },
+			{ "conflictId": 2, "conflictPath": "/main.go", "conflictType": "deleteEdit", "resolutionStatus": "unresolved" }
+		],
+		"count": 2
+	}`
+)
+
+func TestPullRequestsService_ListConflicts(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(conflictsListURL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"excludeResolved": "true",
+		})
+		fmt.Fprint(w, conflictsListResponse)
+	})
+
+	opts := &azuredevops.PullRequestConflictsListOptions{ExcludeResolved: true}
+	got, _, err := c.PullRequests.ListConflicts(context.Background(), "o", "p", "r", 1, opts)
+	if err != nil {
+		t.Fatalf("PullRequests.ListConflicts returned error: %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("PullRequests.ListConflicts returned %d conflicts, want 2", len(got))
+	}
+	if got[0].GetConflictType() == nil || *got[0].GetConflictType() != azuredevops.ConflictEditEdit {
+		t.Errorf("PullRequests.ListConflicts conflict type is %v, want %v", got[0].GetConflictType(), azuredevops.ConflictEditEdit)
+	}
+	if got[1].GetResolutionStatus() == nil || *got[1].GetResolutionStatus() != azuredevops.ResolutionUnresolved {
+		t.Errorf("PullRequests.ListConflicts resolution status is %v, want %v", got[1].GetResolutionStatus(), azuredevops.ResolutionUnresolved)
+	}
+}
+
+func TestPullRequestsService_GetConflict(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(conflictsListURL+"/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"conflictId": 1, "conflictPath": "/go.sum"}`)
+	})
+
+	got, _, err := c.PullRequests.GetConflict(context.Background(), "o", "p", "r", 1, 1)
+	if err != nil {
+		t.Fatalf("PullRequests.GetConflict returned error: %v", err)
+	}
+
+	want := &azuredevops.GitConflict{ConflictID: Int(1), ConflictPath: String("/go.sum")}
+	if !cmp.Equal(got, want) {
+		t.Errorf("PullRequests.GetConflict returned %+v, want %+v", got, want)
+	}
+}
+
+func TestPullRequestsService_UpdateConflict(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(conflictsListURL+"/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"conflictId":1,"resolution":{"mergeType":"userMerged","userMergedContent":"bWVyZ2Vk"}}`+"\n")
+		fmt.Fprint(w, `{"conflictId": 1, "resolutionStatus": "resolved"}`)
+	})
+
+	conflict := &azuredevops.GitConflict{
+		ConflictID: Int(1),
+		Resolution: azuredevops.UserMergedResolution([]byte("merged")),
+	}
+	got, _, err := c.PullRequests.UpdateConflict(context.Background(), "o", "p", "r", 1, conflict)
+	if err != nil {
+		t.Fatalf("PullRequests.UpdateConflict returned error: %v", err)
+	}
+	if got.GetResolutionStatus() == nil || *got.GetResolutionStatus() != azuredevops.ResolutionResolved {
+		t.Errorf("PullRequests.UpdateConflict resolution status is %v, want %v", got.GetResolutionStatus(), azuredevops.ResolutionResolved)
+	}
+
+	_, _, err = c.PullRequests.UpdateConflict(context.Background(), "o", "p", "r", 1, &azuredevops.GitConflict{ConflictID: Int(1)})
+	if err == nil {
+		t.Errorf("PullRequests.UpdateConflict accepted a conflict without a resolution")
+	}
+}
+
+func TestPullRequestsService_ResolveConflicts(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	var updated []*azuredevops.GitConflict
+	mux.HandleFunc(conflictsListURL, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, conflictsListResponse)
+		case "PATCH":
+			b, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(b, &updated)
+			fmt.Fprint(w, `{"count": 1, "value": [{"conflictId": 1, "updateStatus": "succeeded"}]}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	resolve := func(conflict *azuredevops.GitConflict) *azuredevops.GitResolution {
+		if conflict.GetConflictPath() == "/go.sum" {
+			return azuredevops.TakeTargetResolution()
+		}
+		return nil
+	}
+	got, _, err := c.PullRequests.ResolveConflicts(context.Background(), "o", "p", "r", 1, resolve)
+	if err != nil {
+		t.Fatalf("PullRequests.ResolveConflicts returned error: %v", err)
+	}
+
+	if len(got) != 1 || got[0].GetUpdateStatus() != "succeeded" {
+		t.Errorf("PullRequests.ResolveConflicts returned %+v", got)
+	}
+	editEdit := azuredevops.ConflictEditEdit
+	want := []*azuredevops.GitConflict{{
+		ConflictID:   Int(1),
+		ConflictType: &editEdit,
+		Resolution:   azuredevops.TakeTargetResolution(),
+	}}
+	if !cmp.Equal(updated, want) {
+		t.Errorf("PullRequests.ResolveConflicts sent %s", cmp.Diff(updated, want))
+	}
+}
+
+func TestPickOneResolution(t *testing.T) {
+	b, err := json.Marshal(azuredevops.PickOneResolution(azuredevops.PickRight))
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	if want := `{"action":"pickRight"}`; string(b) != want {
+		t.Errorf("PickOneResolution marshalled to %s, want %s", b, want)
+	}
+
+	resolution := new(azuredevops.GitResolution)
+	if err := json.Unmarshal([]byte(`{"action":"pickLeft"}`), resolution); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if resolution.GetAction() == nil || *resolution.GetAction() != azuredevops.PickLeft {
+		t.Errorf("GitResolution action is %v, want %v", resolution.GetAction(), azuredevops.PickLeft)
+	}
+}
@@ -0,0 +1,95 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PullRequestLabelsListResponse describes a pull request labels list response
+type PullRequestLabelsListResponse struct {
+	Count  int                    `json:"count"`
+	Labels []*WebAPITagDefinition `json:"value"`
+}
+
+// WebAPICreateTagRequestData The representation of data needed to create a
+// tag definition which is sent across the wire.
+type WebAPICreateTagRequestData struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// ListLabels Get all the labels assigned to a pull request.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20labels/list
+func (s *PullRequestsService) ListLabels(ctx context.Context, owner, project, repo string, pullNum int) ([]*WebAPITagDefinition, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/labels?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(PullRequestLabelsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.Labels, resp, err
+}
+
+// AddLabel Create a label for a specified pull request. The only required
+// field is the name of the new label.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20labels/create
+func (s *PullRequestsService) AddLabel(ctx context.Context, owner, project, repo string, pullNum int, name string) (*WebAPITagDefinition, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/labels?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+	)
+
+	if name == "" {
+		return nil, nil, errors.New("PullRequests.AddLabel: Must supply a label name")
+	}
+
+	body := &WebAPICreateTagRequestData{Name: String(name)}
+	req, err := s.client.NewRequest("POST", URL, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(WebAPITagDefinition)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, resp, err
+}
+
+// RemoveLabel Removes a label from the set of those assigned to the pull
+// request. labelIDOrName may be either the ID or the name of the label.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20labels/delete
+func (s *PullRequestsService) RemoveLabel(ctx context.Context, owner, project, repo string, pullNum int, labelIDOrName string) (*http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/labels/%s?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		url.PathEscape(labelIDOrName),
+	)
+
+	req, err := s.client.NewRequest("DELETE", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Execute(ctx, req, nil)
+}
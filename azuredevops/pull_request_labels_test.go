@@ -0,0 +1,85 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+const labelsListURL = "/o/p/_apis/git/repositories/r/pullrequests/1/labels"
+
+func TestPullRequestsService_ListLabels(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(labelsListURL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"value": [
+				{
+					"id": "921dbcd6-f8a3-4b7c-8e4b-6a5a7a0f4d61",
+					"name": "needs-qa",
+					"active": true
+				}
+			],
+			"count": 1
+		}`)
+	})
+
+	got, _, err := c.PullRequests.ListLabels(context.Background(), "o", "p", "r", 1)
+	if err != nil {
+		t.Fatalf("PullRequests.ListLabels returned error: %v", err)
+	}
+
+	want := []*azuredevops.WebAPITagDefinition{{
+		ID:     String("921dbcd6-f8a3-4b7c-8e4b-6a5a7a0f4d61"),
+		Name:   String("needs-qa"),
+		Active: Bool(true),
+	}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("PullRequests.ListLabels returned %+v, want %+v", got, want)
+	}
+}
+
+func TestPullRequestsService_AddLabel(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(labelsListURL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"needs-qa"}`+"\n")
+		fmt.Fprint(w, `{"id": "921dbcd6-f8a3-4b7c-8e4b-6a5a7a0f4d61", "name": "needs-qa", "active": true}`)
+	})
+
+	got, _, err := c.PullRequests.AddLabel(context.Background(), "o", "p", "r", 1, "needs-qa")
+	if err != nil {
+		t.Fatalf("PullRequests.AddLabel returned error: %v", err)
+	}
+	if got.GetName() != "needs-qa" {
+		t.Errorf("PullRequests.AddLabel returned %+v", got)
+	}
+
+	_, _, err = c.PullRequests.AddLabel(context.Background(), "o", "p", "r", 1, "")
+	if err == nil {
+		t.Errorf("PullRequests.AddLabel accepted an empty name")
+	}
+}
+
+func TestPullRequestsService_RemoveLabel(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(labelsListURL+"/needs qa", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := c.PullRequests.RemoveLabel(context.Background(), "o", "p", "r", 1, "needs qa")
+	if err != nil {
+		t.Fatalf("PullRequests.RemoveLabel returned error: %v", err)
+	}
+}
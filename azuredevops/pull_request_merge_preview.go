@@ -0,0 +1,153 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// maxStaleMergePreviewPolls is the number of polls PreviewMerge ignores
+// while the pull request still reports the previous merge. The service
+// does not recompute a merge when nothing changed, so the previous merge
+// is then accepted as the result.
+const maxStaleMergePreviewPolls = 3
+
+// PullRequestMergePreview describes the result of a test merge of a pull
+// request's source branch into its target branch.
+type PullRequestMergePreview struct {
+	// Wait How waiting for the merge status ended.
+	Wait *PullRequestWaitResult
+	// MergeStatus The merge status of the test merge.
+	MergeStatus PullRequestAsyncStatus
+	// LastMergeSourceCommit The source branch commit that was merged.
+	LastMergeSourceCommit *GitCommitRef
+	// LastMergeTargetCommit The target branch commit that was merged into.
+	LastMergeTargetCommit *GitCommitRef
+	// LastMergeCommit The commit of the test merge.
+	LastMergeCommit *GitCommitRef
+	// Diff The changes the merge commit makes to the target branch. It is
+	// only set when the merge succeeded.
+	Diff *GitCommitDiffs
+}
+
+// Clean reports whether the pull request would merge without conflicts or
+// failures.
+func (p *PullRequestMergePreview) Clean() bool {
+	return p.MergeStatus == MergeSucceeded
+}
+
+// QueueMerge asks the service to recompute the test merge of a pull
+// request by updating its merge options. If opts is nil, the current merge
+// options of the pull request are kept.
+// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20requests/update?view=azure-devops-rest-5.1
+func (s *PullRequestsService) QueueMerge(ctx context.Context, owner, project, repo string, pullNum int, opts *GitPullRequestMergeOptions) (*GitPullRequest, *http.Response, error) {
+	if opts == nil {
+		pull, resp, err := s.GetWithRepo(ctx, owner, project, repo, pullNum, nil)
+		if err != nil {
+			return nil, resp, err
+		}
+		opts = pull.GetMergeOptions()
+		if opts == nil {
+			opts = &GitPullRequestMergeOptions{}
+		}
+	}
+
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+	)
+
+	// GitPullRequest would also send a null completionQueueTime, so only the
+	// merge options are sent.
+	body := struct {
+		MergeOptions *GitPullRequestMergeOptions `json:"mergeOptions"`
+	}{opts}
+	req, err := s.client.NewRequest("PATCH", URL, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(GitPullRequest)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, resp, err
+}
+
+// PreviewMerge queues a test merge of a pull request, waits for its merge
+// status and, when the merge succeeded, retrieves the diff of the merge
+// commit against the target branch commit it was merged into.
+//
+// The merge status of the previous merge is still reported until the
+// service picks up the queued merge, so up to 3 polls are ignored while the
+// merge ID and merge commit are those seen before queueing and the status
+// has not been queued. After that the previous merge is accepted, as the
+// service does not recompute a merge when nothing changed.
+func (s *PullRequestsService) PreviewMerge(ctx context.Context, owner, project, repo string, pullNum int, opts *PullRequestWaitOptions) (*PullRequestMergePreview, *http.Response, error) {
+	before, resp, err := s.GetWithRepo(ctx, owner, project, repo, pullNum, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	mergeOptions := before.GetMergeOptions()
+	if mergeOptions == nil {
+		mergeOptions = &GitPullRequestMergeOptions{}
+	}
+
+	_, resp, err = s.QueueMerge(ctx, owner, project, repo, pullNum, mergeOptions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	ignored, queued := 0, false
+	stale := func(pull *GitPullRequest) bool {
+		if queued || ignored >= maxStaleMergePreviewPolls {
+			return false
+		}
+		if ParsePullRequestAsyncStatus(pull.GetMergeStatus()) == MergeQueued {
+			queued = true
+			return false
+		}
+		if pull.GetMergeID() != before.GetMergeID() ||
+			pull.GetLastMergeCommit().GetCommitID() != before.GetLastMergeCommit().GetCommitID() {
+			return false
+		}
+		ignored++
+		return true
+	}
+	wait, resp, err := s.waitFor(ctx, owner, project, repo, pullNum, opts, false, stale)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	pull := wait.PullRequest
+	preview := &PullRequestMergePreview{
+		Wait:                  wait,
+		MergeStatus:           wait.MergeStatus,
+		LastMergeSourceCommit: pull.GetLastMergeSourceCommit(),
+		LastMergeTargetCommit: pull.GetLastMergeTargetCommit(),
+		LastMergeCommit:       pull.GetLastMergeCommit(),
+	}
+	if !preview.Clean() || preview.LastMergeCommit == nil || preview.LastMergeTargetCommit == nil {
+		return preview, resp, nil
+	}
+
+	diffOpts := &GitDiffsOptions{
+		BaseVersionType:   "commit",
+		TargetVersionType: "commit",
+		DiffCommonCommit:  Bool(false),
+	}
+	preview.Diff, resp, err = s.client.Git.GetDiffsWithOptions(ctx, owner, project, repo,
+		preview.LastMergeTargetCommit.GetCommitID(),
+		preview.LastMergeCommit.GetCommitID(),
+		diffOpts,
+	)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return preview, resp, nil
+}
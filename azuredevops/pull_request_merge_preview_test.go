@@ -0,0 +1,144 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+func TestPullRequestsService_PreviewMerge(t *testing.T) {
+	tt := []struct {
+		name      string
+		status    string
+		wantClean bool
+		wantDiff  bool
+	}{
+		{name: "clean merge", status: "succeeded", wantClean: true, wantDiff: true},
+		{name: "conflicting merge", status: "conflicts"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			c, mux, _, teardown := setup()
+			defer teardown()
+
+			// The first poll after queueing still reports the previous merge
+			queued, polls := false, 0
+			previous := `{
+				"pullRequestId": 1,
+				"status": "active",
+				"mergeStatus": "succeeded",
+				"mergeId": "old",
+				"lastMergeCommit": {"commitId": "old-m"},
+				"mergeOptions": {"disableRenames": true}
+			}`
+			mux.HandleFunc("/o/p/_apis/git/repositories/r/pullrequests/1", func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case "PATCH":
+					testBody(t, r, `{"mergeOptions":{"disableRenames":true}}`+"\n")
+					queued = true
+					fmt.Fprint(w, `{"pullRequestId": 1, "status": "active", "mergeStatus": "queued"}`)
+				case "GET":
+					if !queued {
+						fmt.Fprint(w, previous)
+						return
+					}
+					if polls++; polls == 1 {
+						fmt.Fprint(w, previous)
+						return
+					}
+					fmt.Fprintf(w, `{
+						"pullRequestId": 1,
+						"status": "active",
+						"mergeStatus": %q,
+						"mergeId": "new",
+						"lastMergeSourceCommit": {"commitId": "s"},
+						"lastMergeTargetCommit": {"commitId": "t"},
+						"lastMergeCommit": {"commitId": "m"}
+					}`, tc.status)
+				}
+			})
+			diffed := false
+			mux.HandleFunc("/o/p/_apis/git/repositories/r/diffs/commits", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testFormValues(t, r, values{
+					"baseVersion":       "t",
+					"baseVersionType":   "commit",
+					"targetVersion":     "m",
+					"targetVersionType": "commit",
+					"diffCommonCommit":  "false",
+				})
+				diffed = true
+				fmt.Fprint(w, `{"baseCommit": "t", "targetCommit": "m", "changes": [{"item": {"path": "/a.go"}, "changeType": "edit"}]}`)
+			})
+
+			opts := &azuredevops.PullRequestWaitOptions{PollInterval: time.Millisecond}
+			got, _, err := c.PullRequests.PreviewMerge(context.Background(), "o", "p", "r", 1, opts)
+			if err != nil {
+				t.Fatalf("PullRequests.PreviewMerge returned error: %v", err)
+			}
+
+			if got.Clean() != tc.wantClean {
+				t.Errorf("PullRequestMergePreview.Clean is %v, want %v", got.Clean(), tc.wantClean)
+			}
+			if got.Wait.Polls != 2 {
+				t.Errorf("PullRequestMergePreview polled %d times, want 2", got.Wait.Polls)
+			}
+			if got.LastMergeCommit.GetCommitID() != "m" {
+				t.Errorf("LastMergeCommit is %q, want %q", got.LastMergeCommit.GetCommitID(), "m")
+			}
+			if diffed != tc.wantDiff || (got.Diff != nil) != tc.wantDiff {
+				t.Errorf("diff retrieved = %v, want %v", diffed, tc.wantDiff)
+			}
+			if tc.wantDiff && len(got.Diff.Changes) != 1 {
+				t.Errorf("Diff has %d changes, want 1", len(got.Diff.Changes))
+			}
+		})
+	}
+}
+
+func TestPullRequestsService_PreviewMerge_unchanged(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	// The service never recomputes the merge, as nothing changed
+	gets := 0
+	mux.HandleFunc("/o/p/_apis/git/repositories/r/pullrequests/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PATCH":
+			fmt.Fprint(w, `{"pullRequestId": 1, "status": "active", "mergeStatus": "succeeded", "mergeId": "old"}`)
+		case "GET":
+			gets++
+			fmt.Fprint(w, `{
+				"pullRequestId": 1,
+				"status": "active",
+				"mergeStatus": "succeeded",
+				"mergeId": "old",
+				"lastMergeTargetCommit": {"commitId": "t"},
+				"lastMergeCommit": {"commitId": "m"}
+			}`)
+		}
+	})
+	mux.HandleFunc("/o/p/_apis/git/repositories/r/diffs/commits", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"baseCommit": "t", "targetCommit": "m"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	opts := &azuredevops.PullRequestWaitOptions{PollInterval: time.Millisecond}
+	got, _, err := c.PullRequests.PreviewMerge(ctx, "o", "p", "r", 1, opts)
+	if err != nil {
+		t.Fatalf("PullRequests.PreviewMerge returned error: %v", err)
+	}
+
+	if !got.Clean() || got.LastMergeCommit.GetCommitID() != "m" || got.Diff == nil {
+		t.Errorf("PullRequests.PreviewMerge returned %+v, want the previous clean merge", got)
+	}
+	if got.Wait.Polls != 4 {
+		t.Errorf("PullRequestMergePreview polled %d times, want 4", got.Wait.Polls)
+	}
+}
@@ -0,0 +1,72 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// PullRequestPropertiesResponse describes a pull request properties response
+type PullRequestPropertiesResponse struct {
+	Count      int                  `json:"count"`
+	Properties PropertiesCollection `json:"value"`
+}
+
+// GetProperties Get external properties of the pull request.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20properties/list
+func (s *PullRequestsService) GetProperties(ctx context.Context, owner, project, repo string, pullNum int) (PropertiesCollection, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/properties?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(PullRequestPropertiesResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.Properties, resp, err
+}
+
+// UpdateProperties Create or update pull request external properties. The
+// patch operation can be add, replace or remove. For add operation, the path
+// can be empty. If the path is empty, the value must be a list of key value
+// pairs. For replace operation, the path cannot be empty. If the path does
+// not exist, the property will be added to the collection. For remove
+// operation, the path cannot be empty. If the path does not exist, no action
+// will be performed. The resulting properties are returned.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20properties/update
+func (s *PullRequestsService) UpdateProperties(ctx context.Context, owner, project, repo string, pullNum int, patch []*JSONPatchOperation) (PropertiesCollection, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/properties?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+	)
+
+	if len(patch) == 0 {
+		return nil, nil, errors.New("PullRequests.UpdateProperties: Must supply at least one patch operation")
+	}
+
+	req, err := s.client.NewJSONPatchRequest("PATCH", URL, patch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(PullRequestPropertiesResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.Properties, resp, err
+}
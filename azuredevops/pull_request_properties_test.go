@@ -0,0 +1,75 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+const propertiesURL = "/o/p/_apis/git/repositories/r/pullrequests/1/properties"
+
+func TestPullRequestsService_GetProperties(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(propertiesURL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"count": 2,
+			"value": {
+				"coverage.lastRun": { "$type": "System.String", "$value": "2020-01-20" },
+				"coverage.percent": { "$type": "System.Int32", "$value": 80 }
+			}
+		}`)
+	})
+
+	got, _, err := c.PullRequests.GetProperties(context.Background(), "o", "p", "r", 1)
+	if err != nil {
+		t.Fatalf("PullRequests.GetProperties returned error: %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("PullRequests.GetProperties returned %d properties, want 2", len(got))
+	}
+	if v, ok := got.GetString("coverage.lastRun"); !ok || v != "2020-01-20" {
+		t.Errorf("coverage.lastRun is %q, want %q", v, "2020-01-20")
+	}
+	if v := got["coverage.percent"].Value; v != float64(80) {
+		t.Errorf("coverage.percent is %v, want 80", v)
+	}
+}
+
+func TestPullRequestsService_UpdateProperties(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(propertiesURL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		if got := r.Header.Get("Content-Type"); got != "application/json-patch+json" {
+			t.Errorf("Content-Type is %q, want %q", got, "application/json-patch+json")
+		}
+		testBody(t, r, `[{"op":"replace","path":"/coverage.lastRun","value":"2020-01-21"},{"op":"remove","path":"/coverage.percent"}]`+"\n")
+		fmt.Fprint(w, `{
+			"count": 1,
+			"value": {
+				"coverage.lastRun": { "$type": "System.String", "$value": "2020-01-21" }
+			}
+		}`)
+	})
+
+	patch := []*azuredevops.JSONPatchOperation{
+		{Op: azuredevops.PatchOpReplace, Path: "/coverage.lastRun", Value: "2020-01-21"},
+		{Op: azuredevops.PatchOpRemove, Path: "/coverage.percent"},
+	}
+	got, _, err := c.PullRequests.UpdateProperties(context.Background(), "o", "p", "r", 1, patch)
+	if err != nil {
+		t.Fatalf("PullRequests.UpdateProperties returned error: %v", err)
+	}
+
+	if v, ok := got.GetString("coverage.lastRun"); !ok || v != "2020-01-21" {
+		t.Errorf("coverage.lastRun is %q, want %q", v, "2020-01-21")
+	}
+}
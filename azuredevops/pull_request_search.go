@@ -0,0 +1,198 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultSearchPageSize = 100
+	defaultSearchWorkers  = 4
+)
+
+// PullRequestTimeRangeType Specifies the type of time range to use for
+// PullRequestSearchCriteria.MinTime and MaxTime.
+type PullRequestTimeRangeType string
+
+// PullRequestTimeRangeType enum values
+const (
+	// TimeRangeCreated The date when the pull request was created.
+	TimeRangeCreated PullRequestTimeRangeType = "created"
+	// TimeRangeClosed The date when the pull request was closed (completed,
+	// abandoned, or merged externally).
+	TimeRangeClosed PullRequestTimeRangeType = "closed"
+)
+
+// PullRequestSearchCriteria Pull requests can be searched for matching
+// this criteria. Unset fields are not used to filter the results.
+type PullRequestSearchCriteria struct {
+	// Status If set, only return pull requests with this status. The
+	// service default is PullActive; use PullIncludeAll for every status.
+	Status *PullRequestStatus
+	// CreatorID If set, only return pull requests created by this identity.
+	CreatorID string
+	// ReviewerID If set, only return pull requests that have this identity
+	// as a reviewer.
+	ReviewerID string
+	// RepositoryID If set, search for pull requests whose target branch is
+	// in this repository.
+	RepositoryID string
+	// SourceRepositoryID If set, search for pull requests whose source
+	// branch is in this repository.
+	SourceRepositoryID string
+	// SourceRefName If set, search for pull requests from this branch.
+	// Branch names are expanded to full refs, e.g. refs/heads/feature.
+	SourceRefName string
+	// TargetRefName If set, search for pull requests into this branch.
+	TargetRefName string
+	// MinTime If set, only return pull requests created or closed (see
+	// TimeRangeType) at or after this time.
+	MinTime time.Time
+	// MaxTime If set, only return pull requests created or closed (see
+	// TimeRangeType) at or before this time.
+	MaxTime time.Time
+	// TimeRangeType The type of time range MinTime and MaxTime apply to.
+	// The service default is TimeRangeCreated.
+	TimeRangeType PullRequestTimeRangeType
+	// IncludeLinks Whether to include the _links field on the returned pull
+	// requests.
+	IncludeLinks bool
+	// PageSize The number of pull requests to retrieve per request.
+	// Defaults to 100.
+	PageSize int
+	// MaxResults If set, stop paging once this many pull requests have been
+	// retrieved.
+	MaxResults int
+}
+
+// pullRequestSearchQuery is the query string form of PullRequestSearchCriteria
+type pullRequestSearchQuery struct {
+	Status             string `url:"searchCriteria.status,omitempty"`
+	CreatorID          string `url:"searchCriteria.creatorId,omitempty"`
+	ReviewerID         string `url:"searchCriteria.reviewerId,omitempty"`
+	RepositoryID       string `url:"searchCriteria.repositoryId,omitempty"`
+	SourceRepositoryID string `url:"searchCriteria.sourceRepositoryId,omitempty"`
+	SourceRefName      string `url:"searchCriteria.sourceRefName,omitempty"`
+	TargetRefName      string `url:"searchCriteria.targetRefName,omitempty"`
+	MinTime            string `url:"searchCriteria.minTime,omitempty"`
+	MaxTime            string `url:"searchCriteria.maxTime,omitempty"`
+	TimeRangeType      string `url:"searchCriteria.queryTimeRangeType,omitempty"`
+	IncludeLinks       bool   `url:"searchCriteria.includeLinks,omitempty"`
+	Top                int    `url:"$top,omitempty"`
+	Skip               int    `url:"$skip,omitempty"`
+}
+
+func (c *PullRequestSearchCriteria) query() *pullRequestSearchQuery {
+	q := &pullRequestSearchQuery{
+		CreatorID:          c.CreatorID,
+		ReviewerID:         c.ReviewerID,
+		RepositoryID:       c.RepositoryID,
+		SourceRepositoryID: c.SourceRepositoryID,
+		SourceRefName:      c.SourceRefName,
+		TargetRefName:      c.TargetRefName,
+		TimeRangeType:      string(c.TimeRangeType),
+		IncludeLinks:       c.IncludeLinks,
+		Top:                c.PageSize,
+	}
+	if c.Status != nil {
+		q.Status = c.Status.String()
+	}
+	if q.SourceRefName != "" {
+		formatRef(&q.SourceRefName)
+	}
+	if q.TargetRefName != "" {
+		formatRef(&q.TargetRefName)
+	}
+	if !c.MinTime.IsZero() {
+		q.MinTime = c.MinTime.UTC().Format(time.RFC3339)
+	}
+	if !c.MaxTime.IsZero() {
+		q.MaxTime = c.MaxTime.UTC().Format(time.RFC3339)
+	}
+	if q.Top <= 0 {
+		q.Top = defaultSearchPageSize
+	}
+	if c.MaxResults > 0 && c.MaxResults < q.Top {
+		q.Top = c.MaxResults
+	}
+	return q
+}
+
+// Search returns every pull request in the project matching criteria,
+// requesting further pages until a short page is returned or
+// criteria.MaxResults is reached.
+// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20requests/get%20pull%20requests%20by%20project
+func (s *PullRequestsService) Search(ctx context.Context, owner, project string, criteria *PullRequestSearchCriteria) ([]*GitPullRequest, *http.Response, error) {
+	if criteria == nil {
+		criteria = &PullRequestSearchCriteria{}
+	}
+	q := criteria.query()
+
+	var pulls []*GitPullRequest
+	for {
+		URL := fmt.Sprintf("%s/%s/_apis/git/pullrequests?api-version=5.1-preview.1",
+			owner,
+			project,
+		)
+		URL, err := addOptions(URL, q)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		req, err := s.client.NewRequest("GET", URL, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		r := new(PullRequestsListResponse)
+		resp, err := s.client.Execute(ctx, req, r)
+		if err != nil {
+			return nil, resp, err
+		}
+		pulls = append(pulls, r.GitPullRequests...)
+
+		if criteria.MaxResults > 0 && len(pulls) >= criteria.MaxResults {
+			return pulls[:criteria.MaxResults], resp, nil
+		}
+		if len(r.GitPullRequests) < q.Top {
+			return pulls, resp, nil
+		}
+		q.Skip += len(r.GitPullRequests)
+	}
+}
+
+// SearchOrganization returns the pull requests matching criteria in every
+// project of the organization. Projects are searched concurrently by at
+// most workers goroutines (4 if workers is not positive). Results are
+// grouped by project in the order the projects are listed, and
+// criteria.MaxResults applies to each project. The first error cancels the
+// remaining searches and is returned with the response to the failed
+// request. Otherwise the response is the one to listing the projects.
+func (s *PullRequestsService) SearchOrganization(ctx context.Context, owner string, criteria *PullRequestSearchCriteria, workers int) ([]*GitPullRequest, *http.Response, error) {
+	if workers <= 0 {
+		workers = defaultSearchWorkers
+	}
+
+	projects, resp, err := s.client.Projects.ListAll(ctx, owner, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	results := make([][]*GitPullRequest, len(projects))
+	searchResp, err := forEach(ctx, len(projects), workers, func(ctx context.Context, i int) (*http.Response, error) {
+		pulls, resp, err := s.Search(ctx, owner, projects[i].GetName(), criteria)
+		results[i] = pulls
+		return resp, err
+	})
+	if err != nil {
+		return nil, searchResp, err
+	}
+
+	var pulls []*GitPullRequest
+	for _, r := range results {
+		pulls = append(pulls, r...)
+	}
+	return pulls, resp, nil
+}
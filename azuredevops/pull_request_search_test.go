@@ -0,0 +1,156 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+func TestPullRequestsService_Search(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	wantTop := "2"
+	mux.HandleFunc("/o/p/_apis/git/pullrequests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		skip := r.URL.Query().Get("$skip")
+		want := values{
+			"searchCriteria.status":             "completed",
+			"searchCriteria.creatorId":          "c",
+			"searchCriteria.targetRefName":      "refs/heads/master",
+			"searchCriteria.minTime":            "2020-01-02T03:04:05Z",
+			"searchCriteria.queryTimeRangeType": "closed",
+			"$top":                              wantTop,
+		}
+		if skip != "" {
+			want["$skip"] = skip
+		}
+		testFormValues(t, r, want)
+
+		switch skip {
+		case "":
+			fmt.Fprint(w, `{"count": 2, "value": [{"pullRequestId": 1}, {"pullRequestId": 2}]}`)
+		case "2":
+			fmt.Fprint(w, `{"count": 1, "value": [{"pullRequestId": 3}]}`)
+		default:
+			t.Errorf("unexpected $skip %q", skip)
+		}
+	})
+
+	status := azuredevops.PullCompleted
+	criteria := &azuredevops.PullRequestSearchCriteria{
+		Status:        &status,
+		CreatorID:     "c",
+		TargetRefName: "master",
+		MinTime:       time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		TimeRangeType: azuredevops.TimeRangeClosed,
+		PageSize:      2,
+	}
+	got, _, err := c.PullRequests.Search(context.Background(), "o", "p", criteria)
+	if err != nil {
+		t.Fatalf("PullRequests.Search returned error: %v", err)
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("PullRequests.Search returned %d pull requests, want 3", len(got))
+	}
+	for i, pull := range got {
+		if pull.GetPullRequestID() != i+1 {
+			t.Errorf("pull request %d has ID %d, want %d", i, pull.GetPullRequestID(), i+1)
+		}
+	}
+
+	criteria.MaxResults = 1
+	wantTop = "1"
+	got, _, err = c.PullRequests.Search(context.Background(), "o", "p", criteria)
+	if err != nil {
+		t.Fatalf("PullRequests.Search returned error: %v", err)
+	}
+	if len(got) != 1 {
+		t.Errorf("PullRequests.Search with MaxResults returned %d pull requests, want 1", len(got))
+	}
+}
+
+func TestPullRequestsService_Search_nestedBranches(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/git/pullrequests", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{
+			"searchCriteria.sourceRefName": "refs/heads/feature/x",
+			"searchCriteria.targetRefName": "refs/heads/release/1.0",
+			"$top":                         "100",
+		})
+		fmt.Fprint(w, `{"count": 0, "value": []}`)
+	})
+
+	criteria := &azuredevops.PullRequestSearchCriteria{
+		SourceRefName: "feature/x",
+		TargetRefName: "refs/heads/release/1.0",
+	}
+	if _, _, err := c.PullRequests.Search(context.Background(), "o", "p", criteria); err != nil {
+		t.Fatalf("PullRequests.Search returned error: %v", err)
+	}
+}
+
+func TestPullRequestsService_SearchOrganization(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/_apis/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 3, "value": [{"name": "p1"}, {"name": "p2"}, {"name": "p3"}]}`)
+	})
+	for i := 1; i <= 3; i++ {
+		id := i
+		mux.HandleFunc("/o/p"+strconv.Itoa(id)+"/_apis/git/pullrequests", func(w http.ResponseWriter, r *http.Request) {
+			testFormValues(t, r, values{
+				"searchCriteria.status": "active",
+				"$top":                  "100",
+			})
+			fmt.Fprintf(w, `{"count": 1, "value": [{"pullRequestId": %d}]}`, id)
+		})
+	}
+
+	status := azuredevops.PullActive
+	got, resp, err := c.PullRequests.SearchOrganization(context.Background(), "o", &azuredevops.PullRequestSearchCriteria{Status: &status}, 2)
+	if err != nil {
+		t.Fatalf("PullRequests.SearchOrganization returned error: %v", err)
+	}
+	if resp == nil {
+		t.Error("PullRequests.SearchOrganization returned no response")
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("PullRequests.SearchOrganization returned %d pull requests, want 3", len(got))
+	}
+	for i, pull := range got {
+		if pull.GetPullRequestID() != i+1 {
+			t.Errorf("pull request %d has ID %d, want %d", i, pull.GetPullRequestID(), i+1)
+		}
+	}
+}
+
+func TestPullRequestsService_SearchOrganization_error(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/_apis/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 2, "value": [{"name": "p1"}, {"name": "p2"}]}`)
+	})
+	mux.HandleFunc("/o/p1/_apis/git/pullrequests", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 0, "value": []}`)
+	})
+	mux.HandleFunc("/o/p2/_apis/git/pullrequests", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := c.PullRequests.SearchOrganization(context.Background(), "o", nil, 0)
+	if err == nil {
+		t.Errorf("PullRequests.SearchOrganization returned no error")
+	}
+}
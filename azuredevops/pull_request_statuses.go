@@ -0,0 +1,164 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// PullRequestStatusesListResponse describes a pull request statuses list response
+type PullRequestStatusesListResponse struct {
+	Count    int                     `json:"count"`
+	Statuses []*GitPullRequestStatus `json:"value"`
+}
+
+// ListStatuses Get all the statuses associated with a pull request.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20statuses/list
+func (s *PullRequestsService) ListStatuses(ctx context.Context, owner, project, repo string, pullNum int) ([]*GitPullRequestStatus, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/statuses?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+	)
+
+	return s.listStatuses(ctx, URL)
+}
+
+// GetStatus Get the specific pull request status by ID. The status ID is
+// unique within the pull request across all iterations.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20statuses/get
+func (s *PullRequestsService) GetStatus(ctx context.Context, owner, project, repo string, pullNum, statusID int) (*GitPullRequestStatus, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/statuses/%d?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		statusID,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(GitPullRequestStatus)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, resp, err
+}
+
+// DeleteStatus Delete pull request status.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20statuses/delete
+func (s *PullRequestsService) DeleteStatus(ctx context.Context, owner, project, repo string, pullNum, statusID int) (*http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/statuses/%d?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		statusID,
+	)
+
+	req, err := s.client.NewRequest("DELETE", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Execute(ctx, req, nil)
+}
+
+// UpdateStatuses Update pull request statuses collection. The only
+// supported operation type is remove, so this is used to delete several
+// statuses in one request, e.g.
+//
+//	[]*JSONPatchOperation{
+//		{Op: PatchOpRemove, Path: "/1"},
+//		{Op: PatchOpRemove, Path: "/2"},
+//	}
+//
+// where the path is the ID of the status to remove.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20statuses/update
+func (s *PullRequestsService) UpdateStatuses(ctx context.Context, owner, project, repo string, pullNum int, patch []*JSONPatchOperation) (*http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/statuses?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+	)
+
+	if len(patch) == 0 {
+		return nil, errors.New("PullRequests.UpdateStatuses: Must supply at least one patch operation")
+	}
+
+	req, err := s.client.NewJSONPatchRequest("PATCH", URL, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Execute(ctx, req, nil)
+}
+
+// CreateIterationStatus Create a pull request status on the iteration. This
+// operation will have the same result as CreateStatus with the specified
+// iteration ID in the request body.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20iteration%20statuses/create
+func (s *PullRequestsService) CreateIterationStatus(ctx context.Context, owner, project, repo string, pullNum, iterationID int, status *GitPullRequestStatus) (*GitPullRequestStatus, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/iterations/%d/statuses?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		iterationID,
+	)
+
+	if context := status.GetContext(); context == nil || context.GetName() == "" {
+		return nil, nil, errors.New("PullRequests.CreateIterationStatus: Must supply a value for Context.Name")
+	}
+
+	req, err := s.client.NewRequest("POST", URL, status)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(GitPullRequestStatus)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, resp, err
+}
+
+// ListIterationStatuses Get all the statuses associated with a pull request
+// iteration.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20iteration%20statuses/list
+func (s *PullRequestsService) ListIterationStatuses(ctx context.Context, owner, project, repo string, pullNum, iterationID int) ([]*GitPullRequestStatus, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/iterations/%d/statuses?api-version=5.1-preview.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		iterationID,
+	)
+
+	return s.listStatuses(ctx, URL)
+}
+
+func (s *PullRequestsService) listStatuses(ctx context.Context, URL string) ([]*GitPullRequestStatus, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(PullRequestStatusesListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.Statuses, resp, err
+}
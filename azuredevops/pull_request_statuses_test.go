@@ -0,0 +1,173 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+const (
+	statusesListURL      = "/o/p/_apis/git/repositories/r/pullrequests/1/statuses"
+	statusesListResponse = `{
+		"value": [
+			{
+				"id": 1,
+				"state": "pending",
+				"description": "Sonar Quality gate",
+				"context": { "name": "QualityGate", "genre": "SonarCloud" },
+				"iterationId": 1
+			},
+			{
+				"id": 2,
+				"state": "succeeded",
+				"description": "Sonar Quality gate",
+				"context": { "name": "QualityGate", "genre": "SonarCloud" },
+				"iterationId": 2
+			}
+		],
+		"count": 2
+	}`
+)
+
+func TestPullRequestsService_ListStatuses(t *testing.T) {
+	tt := []struct {
+		name string
+		URL  string
+		list func(c *azuredevops.Client) ([]*azuredevops.GitPullRequestStatus, *http.Response, error)
+	}{
+		{
+			name: "pull request statuses",
+			URL:  statusesListURL,
+			list: func(c *azuredevops.Client) ([]*azuredevops.GitPullRequestStatus, *http.Response, error) {
+				return c.PullRequests.ListStatuses(context.Background(), "o", "p", "r", 1)
+			},
+		},
+		{
+			name: "iteration statuses",
+			URL:  "/o/p/_apis/git/repositories/r/pullrequests/1/iterations/2/statuses",
+			list: func(c *azuredevops.Client) ([]*azuredevops.GitPullRequestStatus, *http.Response, error) {
+				return c.PullRequests.ListIterationStatuses(context.Background(), "o", "p", "r", 1, 2)
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			c, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc(tc.URL, func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				fmt.Fprint(w, statusesListResponse)
+			})
+
+			got, _, err := tc.list(c)
+			if err != nil {
+				t.Fatalf("returned error: %v", err)
+			}
+
+			if len(got) != 2 {
+				t.Fatalf("returned %d statuses, want 2", len(got))
+			}
+			if got[0].GetState() != "pending" || got[1].GetIterationID() != 2 {
+				t.Errorf("returned %+v, %+v", got[0], got[1])
+			}
+		})
+	}
+}
+
+func TestPullRequestsService_GetStatus(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(statusesListURL+"/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "state": "pending", "iterationId": 1}`)
+	})
+
+	got, _, err := c.PullRequests.GetStatus(context.Background(), "o", "p", "r", 1, 1)
+	if err != nil {
+		t.Fatalf("PullRequests.GetStatus returned error: %v", err)
+	}
+
+	want := &azuredevops.GitPullRequestStatus{IterationID: Int(1)}
+	want.ID = Int(1)
+	want.State = String("pending")
+	if !cmp.Equal(got, want) {
+		t.Errorf("PullRequests.GetStatus returned %+v, want %+v", got, want)
+	}
+}
+
+func TestPullRequestsService_DeleteStatus(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(statusesListURL+"/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := c.PullRequests.DeleteStatus(context.Background(), "o", "p", "r", 1, 1)
+	if err != nil {
+		t.Fatalf("PullRequests.DeleteStatus returned error: %v", err)
+	}
+}
+
+func TestPullRequestsService_UpdateStatuses(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(statusesListURL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		if got := r.Header.Get("Content-Type"); got != "application/json-patch+json" {
+			t.Errorf("Content-Type is %q, want %q", got, "application/json-patch+json")
+		}
+		testBody(t, r, `[{"op":"remove","path":"/1"},{"op":"remove","path":"/2"}]`+"\n")
+	})
+
+	patch := []*azuredevops.JSONPatchOperation{
+		{Op: azuredevops.PatchOpRemove, Path: "/1"},
+		{Op: azuredevops.PatchOpRemove, Path: "/2"},
+	}
+	_, err := c.PullRequests.UpdateStatuses(context.Background(), "o", "p", "r", 1, patch)
+	if err != nil {
+		t.Fatalf("PullRequests.UpdateStatuses returned error: %v", err)
+	}
+
+	_, err = c.PullRequests.UpdateStatuses(context.Background(), "o", "p", "r", 1, nil)
+	if err == nil {
+		t.Errorf("PullRequests.UpdateStatuses accepted an empty patch")
+	}
+}
+
+func TestPullRequestsService_CreateIterationStatus(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/git/repositories/r/pullrequests/1/iterations/2/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"context":{"genre":"ci","name":"build"},"state":"pending"}`+"\n")
+		fmt.Fprint(w, `{"id": 3, "context": {"genre": "ci", "name": "build"}, "state": "pending", "iterationId": 2}`)
+	})
+
+	status := &azuredevops.GitPullRequestStatus{}
+	status.Context = &azuredevops.GitStatusContext{Genre: String("ci"), Name: String("build")}
+	status.State = String(azuredevops.GitPending.String())
+
+	got, _, err := c.PullRequests.CreateIterationStatus(context.Background(), "o", "p", "r", 1, 2, status)
+	if err != nil {
+		t.Fatalf("PullRequests.CreateIterationStatus returned error: %v", err)
+	}
+	if got.GetID() != 3 || got.GetIterationID() != 2 {
+		t.Errorf("PullRequests.CreateIterationStatus returned %+v", got)
+	}
+
+	_, _, err = c.PullRequests.CreateIterationStatus(context.Background(), "o", "p", "r", 1, 2, &azuredevops.GitPullRequestStatus{})
+	if err == nil {
+		t.Errorf("PullRequests.CreateIterationStatus accepted a status without a context")
+	}
+}
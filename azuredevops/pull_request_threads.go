@@ -0,0 +1,394 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// StickyCommentKeyProperty is the comment thread property used to store the
+// key identifying a sticky comment thread created by UpsertStickyComment.
+const StickyCommentKeyProperty = "GoAzureDevops.StickyCommentKey"
+
+// PullRequestThreadsListOptions describes what the request to the API should look like
+type PullRequestThreadsListOptions struct {
+	// Iteration If specified, thread positions will be tracked using this
+	// iteration as the right side of the diff.
+	Iteration int `url:"$iteration,omitempty"`
+	// BaseIteration If specified, thread positions will be tracked using this
+	// iteration as the left side of the diff.
+	BaseIteration int `url:"$baseIteration,omitempty"`
+}
+
+// PullRequestThreadsListResponse describes a pull request threads list response
+type PullRequestThreadsListResponse struct {
+	Count   int                            `json:"count"`
+	Threads []*GitPullRequestCommentThread `json:"value"`
+}
+
+// ListThreads Retrieve all threads in a pull request.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20threads/list
+func (s *PullRequestsService) ListThreads(ctx context.Context, owner, project, repo string, pullNum int, opts *PullRequestThreadsListOptions) ([]*GitPullRequestCommentThread, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/threads?api-version=5.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+	)
+
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(PullRequestThreadsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.Threads, resp, err
+}
+
+// GetThread Retrieve a thread in a pull request.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20threads/get
+func (s *PullRequestsService) GetThread(ctx context.Context, owner, project, repo string, pullNum, threadID int) (*GitPullRequestCommentThread, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/threads/%d?api-version=5.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		threadID,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(GitPullRequestCommentThread)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, resp, err
+}
+
+// UpdateThread Update a thread in a pull request. Only the fields set in
+// thread are changed, which is typically the Status or Properties.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20threads/update
+func (s *PullRequestsService) UpdateThread(ctx context.Context, owner, project, repo string, pullNum, threadID int, thread *GitPullRequestCommentThread) (*GitPullRequestCommentThread, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/threads/%d?api-version=5.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		threadID,
+	)
+
+	req, err := s.client.NewRequest("PATCH", URL, thread)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(GitPullRequestCommentThread)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, resp, err
+}
+
+// UpdateComment Update a comment associated with a specific thread in a pull
+// request.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20thread%20comments/update
+func (s *PullRequestsService) UpdateComment(ctx context.Context, owner, project, repo string, pullNum, threadID, commentID int, comment *Comment) (*Comment, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/threads/%d/comments/%d?api-version=5.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		threadID,
+		commentID,
+	)
+
+	if comment.GetContent() == "" {
+		return nil, nil, errors.New("PullRequests.UpdateComment: Nil pointer or empty string in comment.Content field")
+	}
+
+	req, err := s.client.NewRequest("PATCH", URL, comment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(Comment)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, resp, err
+}
+
+// StickyCommentOptions describes optional behaviour of UpsertStickyComment
+type StickyCommentOptions struct {
+	// Status If set, the thread is moved to this status after the comment has
+	// been written, e.g. Fixed to resolve it or StatusActive to reopen it.
+	// StatusUnknown leaves the status of an existing thread unchanged and
+	// creates new threads as active.
+	Status CommentThreadStatus
+}
+
+// UpsertStickyComment creates or updates a "sticky" comment thread, which is
+// identified by key rather than by thread ID. Bots that report on every push
+// to a pull request can use it to keep a single, up to date comment instead
+// of adding a new thread each time.
+//
+// The key is stored in the thread's StickyCommentKeyProperty property. If no
+// thread with that key exists, a new one is created with content as its first
+// comment. Otherwise the first comment of the existing thread is edited to
+// content, if it differs. opts may be nil.
+func (s *PullRequestsService) UpsertStickyComment(ctx context.Context, owner, project, repo string, pullNum int, key, content string, opts *StickyCommentOptions) (*GitPullRequestCommentThread, *http.Response, error) {
+	if key == "" {
+		return nil, nil, errors.New("PullRequests.UpsertStickyComment: key must not be empty")
+	}
+	if content == "" {
+		return nil, nil, errors.New("PullRequests.UpsertStickyComment: content must not be empty")
+	}
+	if opts == nil {
+		opts = &StickyCommentOptions{}
+	}
+
+	threads, resp, err := s.ListThreads(ctx, owner, project, repo, pullNum, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	thread := findStickyThread(threads, key)
+	if thread == nil {
+		props := PropertiesCollection{}
+		props.SetString(StickyCommentKeyProperty, key)
+		body := &GitPullRequestCommentThread{
+			Comments:   []*Comment{{Content: String(content)}},
+			Properties: props,
+		}
+		if opts.Status != StatusUnknown {
+			body.Status = String(opts.Status.String())
+		}
+		return s.CreateComments(ctx, owner, project, repo, pullNum, body)
+	}
+
+	if len(thread.Comments) > 0 && thread.Comments[0].GetContent() != content {
+		first := thread.Comments[0]
+		var comment *Comment
+		comment, resp, err = s.UpdateComment(ctx, owner, project, repo, pullNum, thread.GetID(), first.GetID(), &Comment{Content: String(content)})
+		if err != nil {
+			return nil, resp, err
+		}
+		thread.Comments[0] = comment
+	}
+
+	if opts.Status != StatusUnknown && thread.GetStatus() != opts.Status.String() {
+		update := &GitPullRequestCommentThread{Status: String(opts.Status.String())}
+		return s.UpdateThread(ctx, owner, project, repo, pullNum, thread.GetID(), update)
+	}
+
+	return thread, resp, nil
+}
+
+// findStickyThread returns the first thread that hasn't been deleted and
+// whose sticky comment key matches key, or nil if there is none.
+func findStickyThread(threads []*GitPullRequestCommentThread, key string) *GitPullRequestCommentThread {
+	for _, thread := range threads {
+		if thread.GetIsDeleted() {
+			continue
+		}
+		if k, ok := thread.Properties.GetString(StickyCommentKeyProperty); ok && k == key {
+			return thread
+		}
+	}
+	return nil
+}
+
+// CommentSide selects the side of a diff an inline comment is anchored to.
+type CommentSide int
+
+// CommentSide enum values
+const (
+	// CommentSideRight anchors the comment to the new version of the file,
+	// as found in the pull request's source branch.
+	CommentSideRight CommentSide = iota
+	// CommentSideLeft anchors the comment to the old version of the file.
+	CommentSideLeft
+)
+
+func (d CommentSide) String() string {
+	return [...]string{"right", "left"}[d]
+}
+
+// InlineCommentAnchor describes the lines of a file an inline comment
+// thread is attached to.
+type InlineCommentAnchor struct {
+	// FilePath is the path of the file in the repository, e.g. "/src/main.go".
+	FilePath string
+	// StartLine is the first line of the range, starting at 1.
+	StartLine int
+	// EndLine is the last line of the range. Zero means StartLine.
+	EndLine int
+	// Side selects the version of the file the line numbers refer to.
+	Side CommentSide
+	// Iteration is the pull request iteration being commented on. Zero
+	// selects the latest iteration.
+	Iteration int
+	// BaseIteration is the iteration the diff is compared against. Zero
+	// compares against the common commit of the source and target branches.
+	BaseIteration int
+}
+
+// NewInlineCommentThread returns a comment thread anchored to the lines of
+// a file described by anchor, ready to be given comments and passed to
+// CreateComments. The thread context and pull request thread context,
+// including the iteration context and change tracking ID, are computed from
+// the pull request's iterations. An error is returned if the file isn't
+// changed in the iteration or the lines aren't part of the iteration's diff.
+func (s *PullRequestsService) NewInlineCommentThread(ctx context.Context, owner, project, repo string, pullNum int, anchor *InlineCommentAnchor) (*GitPullRequestCommentThread, *http.Response, error) {
+	if anchor == nil || anchor.FilePath == "" {
+		return nil, nil, errors.New("PullRequests.NewInlineCommentThread: Must supply a FilePath")
+	}
+	filePath := anchor.FilePath
+	if !strings.HasPrefix(filePath, "/") {
+		filePath = "/" + filePath
+	}
+	startLine, endLine := anchor.StartLine, anchor.EndLine
+	if endLine == 0 {
+		endLine = startLine
+	}
+	if startLine < 1 || endLine < startLine {
+		return nil, nil, fmt.Errorf("PullRequests.NewInlineCommentThread: invalid line range %d-%d", startLine, endLine)
+	}
+
+	iteration, resp, err := s.getIterationOrLatest(ctx, owner, project, repo, pullNum, anchor.Iteration)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	changes, resp, err := s.ListAllIterationChanges(ctx, owner, project, repo, pullNum, iteration.GetID(), anchor.BaseIteration)
+	if err != nil {
+		return nil, resp, err
+	}
+	var change *GitPullRequestChange
+	for _, c := range changes {
+		if c.GetItem().GetPath() == filePath {
+			change = c
+			break
+		}
+	}
+	if change == nil {
+		return nil, resp, fmt.Errorf("PullRequests.NewInlineCommentThread: %s is not changed in iteration %d", filePath, iteration.GetID())
+	}
+
+	diff, resp, err := s.GetIterationFileDiff(ctx, owner, project, repo, pullNum, iteration.GetID(), anchor.BaseIteration, change)
+	if err != nil {
+		return nil, resp, err
+	}
+	if !diffContainsLines(diff, anchor.Side, startLine, endLine) {
+		return nil, resp, fmt.Errorf("PullRequests.NewInlineCommentThread: lines %d-%d of %s are not part of the %s side of the diff in iteration %d",
+			startLine, endLine, filePath, anchor.Side, iteration.GetID())
+	}
+
+	threadContext := &GitPullRequestCommentThreadContext{FilePath: String(filePath)}
+	start := &CommentPosition{Line: Int(startLine), Offset: Int(1)}
+	end := &CommentPosition{Line: Int(endLine), Offset: Int(1)}
+	if anchor.Side == CommentSideLeft {
+		threadContext.LeftFileStart, threadContext.LeftFileEnd = start, end
+	} else {
+		threadContext.RightFileStart, threadContext.RightFileEnd = start, end
+	}
+
+	// The web UI reports comparisons against the common commit as starting
+	// from the first iteration.
+	first := anchor.BaseIteration
+	if first == 0 {
+		first = 1
+	}
+	thread := &GitPullRequestCommentThread{
+		Status:        String(StatusActive.String()),
+		ThreadContext: threadContext,
+		PullRequestThreadContext: &PullRequestThreadContext{
+			ChangeTrackingID: Int(change.GetChangeTrackingID()),
+			IterationContext: &CommentIterationContext{
+				FirstComparingIteration:  Int(first),
+				SecondComparingIteration: Int(iteration.GetID()),
+			},
+		},
+	}
+
+	return thread, resp, nil
+}
+
+// CreateInlineComment creates a new comment thread containing content and
+// anchored to the lines described by anchor. See NewInlineCommentThread.
+func (s *PullRequestsService) CreateInlineComment(ctx context.Context, owner, project, repo string, pullNum int, anchor *InlineCommentAnchor, content string) (*GitPullRequestCommentThread, *http.Response, error) {
+	thread, resp, err := s.NewInlineCommentThread(ctx, owner, project, repo, pullNum, anchor)
+	if err != nil {
+		return nil, resp, err
+	}
+	thread.Comments = []*Comment{{Content: String(content)}}
+
+	return s.CreateComments(ctx, owner, project, repo, pullNum, thread)
+}
+
+// getIterationOrLatest returns the given iteration, or the latest iteration
+// of the pull request if iterationID is zero.
+func (s *PullRequestsService) getIterationOrLatest(ctx context.Context, owner, project, repo string, pullNum, iterationID int) (*GitPullRequestIteration, *http.Response, error) {
+	if iterationID > 0 {
+		return s.GetIteration(ctx, owner, project, repo, pullNum, iterationID)
+	}
+
+	iterations, resp, err := s.ListIterations(ctx, owner, project, repo, pullNum, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(iterations) == 0 {
+		return nil, resp, fmt.Errorf("PullRequests: pull request %d has no iterations", pullNum)
+	}
+
+	latest := iterations[0]
+	for _, iteration := range iterations {
+		if iteration.GetID() > latest.GetID() {
+			latest = iteration
+		}
+	}
+	return latest, resp, nil
+}
+
+// diffContainsLines reports whether every line from start to end exists on
+// the given side of diff.
+func diffContainsLines(diff *FileDiff, side CommentSide, start, end int) bool {
+	for line := start; line <= end; line++ {
+		found := false
+		for _, block := range diff.LineDiffBlocks {
+			first, count := block.GetModifiedLineNumberStart(), block.GetModifiedLinesCount()
+			if side == CommentSideLeft {
+				first, count = block.GetOriginalLineNumberStart(), block.GetOriginalLinesCount()
+			}
+			if line >= first && line < first+count {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,277 @@
+package azuredevops_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+const (
+	threadsListURL      = "/o/p/_apis/git/repositories/r/pullrequests/1/threads"
+	threadsListResponse = `{
+		"value": [
+			{
+				"id": 1,
+				"status": "active",
+				"comments": [
+					{ "id": 1, "content": "human comment", "commentType": "text" }
+				],
+				"properties": {
+					"Microsoft.TeamFoundation.Discussion.SupportsMarkdown": {
+						"$type": "System.Int32",
+						"$value": 1
+					}
+				}
+			},
+			{
+				"id": 2,
+				"status": "active",
+				"comments": [
+					{ "id": 1, "content": "coverage: 80%", "commentType": "text" }
+				],
+				"properties": {
+					"GoAzureDevops.StickyCommentKey": {
+						"$type": "System.String",
+						"$value": "coverage"
+					}
+				}
+			}
+		],
+		"count": 2
+	}`
+)
+
+func TestPullRequestsService_ListThreads(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(threadsListURL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"$iteration": "2",
+		})
+		fmt.Fprint(w, threadsListResponse)
+	})
+
+	opts := &azuredevops.PullRequestThreadsListOptions{Iteration: 2}
+	got, _, err := c.PullRequests.ListThreads(context.Background(), "o", "p", "r", 1, opts)
+	if err != nil {
+		t.Fatalf("PullRequests.ListThreads returned error: %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("PullRequests.ListThreads returned %d threads, want 2", len(got))
+	}
+	key, ok := got[1].Properties.GetString(azuredevops.StickyCommentKeyProperty)
+	if !ok || key != "coverage" {
+		t.Errorf("PullRequests.ListThreads sticky key is %q, want %q", key, "coverage")
+	}
+	if _, ok := got[0].Properties.GetString("Microsoft.TeamFoundation.Discussion.SupportsMarkdown"); ok {
+		t.Errorf("PropertiesCollection.GetString returned a non-string property")
+	}
+}
+
+func TestPullRequestsService_UpdateComment(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/git/repositories/r/pullrequests/1/threads/2/comments/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"content":"updated"}`+"\n")
+		fmt.Fprint(w, `{"id": 3, "content": "updated"}`)
+	})
+
+	got, _, err := c.PullRequests.UpdateComment(context.Background(), "o", "p", "r", 1, 2, 3, &azuredevops.Comment{Content: String("updated")})
+	if err != nil {
+		t.Fatalf("PullRequests.UpdateComment returned error: %v", err)
+	}
+
+	want := &azuredevops.Comment{ID: Int(3), Content: String("updated")}
+	if !cmp.Equal(got, want) {
+		t.Errorf("PullRequests.UpdateComment returned %+v, want %+v", got, want)
+	}
+
+	_, _, err = c.PullRequests.UpdateComment(context.Background(), "o", "p", "r", 1, 2, 3, &azuredevops.Comment{})
+	if err == nil {
+		t.Errorf("PullRequests.UpdateComment accepted an empty comment")
+	}
+}
+
+func TestPullRequestsService_UpsertStickyComment(t *testing.T) {
+	tt := []struct {
+		name         string
+		key          string
+		content      string
+		status       azuredevops.CommentThreadStatus
+		wantCreate   bool
+		wantEdit     bool
+		wantStatus   string
+		wantThreadID int
+		wantMethod   string
+	}{
+		{name: "creates a thread for an unknown key", key: "lint", content: "no lint errors", wantCreate: true, wantMethod: "POST"},
+		{name: "edits the existing thread", key: "coverage", content: "coverage: 81%", wantEdit: true, wantThreadID: 2, wantMethod: "PATCH"},
+		{name: "leaves an unchanged thread alone", key: "coverage", content: "coverage: 80%", wantThreadID: 2, wantMethod: "GET"},
+		{name: "resolves the existing thread", key: "coverage", content: "coverage: 80%", status: azuredevops.Fixed, wantStatus: "fixed", wantThreadID: 2, wantMethod: "PATCH"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			c, mux, _, teardown := setup()
+			defer teardown()
+
+			var created, edited bool
+			var status string
+			mux.HandleFunc(threadsListURL, func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case "GET":
+					fmt.Fprint(w, threadsListResponse)
+				case "POST":
+					created = true
+					b, _ := ioutil.ReadAll(r.Body)
+					thread := &azuredevops.GitPullRequestCommentThread{}
+					json.Unmarshal(b, thread)
+					if key, _ := thread.Properties.GetString(azuredevops.StickyCommentKeyProperty); key != tc.key {
+						t.Errorf("sticky key is %q, want %q", key, tc.key)
+					}
+					thread.ID = Int(3)
+					json.NewEncoder(w).Encode(thread)
+				default:
+					t.Errorf("unexpected method %s", r.Method)
+				}
+			})
+			mux.HandleFunc(threadsListURL+"/2/comments/1", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "PATCH")
+				edited = true
+				fmt.Fprintf(w, `{"id": 1, "content": %q}`, tc.content)
+			})
+			mux.HandleFunc(threadsListURL+"/2", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "PATCH")
+				b, _ := ioutil.ReadAll(r.Body)
+				thread := &azuredevops.GitPullRequestCommentThread{}
+				json.Unmarshal(b, thread)
+				status = thread.GetStatus()
+				fmt.Fprintf(w, `{"id": 2, "status": %q, "comments": [{"id": 1, "content": %q}]}`, status, tc.content)
+			})
+
+			opts := &azuredevops.StickyCommentOptions{Status: tc.status}
+			got, resp, err := c.PullRequests.UpsertStickyComment(context.Background(), "o", "p", "r", 1, tc.key, tc.content, opts)
+			if err != nil {
+				t.Fatalf("PullRequests.UpsertStickyComment returned error: %v", err)
+			}
+
+			if created != tc.wantCreate {
+				t.Errorf("thread created = %v, want %v", created, tc.wantCreate)
+			}
+			if edited != tc.wantEdit {
+				t.Errorf("comment edited = %v, want %v", edited, tc.wantEdit)
+			}
+			if status != tc.wantStatus {
+				t.Errorf("thread status updated to %q, want %q", status, tc.wantStatus)
+			}
+			if !tc.wantCreate && got.GetID() != tc.wantThreadID {
+				t.Errorf("returned thread ID %d, want %d", got.GetID(), tc.wantThreadID)
+			}
+			if content := got.Comments[0].GetContent(); content != tc.content {
+				t.Errorf("returned comment content %q, want %q", content, tc.content)
+			}
+			if method := resp.Request.Method; method != tc.wantMethod {
+				t.Errorf("returned the response to a %s request, want %s", method, tc.wantMethod)
+			}
+		})
+	}
+}
+
+func TestPullRequestsService_NewInlineCommentThread(t *testing.T) {
+	tt := []struct {
+		name    string
+		anchor  *azuredevops.InlineCommentAnchor
+		wantErr bool
+	}{
+		{name: "right side lines in the diff", anchor: &azuredevops.InlineCommentAnchor{FilePath: "src/a.go", StartLine: 10, EndLine: 12}},
+		{name: "left side line in the diff", anchor: &azuredevops.InlineCommentAnchor{FilePath: "/src/a.go", StartLine: 10, Side: azuredevops.CommentSideLeft}},
+		{name: "right side line outside the diff", anchor: &azuredevops.InlineCommentAnchor{FilePath: "/src/a.go", StartLine: 13}, wantErr: true},
+		{name: "left side line outside the diff", anchor: &azuredevops.InlineCommentAnchor{FilePath: "/src/a.go", StartLine: 11, Side: azuredevops.CommentSideLeft}, wantErr: true},
+		{name: "file not in the iteration", anchor: &azuredevops.InlineCommentAnchor{FilePath: "/src/b.go", StartLine: 1}, wantErr: true},
+		{name: "invalid line range", anchor: &azuredevops.InlineCommentAnchor{FilePath: "/src/a.go", StartLine: 12, EndLine: 10}, wantErr: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			c, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/o/p/_apis/git/repositories/r/pullrequests/1/iterations", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				fmt.Fprint(w, `{"value": [
+					{"id": 1, "sourceRefCommit": {"commitId": "s1"}, "commonRefCommit": {"commitId": "c"}},
+					{"id": 2, "sourceRefCommit": {"commitId": "s2"}, "commonRefCommit": {"commitId": "c"}}
+				], "count": 2}`)
+			})
+			mux.HandleFunc("/o/p/_apis/git/repositories/r/pullrequests/1/iterations/2", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				fmt.Fprint(w, `{"id": 2, "sourceRefCommit": {"commitId": "s2"}, "commonRefCommit": {"commitId": "c"}}`)
+			})
+			mux.HandleFunc("/o/p/_apis/git/repositories/r/pullrequests/1/iterations/2/changes", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				fmt.Fprint(w, `{"changeEntries": [
+					{"changeTrackingId": 7, "changeId": 1, "item": {"path": "/src/a.go"}, "changeType": "edit"}
+				]}`)
+			})
+			mux.HandleFunc("/o/p/_apis/git/repositories/r/filediffs", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				testBody(t, r, `{"baseVersionCommit":"c","fileDiffParams":[{"originalPath":"/src/a.go","path":"/src/a.go"}],"targetVersionCommit":"s2"}`+"\n")
+				fmt.Fprint(w, `{"count": 1, "value": [{
+					"path": "/src/a.go",
+					"lineDiffBlocks": [
+						{"changeType": "edit", "modifiedLineNumberStart": 10, "modifiedLinesCount": 3, "originalLineNumberStart": 10, "originalLinesCount": 1}
+					]
+				}]}`)
+			})
+
+			got, _, err := c.PullRequests.NewInlineCommentThread(context.Background(), "o", "p", "r", 1, tc.anchor)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("PullRequests.NewInlineCommentThread returned no error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PullRequests.NewInlineCommentThread returned error: %v", err)
+			}
+
+			endLine := tc.anchor.EndLine
+			if endLine == 0 {
+				endLine = tc.anchor.StartLine
+			}
+			wantContext := &azuredevops.GitPullRequestCommentThreadContext{FilePath: String("/src/a.go")}
+			start := &azuredevops.CommentPosition{Line: Int(tc.anchor.StartLine), Offset: Int(1)}
+			end := &azuredevops.CommentPosition{Line: Int(endLine), Offset: Int(1)}
+			if tc.anchor.Side == azuredevops.CommentSideLeft {
+				wantContext.LeftFileStart, wantContext.LeftFileEnd = start, end
+			} else {
+				wantContext.RightFileStart, wantContext.RightFileEnd = start, end
+			}
+			want := &azuredevops.GitPullRequestCommentThread{
+				Status:        String("active"),
+				ThreadContext: wantContext,
+				PullRequestThreadContext: &azuredevops.PullRequestThreadContext{
+					ChangeTrackingID: Int(7),
+					IterationContext: &azuredevops.CommentIterationContext{
+						FirstComparingIteration:  Int(1),
+						SecondComparingIteration: Int(2),
+					},
+				},
+			}
+			if !cmp.Equal(got, want) {
+				t.Errorf("PullRequests.NewInlineCommentThread returned %s", cmp.Diff(got, want))
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultWaitPollInterval    = 2 * time.Second
+	defaultWaitMaxPollInterval = 30 * time.Second
+)
+
+// PullRequestWaitOutcome describes why waiting for a pull request stopped.
+type PullRequestWaitOutcome string
+
+// PullRequestWaitOutcome enum values
+const (
+	// WaitMergeSucceeded The merge of the pull request succeeded.
+	WaitMergeSucceeded PullRequestWaitOutcome = "mergeSucceeded"
+	// WaitCompleted The pull request was completed.
+	WaitCompleted PullRequestWaitOutcome = "completed"
+	// WaitAbandoned The pull request was abandoned.
+	WaitAbandoned PullRequestWaitOutcome = "abandoned"
+	// WaitConflicts The merge stopped because of merge conflicts.
+	WaitConflicts PullRequestWaitOutcome = "conflicts"
+	// WaitRejectedByPolicy The merge was rejected by a branch policy.
+	WaitRejectedByPolicy PullRequestWaitOutcome = "rejectedByPolicy"
+	// WaitMergeFailed The merge failed, see FailureType for details.
+	WaitMergeFailed PullRequestWaitOutcome = "mergeFailed"
+	// WaitNotQueued No merge was queued, so the merge status would not
+	// change without a new merge request.
+	WaitNotQueued PullRequestWaitOutcome = "notQueued"
+	// WaitCancelled The context was cancelled before a final state was seen.
+	WaitCancelled PullRequestWaitOutcome = "cancelled"
+)
+
+// PullRequestWaitOptions controls how WaitForMergeStatus and
+// WaitForCompletion poll the pull request.
+type PullRequestWaitOptions struct {
+	// PollInterval The delay before the first poll. It doubles after every
+	// poll, up to MaxPollInterval. Defaults to 2 seconds.
+	PollInterval time.Duration
+	// MaxPollInterval The maximum delay between polls. Defaults to 30 seconds.
+	MaxPollInterval time.Duration
+}
+
+// PullRequestWaitResult describes the state of a pull request when waiting
+// for it stopped.
+type PullRequestWaitResult struct {
+	// Outcome Why waiting stopped.
+	Outcome PullRequestWaitOutcome
+	// PullRequest The pull request as returned by the last poll.
+	PullRequest *GitPullRequest
+	// MergeStatus The merge status of the pull request at the last poll.
+	MergeStatus PullRequestAsyncStatus
+	// FailureType The merge failure type, when Outcome is WaitMergeFailed.
+	FailureType PullRequestMergeFailureType
+	// FailureMessage The merge failure message reported by the service.
+	FailureMessage string
+	// RejectedPolicies The blocking policy evaluations that rejected the
+	// pull request, when Outcome is WaitRejectedByPolicy.
+	RejectedPolicies []*PolicyEvaluationRecord
+	// Polls The number of times the pull request was retrieved.
+	Polls int
+}
+
+// WaitForMergeStatus polls a pull request until its merge is no longer
+// queued, typically after calling Merge. It stops when the merge succeeds,
+// fails, runs into conflicts, is rejected by a policy or ctx is done. It
+// also stops with WaitNotQueued when the merge status is notSet, as no
+// merge is pending then. When ctx is done the result describes the last
+// state seen and ctx.Err() is returned alongside it.
+func (s *PullRequestsService) WaitForMergeStatus(ctx context.Context, owner, project, repo string, pullNum int, opts *PullRequestWaitOptions) (*PullRequestWaitResult, *http.Response, error) {
+	return s.waitFor(ctx, owner, project, repo, pullNum, opts, false, nil)
+}
+
+// WaitForCompletion polls a pull request until it is completed or
+// abandoned. Like WaitForMergeStatus it also stops when the merge fails,
+// runs into conflicts or is rejected by a policy, including when the merge
+// succeeded but a blocking policy keeps the pull request active. While the
+// merge has succeeded, the policy evaluations are retrieved when the merge
+// status or merge commit changes and otherwise at most once every
+// MaxPollInterval.
+func (s *PullRequestsService) WaitForCompletion(ctx context.Context, owner, project, repo string, pullNum int, opts *PullRequestWaitOptions) (*PullRequestWaitResult, *http.Response, error) {
+	return s.waitFor(ctx, owner, project, repo, pullNum, opts, true, nil)
+}
+
+// waitFor polls a pull request until it reaches a final state. Polls for
+// which stale returns true only end waiting if the pull request was
+// completed or abandoned; stale may be nil.
+func (s *PullRequestsService) waitFor(ctx context.Context, owner, project, repo string, pullNum int, opts *PullRequestWaitOptions, completion bool, stale func(*GitPullRequest) bool) (*PullRequestWaitResult, *http.Response, error) {
+	interval, maxInterval := defaultWaitPollInterval, defaultWaitMaxPollInterval
+	if opts != nil && opts.PollInterval > 0 {
+		interval = opts.PollInterval
+	}
+	if opts != nil && opts.MaxPollInterval > 0 {
+		maxInterval = opts.MaxPollInterval
+	}
+
+	result := &PullRequestWaitResult{}
+	var lastResp *http.Response
+	var lastMergeCommit string
+	var policiesCheckedAt time.Time
+	for {
+		pull, resp, err := s.GetWithRepo(ctx, owner, project, repo, pullNum, nil)
+		if err != nil {
+			if ctx.Err() != nil {
+				result.Outcome = WaitCancelled
+			}
+			return result, resp, err
+		}
+		lastResp = resp
+		result.Polls++
+		result.PullRequest = pull
+		previousStatus := result.MergeStatus
+		result.MergeStatus = ParsePullRequestAsyncStatus(pull.GetMergeStatus())
+		result.FailureType = ParsePullRequestMergeFailureType(pull.GetMergeFailureType())
+		result.FailureMessage = pull.GetMergeFailureMessage()
+
+		switch pull.GetStatus() {
+		case PullCompleted.String():
+			result.Outcome = WaitCompleted
+			return result, resp, nil
+		case PullAbandoned.String():
+			result.Outcome = WaitAbandoned
+			return result, resp, nil
+		}
+
+		if stale == nil || !stale(pull) {
+			switch result.MergeStatus {
+			case MergeNotSet:
+				result.Outcome = WaitNotQueued
+				return result, resp, nil
+			case MergeConflicts:
+				result.Outcome = WaitConflicts
+				return result, resp, nil
+			case MergeFailure:
+				result.Outcome = WaitMergeFailed
+				return result, resp, nil
+			case MergeRejectedByPolicy:
+				result.Outcome = WaitRejectedByPolicy
+				result.RejectedPolicies, resp, err = s.rejectedPolicies(ctx, owner, project, pull)
+				return result, resp, err
+			case MergeSucceeded:
+				if !completion {
+					result.Outcome = WaitMergeSucceeded
+					return result, resp, nil
+				}
+				mergeCommit := pull.GetLastMergeCommit().GetCommitID()
+				changed := result.Polls == 1 || previousStatus != MergeSucceeded || mergeCommit != lastMergeCommit
+				if !changed && time.Since(policiesCheckedAt) < maxInterval {
+					break
+				}
+				lastMergeCommit, policiesCheckedAt = mergeCommit, time.Now()
+				rejected, resp, err := s.rejectedPolicies(ctx, owner, project, pull)
+				if err != nil {
+					return result, resp, err
+				}
+				if len(rejected) > 0 {
+					result.Outcome = WaitRejectedByPolicy
+					result.RejectedPolicies = rejected
+					return result, resp, nil
+				}
+			}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			result.Outcome = WaitCancelled
+			return result, lastResp, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// rejectedPolicies returns the blocking policy evaluations that rejected pull.
+func (s *PullRequestsService) rejectedPolicies(ctx context.Context, owner, project string, pull *GitPullRequest) ([]*PolicyEvaluationRecord, *http.Response, error) {
+	projectID := pull.GetRepository().GetProject().GetID()
+	if projectID == "" {
+		projectID = project
+	}
+	artifactID := s.client.PolicyEvaluations.GetPullRequestArtifactID(projectID, pull.GetPullRequestID())
+	evaluations, resp, err := s.client.PolicyEvaluations.List(ctx, owner, project, artifactID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var rejected []*PolicyEvaluationRecord
+	for _, evaluation := range evaluations {
+		if evaluation.GetStatus() == PolicyEvaluationRejected && evaluation.GetConfiguration().GetIsBlocking() {
+			rejected = append(rejected, evaluation)
+		}
+	}
+	return rejected, resp, nil
+}
@@ -0,0 +1,207 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+const (
+	waitPullURL        = "/o/p/_apis/git/repositories/r/pullrequests/1"
+	waitEvaluationsURL = "/o/p/_apis/policy/evaluations"
+)
+
+func TestPullRequestsService_WaitForMergeStatus(t *testing.T) {
+	tt := []struct {
+		name            string
+		responses       []string
+		wantOutcome     azuredevops.PullRequestWaitOutcome
+		wantMergeStatus azuredevops.PullRequestAsyncStatus
+		wantFailureType azuredevops.PullRequestMergeFailureType
+		wantPolls       int
+		wantRejected    int
+	}{
+		{
+			name: "succeeds after being queued",
+			responses: []string{
+				`{"pullRequestId": 1, "status": "active", "mergeStatus": "queued"}`,
+				`{"pullRequestId": 1, "status": "active", "mergeStatus": "succeeded"}`,
+			},
+			wantOutcome:     azuredevops.WaitMergeSucceeded,
+			wantMergeStatus: azuredevops.MergeSucceeded,
+			wantPolls:       2,
+		},
+		{
+			name: "reports the merge failure type",
+			responses: []string{
+				`{"pullRequestId": 1, "status": "active", "mergeStatus": "failure", "mergeFailureType": "objectTooLarge", "mergeFailureMessage": "too large"}`,
+			},
+			wantOutcome:     azuredevops.WaitMergeFailed,
+			wantMergeStatus: azuredevops.MergeFailure,
+			wantFailureType: azuredevops.ObjectTooLarge,
+			wantPolls:       1,
+		},
+		{
+			name: "stops on conflicts",
+			responses: []string{
+				`{"pullRequestId": 1, "status": "active", "mergeStatus": "conflicts"}`,
+			},
+			wantOutcome:     azuredevops.WaitConflicts,
+			wantMergeStatus: azuredevops.MergeConflicts,
+			wantPolls:       1,
+		},
+		{
+			name: "stops when no merge is queued",
+			responses: []string{
+				`{"pullRequestId": 1, "status": "active", "mergeStatus": "notSet"}`,
+			},
+			wantOutcome:     azuredevops.WaitNotQueued,
+			wantMergeStatus: azuredevops.MergeNotSet,
+			wantPolls:       1,
+		},
+		{
+			name: "reports rejecting policies",
+			responses: []string{
+				`{"pullRequestId": 1, "status": "active", "mergeStatus": "rejectedByPolicy", "repository": {"project": {"id": "pid"}}}`,
+			},
+			wantOutcome:     azuredevops.WaitRejectedByPolicy,
+			wantMergeStatus: azuredevops.MergeRejectedByPolicy,
+			wantPolls:       1,
+			wantRejected:    1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			c, mux, _, teardown := setup()
+			defer teardown()
+
+			polls := 0
+			mux.HandleFunc(waitPullURL, func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				fmt.Fprint(w, tc.responses[polls])
+				polls++
+			})
+			mux.HandleFunc(waitEvaluationsURL, func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testFormValues(t, r, values{
+					"artifactId": "vstfs:///CodeReview/CodeReviewId/pid/1",
+				})
+				fmt.Fprint(w, `{"count": 2, "value": [
+					{"status": "rejected", "configuration": {"isBlocking": true}},
+					{"status": "rejected", "configuration": {"isBlocking": false}}
+				]}`)
+			})
+
+			opts := &azuredevops.PullRequestWaitOptions{PollInterval: time.Millisecond}
+			got, _, err := c.PullRequests.WaitForMergeStatus(context.Background(), "o", "p", "r", 1, opts)
+			if err != nil {
+				t.Fatalf("PullRequests.WaitForMergeStatus returned error: %v", err)
+			}
+
+			if got.Outcome != tc.wantOutcome {
+				t.Errorf("Outcome is %q, want %q", got.Outcome, tc.wantOutcome)
+			}
+			if got.MergeStatus != tc.wantMergeStatus {
+				t.Errorf("MergeStatus is %v, want %v", got.MergeStatus, tc.wantMergeStatus)
+			}
+			if got.FailureType != tc.wantFailureType {
+				t.Errorf("FailureType is %v, want %v", got.FailureType, tc.wantFailureType)
+			}
+			if got.Polls != tc.wantPolls {
+				t.Errorf("Polls is %d, want %d", got.Polls, tc.wantPolls)
+			}
+			if len(got.RejectedPolicies) != tc.wantRejected {
+				t.Errorf("RejectedPolicies has %d entries, want %d", len(got.RejectedPolicies), tc.wantRejected)
+			}
+		})
+	}
+}
+
+func TestPullRequestsService_WaitForCompletion(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	responses := []string{
+		`{"pullRequestId": 1, "status": "active", "mergeStatus": "succeeded"}`,
+		`{"pullRequestId": 1, "status": "completed", "mergeStatus": "succeeded"}`,
+	}
+	polls := 0
+	mux.HandleFunc(waitPullURL, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, responses[polls])
+		polls++
+	})
+	mux.HandleFunc(waitEvaluationsURL, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 1, "value": [{"status": "approved", "configuration": {"isBlocking": true}}]}`)
+	})
+
+	opts := &azuredevops.PullRequestWaitOptions{PollInterval: time.Millisecond}
+	got, _, err := c.PullRequests.WaitForCompletion(context.Background(), "o", "p", "r", 1, opts)
+	if err != nil {
+		t.Fatalf("PullRequests.WaitForCompletion returned error: %v", err)
+	}
+	if got.Outcome != azuredevops.WaitCompleted || got.Polls != 2 {
+		t.Errorf("PullRequests.WaitForCompletion returned outcome %q after %d polls", got.Outcome, got.Polls)
+	}
+}
+
+func TestPullRequestsService_WaitForCompletion_policyChecks(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	// The merge commit changes once, so the policies are checked on the
+	// first poll and after the change, but not on every poll.
+	responses := []string{
+		`{"pullRequestId": 1, "status": "active", "mergeStatus": "succeeded", "lastMergeCommit": {"commitId": "a"}}`,
+		`{"pullRequestId": 1, "status": "active", "mergeStatus": "succeeded", "lastMergeCommit": {"commitId": "a"}}`,
+		`{"pullRequestId": 1, "status": "active", "mergeStatus": "succeeded", "lastMergeCommit": {"commitId": "a"}}`,
+		`{"pullRequestId": 1, "status": "active", "mergeStatus": "succeeded", "lastMergeCommit": {"commitId": "b"}}`,
+		`{"pullRequestId": 1, "status": "active", "mergeStatus": "succeeded", "lastMergeCommit": {"commitId": "b"}}`,
+		`{"pullRequestId": 1, "status": "completed", "mergeStatus": "succeeded"}`,
+	}
+	polls, evaluations := 0, 0
+	mux.HandleFunc(waitPullURL, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, responses[polls])
+		polls++
+	})
+	mux.HandleFunc(waitEvaluationsURL, func(w http.ResponseWriter, r *http.Request) {
+		evaluations++
+		fmt.Fprint(w, `{"count": 1, "value": [{"status": "running", "configuration": {"isBlocking": true}}]}`)
+	})
+
+	opts := &azuredevops.PullRequestWaitOptions{PollInterval: time.Millisecond, MaxPollInterval: time.Hour}
+	got, _, err := c.PullRequests.WaitForCompletion(context.Background(), "o", "p", "r", 1, opts)
+	if err != nil {
+		t.Fatalf("PullRequests.WaitForCompletion returned error: %v", err)
+	}
+	if got.Outcome != azuredevops.WaitCompleted || got.Polls != 6 {
+		t.Errorf("PullRequests.WaitForCompletion returned outcome %q after %d polls", got.Outcome, got.Polls)
+	}
+	if evaluations != 2 {
+		t.Errorf("policy evaluations were retrieved %d times, want 2", evaluations)
+	}
+}
+
+func TestPullRequestsService_WaitForCompletion_cancelled(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	mux.HandleFunc(waitPullURL, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"pullRequestId": 1, "status": "active", "mergeStatus": "queued"}`)
+	})
+
+	opts := &azuredevops.PullRequestWaitOptions{PollInterval: time.Hour}
+	got, _, err := c.PullRequests.WaitForCompletion(ctx, "o", "p", "r", 1, opts)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("PullRequests.WaitForCompletion returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got.Outcome != azuredevops.WaitCancelled || got.MergeStatus != azuredevops.MergeQueued {
+		t.Errorf("PullRequests.WaitForCompletion returned outcome %q with merge status %v", got.Outcome, got.MergeStatus)
+	}
+}
@@ -0,0 +1,78 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PullRequestWorkItemsListResponse describes a pull request work items list response
+type PullRequestWorkItemsListResponse struct {
+	Count     int            `json:"count"`
+	WorkItems []*ResourceRef `json:"value"`
+}
+
+// ListWorkItems Retrieve a list of work items associated with a pull request.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20work%20items/list
+func (s *PullRequestsService) ListWorkItems(ctx context.Context, owner, project, repo string, pullNum int) ([]*ResourceRef, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/workitems?api-version=5.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(PullRequestWorkItemsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.WorkItems, resp, err
+}
+
+// GetArtifactID gets the artifact ID of a pull request, which is used to link
+// work items to it.
+// ex: vstfs:///Git/PullRequestId/{projectId}%2F{repositoryId}%2F{pullRequestId}
+func (s *PullRequestsService) GetArtifactID(projectID, repositoryID string, pullRequestID int) string {
+	return fmt.Sprintf("vstfs:///Git/PullRequestId/%s",
+		url.PathEscape(fmt.Sprintf("%s/%s/%d", projectID, repositoryID, pullRequestID)),
+	)
+}
+
+// LinkWorkItem Associates a work item with a pull request by adding an
+// artifact link to the pull request to the work item's relations. The pull
+// request is looked up to find its artifact ID.
+// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work%20items/update?view=azure-devops-rest-5.1#add-a-link
+func (s *PullRequestsService) LinkWorkItem(ctx context.Context, owner, project, repo string, pullNum int, workItemID int) (*WorkItem, *http.Response, error) {
+	pull, resp, err := s.GetWithRepo(ctx, owner, project, repo, pullNum, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	artifactID := pull.GetArtifactID()
+	if artifactID == "" {
+		artifactID = s.GetArtifactID(pull.GetRepository().GetProject().GetID(), pull.GetRepository().GetID(), pullNum)
+	}
+
+	patch := WorkItemPatch{}.AddRelation(&WorkItemRelation{
+		Rel: String("ArtifactLink"),
+		URL: String(artifactID),
+		Attributes: &map[string]interface{}{
+			"name": "Pull Request",
+		},
+	})
+
+	r, resp, err := s.client.WorkItems.Update(ctx, owner, project, workItemID, patch, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, resp, err
+}
@@ -0,0 +1,71 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+func TestPullRequestsService_ListWorkItems(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/git/repositories/r/pullrequests/1/workitems", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"value": [
+				{ "id": "42", "url": "https://dev.azure.com/o/_apis/wit/workItems/42" }
+			],
+			"count": 1
+		}`)
+	})
+
+	got, _, err := c.PullRequests.ListWorkItems(context.Background(), "o", "p", "r", 1)
+	if err != nil {
+		t.Fatalf("PullRequests.ListWorkItems returned error: %v", err)
+	}
+
+	want := []*azuredevops.ResourceRef{{
+		ID:  String("42"),
+		URL: String("https://dev.azure.com/o/_apis/wit/workItems/42"),
+	}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("PullRequests.ListWorkItems returned %+v, want %+v", got, want)
+	}
+}
+
+func TestPullRequestsService_LinkWorkItem(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/git/repositories/r/pullrequests/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"pullRequestId": 1,
+			"repository": {
+				"id": "repo-id",
+				"project": { "id": "project-id" }
+			}
+		}`)
+	})
+	mux.HandleFunc("/o/p/_apis/wit/workitems/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		if got := r.Header.Get("Content-Type"); got != "application/json-patch+json" {
+			t.Errorf("Content-Type is %q, want %q", got, "application/json-patch+json")
+		}
+		testBody(t, r, `[{"op":"add","path":"/relations/-","value":{"attributes":{"name":"Pull Request"},"rel":"ArtifactLink","url":"vstfs:///Git/PullRequestId/project-id%2Frepo-id%2F1"}}]`+"\n")
+		fmt.Fprint(w, `{"id": 42, "rev": 2}`)
+	})
+
+	got, _, err := c.PullRequests.LinkWorkItem(context.Background(), "o", "p", "r", 1, 42)
+	if err != nil {
+		t.Fatalf("PullRequests.LinkWorkItem returned error: %v", err)
+	}
+	if got.GetID() != 42 {
+		t.Errorf("PullRequests.LinkWorkItem returned %+v", got)
+	}
+}
@@ -70,6 +70,17 @@ func (d PullRequestAsyncStatus) String() string {
 	return [...]string{"notSet", "queued", "conflicts", "succeeded", "rejectedByPolicy", "failure"}[d]
 }
 
+// ParsePullRequestAsyncStatus returns the PullRequestAsyncStatus named by s,
+// as found in GitPullRequest.MergeStatus. Unknown values return MergeNotSet.
+func ParsePullRequestAsyncStatus(s string) PullRequestAsyncStatus {
+	for d := MergeNotSet; d <= MergeFailure; d++ {
+		if d.String() == s {
+			return d
+		}
+	}
+	return MergeNotSet
+}
+
 // PullRequestMergeFailureType The specific type of merge request failure
 type PullRequestMergeFailureType int
 
@@ -85,6 +96,21 @@ func (d PullRequestMergeFailureType) String() string {
 	return [...]string{"none", "unknown", "caseSensitive", "objectTooLarge"}[d]
 }
 
+// ParsePullRequestMergeFailureType returns the PullRequestMergeFailureType
+// named by s, as found in GitPullRequest.MergeFailureType. An empty value
+// returns NoFailure and other unknown values return UnknownFailure.
+func ParsePullRequestMergeFailureType(s string) PullRequestMergeFailureType {
+	if s == "" {
+		return NoFailure
+	}
+	for d := NoFailure; d <= ObjectTooLarge; d++ {
+		if d.String() == s {
+			return d
+		}
+	}
+	return UnknownFailure
+}
+
 // PullRequestStatus The current status of a pull request merge.
 type PullRequestStatus int
 
@@ -134,6 +160,19 @@ type PullRequestIterationsListOptions struct {
 	IncludeCommits bool `url:"includeCommits,omitempty"`
 }
 
+// PullRequestIterationChangesOptions describes what the request to the API should look like
+type PullRequestIterationChangesOptions struct {
+	// Top Optional. The number of changes to retrieve. The default value is
+	// 100 and the maximum value is 2000.
+	Top int `url:"$top,omitempty"`
+	// Skip Optional. The number of changes to ignore.
+	Skip int `url:"$skip,omitempty"`
+	// CompareTo ID of the pull request iteration to compare against. The
+	// default value is zero which indicates the comparison is made against
+	// the common commit between the source and target branches.
+	CompareTo int `url:"$compareTo,omitempty"`
+}
+
 // PullRequestsIterationsListResponse describes a pull requests list response
 type PullRequestsIterationsListResponse struct {
 	Count                    int                        `json:"count"`
@@ -333,15 +372,16 @@ type GitPullRequestCommentThread struct {
 	Identities               []*IdentityRef                      `json:"identities,omitempty"`
 	IsDeleted                *bool                               `json:"isDeleted,omitempty"`
 	LastUpdatedDate          *Time                               `json:"lastUpdatedDate,omitempty"`
-	Properties               []*int                              `json:"properties,omitempty"`
+	Properties               PropertiesCollection                `json:"properties,omitempty"`
 	PublishedDate            *Time                               `json:"publishedDate,omitempty"`
 	Status                   *string                             `json:"status,omitempty"`
-	PullRequestThreadContext *GitPullRequestCommentThreadContext `json:"pullRequestThreadContext,omitempty"`
+	ThreadContext            *GitPullRequestCommentThreadContext `json:"threadContext,omitempty"`
+	PullRequestThreadContext *PullRequestThreadContext           `json:"pullRequestThreadContext,omitempty"`
 }
 
-// GitPullRequestCommentThreadContext Comment thread context contains details about what
-// diffs were being viewed at the time of thread creation and whether or not the thread
-// has been tracked from that original diff.
+// GitPullRequestCommentThreadContext describes the file and the range of
+// lines a comment thread is anchored to. Only one side (left or right) is
+// normally set.
 type GitPullRequestCommentThreadContext struct {
 	FilePath       *string          `json:"filePath,omitempty"`
 	LeftFileEnd    *CommentPosition `json:"leftFileEnd,omitempty"`
@@ -350,6 +390,35 @@ type GitPullRequestCommentThreadContext struct {
 	RightFileStart *CommentPosition `json:"rightFileStart,omitempty"`
 }
 
+// CommentIterationContext Comment iteration context is used to identify which
+// diff was being viewed when the thread was created.
+type CommentIterationContext struct {
+	FirstComparingIteration  *int `json:"firstComparingIteration,omitempty"`
+	SecondComparingIteration *int `json:"secondComparingIteration,omitempty"`
+}
+
+// CommentTrackingCriteria Comment tracking criteria is used to identify which
+// iteration context the thread has been tracked to (if any) along with some
+// detail about the original position and filename.
+type CommentTrackingCriteria struct {
+	FirstComparingIteration  *int             `json:"firstComparingIteration,omitempty"`
+	OrigFilePath             *string          `json:"origFilePath,omitempty"`
+	OrigLeftFileEnd          *CommentPosition `json:"origLeftFileEnd,omitempty"`
+	OrigLeftFileStart        *CommentPosition `json:"origLeftFileStart,omitempty"`
+	OrigRightFileEnd         *CommentPosition `json:"origRightFileEnd,omitempty"`
+	OrigRightFileStart       *CommentPosition `json:"origRightFileStart,omitempty"`
+	SecondComparingIteration *int             `json:"secondComparingIteration,omitempty"`
+}
+
+// PullRequestThreadContext Pull request thread context contains details about what
+// diffs were being viewed at the time of thread creation and whether or not the thread
+// has been tracked from that original diff.
+type PullRequestThreadContext struct {
+	ChangeTrackingID *int                     `json:"changeTrackingId,omitempty"`
+	IterationContext *CommentIterationContext `json:"iterationContext,omitempty"`
+	TrackingCriteria *CommentTrackingCriteria `json:"trackingCriteria,omitempty"`
+}
+
 // GitPullRequestWithComment contains a reference to an existing pull request and a
 // comment.
 type GitPullRequestWithComment struct {
@@ -359,7 +428,6 @@ type GitPullRequestWithComment struct {
 
 // ListCommits lists the commits in a pull request.
 // Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20commits/get%20pull%20request%20commits
-//
 func (s *PullRequestsService) ListCommits(ctx context.Context, owner, project, repo string, pullNum int) ([]*GitCommitRef, *http.Response, error) {
 	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/commits?api-version=5.1-preview.1",
 		owner,
@@ -384,7 +452,6 @@ func (s *PullRequestsService) ListCommits(ctx context.Context, owner, project, r
 
 // CreateComment adds a comment to a pull request thread.
 // Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20thread%20comments/create
-//
 func (s *PullRequestsService) CreateComment(ctx context.Context, owner, project, repo string, pullNum int, threadId int, comment *Comment) (*Comment, *http.Response, error) {
 	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/threads/%d/comments?api-version=5.1-preview.1",
 		owner,
@@ -419,7 +486,6 @@ func (s *PullRequestsService) CreateComment(ctx context.Context, owner, project,
 // CreateComments adds one or more comments to a new or existing thread
 // and may include additional context
 // Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20threads/create
-//
 func (s *PullRequestsService) CreateComments(ctx context.Context, owner, project, repo string, pullNum int, body *GitPullRequestCommentThread) (*GitPullRequestCommentThread, *http.Response, error) {
 	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/threads?api-version=5.1-preview.1",
 		owner,
@@ -458,7 +524,6 @@ func (s *PullRequestsService) CreateComments(ctx context.Context, owner, project
 
 // CreateStatus Create a pull request status.
 // Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20statuses/create
-//
 func (s *PullRequestsService) CreateStatus(ctx context.Context, owner, project, repo string, pullNum int, status *GitPullRequestStatus) (*GitPullRequestStatus, *http.Response, error) {
 	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/statuses?api-version=5.1-preview.1",
 		owner,
@@ -489,7 +554,6 @@ func (s *PullRequestsService) CreateStatus(ctx context.Context, owner, project,
 
 // GetIteration Gets a single pull request iteration.
 // Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20iterations/get?view=azure-devops-rest-5.1
-//
 func (s *PullRequestsService) GetIteration(ctx context.Context, owner, project, repo string, pullNum int, iterationID int) (*GitPullRequestIteration, *http.Response, error) {
 	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/iterations/%d?api-version=5.1",
 		owner,
@@ -515,7 +579,6 @@ func (s *PullRequestsService) GetIteration(ctx context.Context, owner, project,
 
 // ListIterations Lists all iterations on a pull request.
 // Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20iterations/list?view=azure-devops-rest-5.1
-//
 func (s *PullRequestsService) ListIterations(ctx context.Context, owner, project, repo string, pullNum int, opts *PullRequestIterationsListOptions) ([]*GitPullRequestIteration, *http.Response, error) {
 	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/iterations?api-version=5.1",
 		owner,
@@ -539,3 +602,105 @@ func (s *PullRequestsService) ListIterations(ctx context.Context, owner, project
 
 	return r.GitPullRequestIterations, resp, err
 }
+
+// GetIterationChanges Retrieve the changes made in a pull request between two
+// iterations. The result holds a single page of changes; NextSkip and NextTop
+// describe the following page, if there is one.
+// Azure Devops API docs: https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull%20request%20iteration%20changes/get?view=azure-devops-rest-5.1
+func (s *PullRequestsService) GetIterationChanges(ctx context.Context, owner, project, repo string, pullNum int, iterationID int, opts *PullRequestIterationChangesOptions) (*GitPullRequestIterationChanges, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d/iterations/%d/changes?api-version=5.1",
+		owner,
+		project,
+		repo,
+		pullNum,
+		iterationID,
+	)
+
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(GitPullRequestIterationChanges)
+	resp, err := s.client.Execute(ctx, req, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, resp, err
+}
+
+// ListAllIterationChanges Retrieve all the changes made in a pull request
+// iteration compared to the iteration compareTo, following the NextSkip and
+// NextTop paging information returned by GetIterationChanges. A compareTo
+// value of zero compares against the common commit between the source and
+// target branches.
+func (s *PullRequestsService) ListAllIterationChanges(ctx context.Context, owner, project, repo string, pullNum int, iterationID int, compareTo int) ([]*GitPullRequestChange, *http.Response, error) {
+	var all []*GitPullRequestChange
+	opts := &PullRequestIterationChangesOptions{CompareTo: compareTo}
+	for {
+		changes, resp, err := s.GetIterationChanges(ctx, owner, project, repo, pullNum, iterationID, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		all = append(all, changes.ChangeEntries...)
+
+		if changes.GetNextTop() == 0 {
+			return all, resp, nil
+		}
+		opts.Skip, opts.Top = changes.GetNextSkip(), changes.GetNextTop()
+	}
+}
+
+// GetIterationFileDiff Retrieve the line diff blocks of a file changed in a
+// pull request iteration, compared to the iteration compareTo. change is one
+// of the changes returned by GetIterationChanges for the same iterations.
+// The right side of the diff is the iteration's source commit. The left side
+// is the source commit of compareTo, or the common commit between the source
+// and target branches if compareTo is zero.
+func (s *PullRequestsService) GetIterationFileDiff(ctx context.Context, owner, project, repo string, pullNum int, iterationID int, compareTo int, change *GitPullRequestChange) (*FileDiff, *http.Response, error) {
+	path := change.GetItem().GetPath()
+	if path == "" {
+		return nil, nil, errors.New("PullRequests.GetIterationFileDiff: Must supply a change with an item path")
+	}
+	originalPath := change.GetOriginalPath()
+	if originalPath == "" {
+		originalPath = path
+	}
+
+	iteration, resp, err := s.GetIteration(ctx, owner, project, repo, pullNum, iterationID)
+	if err != nil {
+		return nil, resp, err
+	}
+	baseCommit := iteration.GetCommonRefCommit().GetCommitID()
+	if compareTo > 0 {
+		base, resp, err := s.GetIteration(ctx, owner, project, repo, pullNum, compareTo)
+		if err != nil {
+			return nil, resp, err
+		}
+		baseCommit = base.GetSourceRefCommit().GetCommitID()
+	}
+
+	criteria := &FileDiffsCriteria{
+		BaseVersionCommit:   String(baseCommit),
+		TargetVersionCommit: String(iteration.GetSourceRefCommit().GetCommitID()),
+		FileDiffParams: []*FileDiffParams{{
+			OriginalPath: String(originalPath),
+			Path:         String(path),
+		}},
+	}
+	diffs, resp, err := s.client.Git.GetFileDiffs(ctx, owner, project, repo, criteria)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(diffs) == 0 {
+		return nil, resp, fmt.Errorf("PullRequests.GetIterationFileDiff: no diff returned for %s", path)
+	}
+
+	return diffs[0], resp, nil
+}
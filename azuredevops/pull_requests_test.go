@@ -634,3 +634,104 @@ func TestPullRequestsService_ListIterations(t *testing.T) {
 		t.Errorf("PullRequests.ListIterations IDs don't match ID 0 = %+v ID 1 = %+v, want ID 0 = %+v ID 1 = %+v", *got[0].ID, *got[1].ID, *want[0].ID, *want[1].ID)
 	}
 }
+
+func TestPullRequestsService_GetIterationChanges(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/o/p/_apis/git/repositories/r/pullrequests/1/iterations/2/changes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"$compareTo": "1",
+			"$top":       "1",
+		})
+		fmt.Fprint(w, `{
+			"changeEntries": [{
+				"changeTrackingId": 1,
+				"changeId": 1,
+				"item": {
+					"objectId": "e21e4ba5d5b4ab9e7e4a3d3d5d6b8a4c6a7e8c9d",
+					"path": "/MyWebSite/MyWebSite/Views/Home/_Home.cshtml"
+				},
+				"changeType": "edit"
+			}],
+			"nextSkip": 1,
+			"nextTop": 1
+		}`)
+	})
+
+	opts := &azuredevops.PullRequestIterationChangesOptions{CompareTo: 1, Top: 1}
+	got, _, err := c.PullRequests.GetIterationChanges(context.Background(), "o", "p", "r", 1, 2, opts)
+	if err != nil {
+		t.Fatalf("PullRequests.GetIterationChanges returned error: %v", err)
+	}
+
+	if len(got.ChangeEntries) != 1 {
+		t.Fatalf("PullRequests.GetIterationChanges returned %d changes, want 1", len(got.ChangeEntries))
+	}
+	change := got.ChangeEntries[0]
+	if change.GetChangeTrackingID() != 1 || change.GetItem().GetPath() != "/MyWebSite/MyWebSite/Views/Home/_Home.cshtml" {
+		t.Errorf("PullRequests.GetIterationChanges returned %+v", change)
+	}
+	if got.GetNextSkip() != 1 || got.GetNextTop() != 1 {
+		t.Errorf("PullRequests.GetIterationChanges next page is %d/%d, want 1/1", got.GetNextSkip(), got.GetNextTop())
+	}
+}
+
+func TestPullRequestsService_ListAllIterationChanges(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/o/p/_apis/git/repositories/r/pullrequests/1/iterations/3/changes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		r.ParseForm()
+		if got := r.Form.Get("$compareTo"); got != "2" {
+			t.Errorf("$compareTo is %q, want %q", got, "2")
+		}
+		switch r.Form.Get("$skip") {
+		case "":
+			fmt.Fprint(w, `{"changeEntries": [{"changeTrackingId": 1, "item": {"path": "/a"}}], "nextSkip": 1, "nextTop": 1}`)
+		case "1":
+			fmt.Fprint(w, `{"changeEntries": [{"changeTrackingId": 2, "item": {"path": "/b"}}]}`)
+		default:
+			t.Errorf("unexpected $skip %q", r.Form.Get("$skip"))
+		}
+	})
+
+	got, _, err := c.PullRequests.ListAllIterationChanges(context.Background(), "o", "p", "r", 1, 3, 2)
+	if err != nil {
+		t.Fatalf("PullRequests.ListAllIterationChanges returned error: %v", err)
+	}
+
+	if len(got) != 2 || got[0].GetItem().GetPath() != "/a" || got[1].GetItem().GetPath() != "/b" {
+		t.Errorf("PullRequests.ListAllIterationChanges returned %+v", got)
+	}
+}
+
+func TestPullRequestsService_GetIterationFileDiff(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/o/p/_apis/git/repositories/r/pullrequests/1/iterations/3", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 3, "sourceRefCommit": {"commitId": "s3"}, "commonRefCommit": {"commitId": "c"}}`)
+	})
+	mux.HandleFunc("/o/p/_apis/git/repositories/r/pullrequests/1/iterations/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 2, "sourceRefCommit": {"commitId": "s2"}, "commonRefCommit": {"commitId": "c"}}`)
+	})
+	mux.HandleFunc("/o/p/_apis/git/repositories/r/filediffs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"baseVersionCommit":"s2","fileDiffParams":[{"originalPath":"/old.go","path":"/new.go"}],"targetVersionCommit":"s3"}`+"\n")
+		fmt.Fprint(w, `{"count": 1, "value": [{"path": "/new.go", "originalPath": "/old.go", "lineDiffBlocks": [
+			{"changeType": "add", "modifiedLineNumberStart": 4, "modifiedLinesCount": 2, "originalLineNumberStart": 4, "originalLinesCount": 0}
+		]}]}`)
+	})
+
+	change := &azuredevops.GitPullRequestChange{}
+	change.Item = &azuredevops.GitItem{Path: String("/new.go")}
+	change.OriginalPath = String("/old.go")
+	got, _, err := c.PullRequests.GetIterationFileDiff(context.Background(), "o", "p", "r", 1, 3, 2, change)
+	if err != nil {
+		t.Fatalf("PullRequests.GetIterationFileDiff returned error: %v", err)
+	}
+
+	if len(got.LineDiffBlocks) != 1 || got.LineDiffBlocks[0].GetModifiedLinesCount() != 2 {
+		t.Errorf("PullRequests.GetIterationFileDiff returned %+v", got)
+	}
+}
@@ -0,0 +1,270 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ReleaseApprovalStatus is enum type for the status of a release approval
+type ReleaseApprovalStatus string
+
+const (
+	// ReleaseApprovalUndefined No status.
+	ReleaseApprovalUndefined ReleaseApprovalStatus = "undefined"
+	// ReleaseApprovalPending The approval is waiting for the approver.
+	ReleaseApprovalPending ReleaseApprovalStatus = "pending"
+	// ReleaseApprovalApproved The approval was approved.
+	ReleaseApprovalApproved ReleaseApprovalStatus = "approved"
+	// ReleaseApprovalRejected The approval was rejected.
+	ReleaseApprovalRejected ReleaseApprovalStatus = "rejected"
+	// ReleaseApprovalReassigned The approval was reassigned to another approver.
+	ReleaseApprovalReassigned ReleaseApprovalStatus = "reassigned"
+	// ReleaseApprovalCanceled The approval was canceled.
+	ReleaseApprovalCanceled ReleaseApprovalStatus = "canceled"
+	// ReleaseApprovalSkipped The approval was skipped.
+	ReleaseApprovalSkipped ReleaseApprovalStatus = "skipped"
+)
+
+// ReleaseApprovalType is enum type for when an approval is required
+type ReleaseApprovalType string
+
+const (
+	// ReleaseApprovalPreDeploy The approval is required before deploying.
+	ReleaseApprovalPreDeploy ReleaseApprovalType = "preDeploy"
+	// ReleaseApprovalPostDeploy The approval is required after deploying.
+	ReleaseApprovalPostDeploy ReleaseApprovalType = "postDeploy"
+)
+
+// GateStatus is enum type for the status of release gates
+type GateStatus string
+
+const (
+	// GateNone No status.
+	GateNone GateStatus = "none"
+	// GatePending The gates have not started.
+	GatePending GateStatus = "pending"
+	// GateInProgress The gates are being evaluated.
+	GateInProgress GateStatus = "inProgress"
+	// GateSucceeded The gates passed.
+	GateSucceeded GateStatus = "succeeded"
+	// GateFailed The gates failed.
+	GateFailed GateStatus = "failed"
+	// GateCanceled The gates were canceled.
+	GateCanceled GateStatus = "canceled"
+)
+
+// ReleaseApproval Represents an approval of a release environment.
+type ReleaseApproval struct {
+	ApprovalType       *ReleaseApprovalType     `json:"approvalType,omitempty"`
+	ApprovedBy         *IdentityRef             `json:"approvedBy,omitempty"`
+	Approver           *IdentityRef             `json:"approver,omitempty"`
+	Attempt            *int                     `json:"attempt,omitempty"`
+	Comments           *string                  `json:"comments,omitempty"`
+	CreatedOn          *Time                    `json:"createdOn,omitempty"`
+	ID                 *int                     `json:"id,omitempty"`
+	IsAutomated        *bool                    `json:"isAutomated,omitempty"`
+	ModifiedOn         *Time                    `json:"modifiedOn,omitempty"`
+	Rank               *int                     `json:"rank,omitempty"`
+	Release            *ReleaseShallowReference `json:"release,omitempty"`
+	ReleaseDefinition  *ReleaseShallowReference `json:"releaseDefinition,omitempty"`
+	ReleaseEnvironment *ReleaseShallowReference `json:"releaseEnvironment,omitempty"`
+	Revision           *int                     `json:"revision,omitempty"`
+	Status             *ReleaseApprovalStatus   `json:"status,omitempty"`
+	URL                *string                  `json:"url,omitempty"`
+}
+
+// IgnoredGate Represents a gate that was ignored.
+type IgnoredGate struct {
+	LastModifiedOn *Time   `json:"lastModifiedOn,omitempty"`
+	Name           *string `json:"name,omitempty"`
+}
+
+// ReleaseGates Represents the evaluation of the gates of a release
+// environment.
+type ReleaseGates struct {
+	// DeploymentJobs The gate evaluation jobs and their tasks, one task per
+	// gate. Their shape is kept as returned by the service.
+	DeploymentJobs []map[string]interface{} `json:"deploymentJobs,omitempty"`
+	// ID The gate step ID, for use with IgnoreGates.
+	ID                       *int           `json:"id,omitempty"`
+	IgnoredGates             []*IgnoredGate `json:"ignoredGates,omitempty"`
+	LastModifiedOn           *Time          `json:"lastModifiedOn,omitempty"`
+	RunPlanID                *string        `json:"runPlanId,omitempty"`
+	StabilizationCompletedOn *Time          `json:"stabilizationCompletedOn,omitempty"`
+	StartedOn                *Time          `json:"startedOn,omitempty"`
+	Status                   *GateStatus    `json:"status,omitempty"`
+	SucceedingSince          *Time          `json:"succeedingSince,omitempty"`
+}
+
+// ReleaseApprovalsListResponse describes a release approvals list response
+type ReleaseApprovalsListResponse struct {
+	Count     int                `json:"count"`
+	Approvals []*ReleaseApproval `json:"value"`
+}
+
+// ReleaseApprovalsListOptions describes what the request to the API should look like
+type ReleaseApprovalsListOptions struct {
+	// AssignedToFilter Only return approvals assigned to this identity.
+	// Defaults to the calling identity.
+	AssignedToFilter string `url:"assignedToFilter,omitempty"`
+	// StatusFilter Defaults to pending approvals.
+	StatusFilter     ReleaseApprovalStatus `url:"statusFilter,omitempty"`
+	ReleaseIDsFilter []int                 `url:"releaseIdsFilter,comma,omitempty"`
+	TypeFilter       ReleaseApprovalType   `url:"typeFilter,omitempty"`
+	Top              int                   `url:"top,omitempty"`
+	// ContinuationToken The ID of the approval to continue from.
+	ContinuationToken int `url:"continuationToken,omitempty"`
+	// QueryOrder Either "ascending" or "descending" by ID.
+	QueryOrder string `url:"queryOrder,omitempty"`
+	// IncludeMyGroupApprovals Include approvals assigned to groups the
+	// identity is a member of.
+	IncludeMyGroupApprovals bool `url:"includeMyGroupApprovals,omitempty"`
+}
+
+// releaseApprovalUpdate describes a response to a release approval
+type releaseApprovalUpdate struct {
+	Approver *IdentityRef           `json:"approver,omitempty"`
+	Comments string                 `json:"comments,omitempty"`
+	Status   *ReleaseApprovalStatus `json:"status,omitempty"`
+}
+
+// gateUpdateMetadata describes the gates to ignore
+type gateUpdateMetadata struct {
+	Comment       string   `json:"comment,omitempty"`
+	GatesToIgnore []string `json:"gatesToIgnore"`
+}
+
+// ListApprovals returns release approvals, by default the pending
+// approvals of the calling identity across all releases of a project
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/release/approvals/list?view=azure-devops-rest-5.1
+func (s *ReleasesService) ListApprovals(ctx context.Context, owner string, project string, opts *ReleaseApprovalsListOptions) ([]*ReleaseApproval, *http.Response, error) {
+	URL := fmt.Sprintf("%s%s/%s/_apis/release/approvals?api-version=5.1",
+		s.client.VsrmBaseURL.String(),
+		owner,
+		project,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(ReleaseApprovalsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Approvals, resp, err
+}
+
+// Approve approves a release approval with an optional comment
+func (s *ReleasesService) Approve(ctx context.Context, owner string, project string, approvalID int, comments string) (*ReleaseApproval, *http.Response, error) {
+	status := ReleaseApprovalApproved
+	return s.updateApproval(ctx, owner, project, approvalID, &releaseApprovalUpdate{Status: &status, Comments: comments})
+}
+
+// Reject rejects a release approval with an optional comment
+func (s *ReleasesService) Reject(ctx context.Context, owner string, project string, approvalID int, comments string) (*ReleaseApproval, *http.Response, error) {
+	status := ReleaseApprovalRejected
+	return s.updateApproval(ctx, owner, project, approvalID, &releaseApprovalUpdate{Status: &status, Comments: comments})
+}
+
+// Reassign reassigns a pending release approval to another identity,
+// which must have its ID set
+func (s *ReleasesService) Reassign(ctx context.Context, owner string, project string, approvalID int, approver *IdentityRef, comments string) (*ReleaseApproval, *http.Response, error) {
+	if approver.GetID() == "" {
+		return nil, nil, errors.New("Releases.Reassign: Must supply an approver with an ID")
+	}
+	status := ReleaseApprovalReassigned
+	return s.updateApproval(ctx, owner, project, approvalID, &releaseApprovalUpdate{Approver: approver, Status: &status, Comments: comments})
+}
+
+// updateApproval utilises https://docs.microsoft.com/en-us/rest/api/azure/devops/release/approvals/update?view=azure-devops-rest-5.1
+func (s *ReleasesService) updateApproval(ctx context.Context, owner string, project string, approvalID int, update *releaseApprovalUpdate) (*ReleaseApproval, *http.Response, error) {
+	URL := fmt.Sprintf("%s%s/%s/_apis/release/approvals/%d?api-version=5.1",
+		s.client.VsrmBaseURL.String(),
+		owner,
+		project,
+		approvalID,
+	)
+
+	req, err := s.client.NewRequest("PATCH", URL, update)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(ReleaseApproval)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// GetEnvironment returns a release environment including its approvals
+// and the status of its gates
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/release/releases/get%20release%20environment?view=azure-devops-rest-5.1
+func (s *ReleasesService) GetEnvironment(ctx context.Context, owner string, project string, releaseID int, environmentID int) (*ReleaseEnvironment, *http.Response, error) {
+	URL := fmt.Sprintf("%s%s/%s/_apis/release/releases/%d/environments/%d?api-version=5.1-preview.6",
+		s.client.VsrmBaseURL.String(),
+		owner,
+		project,
+		releaseID,
+		environmentID,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(ReleaseEnvironment)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// GetGates returns the evaluation of the gates that run before or after a
+// release environment is deployed, including their status and the gate step
+// ID for use with IgnoreGates. It returns nil gates if the environment has
+// no gates of that type. gateType is ReleaseApprovalPreDeploy or
+// ReleaseApprovalPostDeploy.
+func (s *ReleasesService) GetGates(ctx context.Context, owner string, project string, releaseID int, environmentID int, gateType ReleaseApprovalType) (*ReleaseGates, *http.Response, error) {
+	if gateType != ReleaseApprovalPreDeploy && gateType != ReleaseApprovalPostDeploy {
+		return nil, nil, fmt.Errorf("Releases.GetGates: Invalid gate type %q", gateType)
+	}
+
+	environment, resp, err := s.GetEnvironment(ctx, owner, project, releaseID, environmentID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if gateType == ReleaseApprovalPostDeploy {
+		return environment.GetPostDeploymentGates(), resp, nil
+	}
+	return environment.GetPreDeploymentGates(), resp, nil
+}
+
+// IgnoreGates ignores the named gates of a gate step so the deployment
+// can proceed. gateStepID is the ID of the environment's ReleaseGates.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/release/gates/update?view=azure-devops-rest-5.1
+func (s *ReleasesService) IgnoreGates(ctx context.Context, owner string, project string, gateStepID int, gateNames []string, comment string) (*ReleaseGates, *http.Response, error) {
+	if len(gateNames) == 0 {
+		return nil, nil, errors.New("Releases.IgnoreGates: Must supply at least one gate name")
+	}
+
+	URL := fmt.Sprintf("%s%s/%s/_apis/release/gates/%d?api-version=5.1",
+		s.client.VsrmBaseURL.String(),
+		owner,
+		project,
+		gateStepID,
+	)
+
+	req, err := s.client.NewRequest("PATCH", URL, &gateUpdateMetadata{Comment: comment, GatesToIgnore: gateNames})
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(ReleaseGates)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
@@ -0,0 +1,166 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+func TestReleasesService_ListApprovals(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	u, _ := url.Parse("")
+	c.VsrmBaseURL = *u
+
+	mux.HandleFunc("/o/p/_apis/release/approvals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"statusFilter":            "pending",
+			"releaseIdsFilter":        "10,11",
+			"includeMyGroupApprovals": "true",
+		})
+		fmt.Fprint(w, `{"count": 2, "value": [
+			{"id": 1, "approvalType": "preDeploy", "status": "pending", "releaseEnvironment": {"id": 20, "name": "staging"}, "release": {"id": 10}},
+			{"id": 2, "approvalType": "preDeploy", "status": "pending", "releaseEnvironment": {"id": 21, "name": "production"}, "release": {"id": 11}}
+		]}`)
+	})
+
+	opts := &azuredevops.ReleaseApprovalsListOptions{
+		StatusFilter:            azuredevops.ReleaseApprovalPending,
+		ReleaseIDsFilter:        []int{10, 11},
+		IncludeMyGroupApprovals: true,
+	}
+	approvals, _, err := c.Releases.ListApprovals(context.Background(), "o", "p", opts)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if len(approvals) != 2 {
+		t.Fatalf("expected 2 approvals, got %d", len(approvals))
+	}
+	if approvals[1].GetReleaseEnvironment().GetName() != "production" || *approvals[1].GetApprovalType() != azuredevops.ReleaseApprovalPreDeploy {
+		t.Errorf("unexpected approval %+v", approvals[1])
+	}
+}
+
+func TestReleasesService_UpdateApprovals(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	u, _ := url.Parse("")
+	c.VsrmBaseURL = *u
+
+	for id, want := range map[int]string{
+		1: `{"comments":"gates passed","status":"approved"}`,
+		2: `{"status":"rejected"}`,
+		3: `{"approver":{"id":"u2"},"comments":"on call","status":"reassigned"}`,
+	} {
+		want := want
+		mux.HandleFunc(fmt.Sprintf("/o/p/_apis/release/approvals/%d", id), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PATCH")
+			b, _ := ioutil.ReadAll(r.Body)
+			if string(b) != want+"\n" {
+				t.Errorf("request Body is %s, want %s", b, want)
+			}
+			fmt.Fprint(w, want)
+		})
+	}
+
+	approval, _, err := c.Releases.Approve(context.Background(), "o", "p", 1, "gates passed")
+	if err != nil {
+		t.Fatalf("Approve returned error: %v", err)
+	}
+	if *approval.GetStatus() != azuredevops.ReleaseApprovalApproved {
+		t.Errorf("expected approved, got %v", *approval.GetStatus())
+	}
+
+	if _, _, err := c.Releases.Reject(context.Background(), "o", "p", 2, ""); err != nil {
+		t.Fatalf("Reject returned error: %v", err)
+	}
+
+	if _, _, err := c.Releases.Reassign(context.Background(), "o", "p", 3, &azuredevops.IdentityRef{}, ""); err == nil {
+		t.Errorf("expected an error without an approver ID")
+	}
+	approval, _, err = c.Releases.Reassign(context.Background(), "o", "p", 3, &azuredevops.IdentityRef{ID: azuredevops.String("u2")}, "on call")
+	if err != nil {
+		t.Fatalf("Reassign returned error: %v", err)
+	}
+	if approval.GetApprover().GetID() != "u2" {
+		t.Errorf("expected approver u2, got %q", approval.GetApprover().GetID())
+	}
+}
+
+func TestReleasesService_Gates(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	u, _ := url.Parse("")
+	c.VsrmBaseURL = *u
+
+	mux.HandleFunc("/o/p/_apis/release/releases/10/environments/21", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 21, "name": "production",
+			"preDeployApprovals": [{"id": 2, "status": "pending"}],
+			"preDeploymentGates": {"id": 40, "status": "inProgress"}}`)
+	})
+	mux.HandleFunc("/o/p/_apis/release/gates/40", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"comment":"known outage","gatesToIgnore":["Query Azure Monitor alerts"]}`+"\n")
+		fmt.Fprint(w, `{"id": 40, "status": "succeeded", "ignoredGates": [{"name": "Query Azure Monitor alerts"}]}`)
+	})
+
+	environment, _, err := c.Releases.GetEnvironment(context.Background(), "o", "p", 10, 21)
+	if err != nil {
+		t.Fatalf("GetEnvironment returned error: %v", err)
+	}
+	gates := environment.GetPreDeploymentGates()
+	if *gates.GetStatus() != azuredevops.GateInProgress || len(environment.PreDeployApprovals) != 1 {
+		t.Errorf("unexpected environment %+v", environment)
+	}
+
+	if _, _, err := c.Releases.IgnoreGates(context.Background(), "o", "p", gates.GetID(), nil, ""); err == nil {
+		t.Errorf("expected an error without gate names")
+	}
+	gates, _, err = c.Releases.IgnoreGates(context.Background(), "o", "p", gates.GetID(), []string{"Query Azure Monitor alerts"}, "known outage")
+	if err != nil {
+		t.Fatalf("IgnoreGates returned error: %v", err)
+	}
+	if *gates.GetStatus() != azuredevops.GateSucceeded || gates.IgnoredGates[0].GetName() != "Query Azure Monitor alerts" {
+		t.Errorf("unexpected gates %+v", gates)
+	}
+}
+
+func TestReleasesService_GetGates(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	u, _ := url.Parse("")
+	c.VsrmBaseURL = *u
+
+	mux.HandleFunc("/o/p/_apis/release/releases/10/environments/21", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 21, "name": "production",
+			"preDeploymentGates": {"id": 40, "status": "failed"}}`)
+	})
+
+	gates, _, err := c.Releases.GetGates(context.Background(), "o", "p", 10, 21, azuredevops.ReleaseApprovalPreDeploy)
+	if err != nil {
+		t.Fatalf("GetGates returned error: %v", err)
+	}
+	if gates.GetID() != 40 || *gates.GetStatus() != azuredevops.GateFailed {
+		t.Errorf("unexpected gates %+v", gates)
+	}
+
+	gates, _, err = c.Releases.GetGates(context.Background(), "o", "p", 10, 21, azuredevops.ReleaseApprovalPostDeploy)
+	if err != nil {
+		t.Fatalf("GetGates returned error: %v", err)
+	}
+	if gates != nil {
+		t.Errorf("expected no post-deployment gates, got %+v", gates)
+	}
+
+	if _, _, err := c.Releases.GetGates(context.Background(), "o", "p", 10, 21, ""); err == nil {
+		t.Errorf("expected an error without a gate type")
+	}
+}
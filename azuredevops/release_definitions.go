@@ -0,0 +1,199 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ReleaseDefinitionsListResponse describes a release definitions list response
+type ReleaseDefinitionsListResponse struct {
+	Count       int                  `json:"count"`
+	Definitions []*ReleaseDefinition `json:"value"`
+}
+
+// ReleaseShallowReference A shallow reference to a release, release
+// definition or release environment.
+type ReleaseShallowReference struct {
+	Links *map[string]Link `json:"_links,omitempty"`
+	ID    *int             `json:"id,omitempty"`
+	Name  *string          `json:"name,omitempty"`
+	Path  *string          `json:"path,omitempty"`
+	URL   *string          `json:"url,omitempty"`
+}
+
+// ArtifactSourceReference A reference to the source of an artifact, such
+// as a build definition or a branch.
+type ArtifactSourceReference struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// ReleaseArtifact Represents an artifact linked to a release definition or
+// consumed by a release.
+type ReleaseArtifact struct {
+	Alias *string `json:"alias,omitempty"`
+	// DefinitionReference Describes the artifact source, keyed by e.g.
+	// "definition", "project", "branch" and "version".
+	DefinitionReference map[string]*ArtifactSourceReference `json:"definitionReference,omitempty"`
+	IsPrimary           *bool                               `json:"isPrimary,omitempty"`
+	IsRetained          *bool                               `json:"isRetained,omitempty"`
+	SourceID            *string                             `json:"sourceId,omitempty"`
+	// Type The artifact type, e.g. Build or Git.
+	Type *string `json:"type,omitempty"`
+}
+
+// ConfigurationVariableValue Represents a release variable.
+type ConfigurationVariableValue struct {
+	AllowOverride *bool   `json:"allowOverride,omitempty"`
+	IsSecret      *bool   `json:"isSecret,omitempty"`
+	Value         *string `json:"value,omitempty"`
+}
+
+// ReleaseCondition Represents a condition for deploying to an environment,
+// such as the success of a previous environment.
+type ReleaseCondition struct {
+	ConditionType *string `json:"conditionType,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	Value         *string `json:"value,omitempty"`
+}
+
+// EnvironmentExecutionPolicy Controls how many deployments of an
+// environment may run and queue at once.
+type EnvironmentExecutionPolicy struct {
+	ConcurrencyCount *int `json:"concurrencyCount,omitempty"`
+	QueueDepthCount  *int `json:"queueDepthCount,omitempty"`
+}
+
+// EnvironmentRetentionPolicy Controls how long releases deployed to an
+// environment are kept.
+type EnvironmentRetentionPolicy struct {
+	DaysToKeep     *int  `json:"daysToKeep,omitempty"`
+	ReleasesToKeep *int  `json:"releasesToKeep,omitempty"`
+	RetainBuild    *bool `json:"retainBuild,omitempty"`
+}
+
+// ReleaseDefinitionEnvironment Represents an environment (stage) of a
+// release definition.
+type ReleaseDefinitionEnvironment struct {
+	Conditions []*ReleaseCondition `json:"conditions,omitempty"`
+	// DeployPhases The phases and tasks of the environment. Their shape
+	// depends on the phase type and is kept as returned by the service.
+	DeployPhases []map[string]interface{} `json:"deployPhases,omitempty"`
+	// EnvironmentOptions Options such as email notifications, kept as
+	// returned by the service.
+	EnvironmentOptions map[string]interface{}                 `json:"environmentOptions,omitempty"`
+	ExecutionPolicy    *EnvironmentExecutionPolicy            `json:"executionPolicy,omitempty"`
+	ID                 *int                                   `json:"id,omitempty"`
+	Name               *string                                `json:"name,omitempty"`
+	Owner              *IdentityRef                           `json:"owner,omitempty"`
+	Rank               *int                                   `json:"rank,omitempty"`
+	RetentionPolicy    *EnvironmentRetentionPolicy            `json:"retentionPolicy,omitempty"`
+	VariableGroups     []int                                  `json:"variableGroups,omitempty"`
+	Variables          map[string]*ConfigurationVariableValue `json:"variables,omitempty"`
+}
+
+// ReleaseDefinition Represents a classic release definition.
+type ReleaseDefinition struct {
+	Links        *map[string]Link                `json:"_links,omitempty"`
+	Artifacts    []*ReleaseArtifact              `json:"artifacts,omitempty"`
+	Comment      *string                         `json:"comment,omitempty"`
+	CreatedBy    *IdentityRef                    `json:"createdBy,omitempty"`
+	CreatedOn    *Time                           `json:"createdOn,omitempty"`
+	Description  *string                         `json:"description,omitempty"`
+	Environments []*ReleaseDefinitionEnvironment `json:"environments,omitempty"`
+	ID           *int                            `json:"id,omitempty"`
+	IsDeleted    *bool                           `json:"isDeleted,omitempty"`
+	LastRelease  *ReleaseShallowReference        `json:"lastRelease,omitempty"`
+	ModifiedBy   *IdentityRef                    `json:"modifiedBy,omitempty"`
+	ModifiedOn   *Time                           `json:"modifiedOn,omitempty"`
+	Name         *string                         `json:"name,omitempty"`
+	Path         *string                         `json:"path,omitempty"`
+	Properties   PropertiesCollection            `json:"properties,omitempty"`
+	// ReleaseNameFormat The format of release names, e.g.
+	// "Release-$(rev:r)".
+	ReleaseNameFormat *string  `json:"releaseNameFormat,omitempty"`
+	Revision          *int     `json:"revision,omitempty"`
+	Source            *string  `json:"source,omitempty"`
+	Tags              []string `json:"tags,omitempty"`
+	// Triggers The triggers of the definition. Their shape depends on the
+	// trigger type and is kept as returned by the service.
+	Triggers       []map[string]interface{}               `json:"triggers,omitempty"`
+	URL            *string                                `json:"url,omitempty"`
+	VariableGroups []int                                  `json:"variableGroups,omitempty"`
+	Variables      map[string]*ConfigurationVariableValue `json:"variables,omitempty"`
+}
+
+// ReleaseDefinitionsListOptions describes what the request to the API should look like
+type ReleaseDefinitionsListOptions struct {
+	// SearchText Only return definitions whose name contains the text.
+	SearchText string `url:"searchText,omitempty"`
+	// Expand Include additional details, e.g. "environments,artifacts".
+	Expand            string `url:"$expand,omitempty"`
+	Top               int    `url:"$top,omitempty"`
+	ContinuationToken string `url:"continuationToken,omitempty"`
+	Path              string `url:"path,omitempty"`
+	IsDeleted         bool   `url:"isDeleted,omitempty"`
+}
+
+// ListDefinitions returns the release definitions of a project
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/release/definitions/list?view=azure-devops-rest-5.1
+func (s *ReleasesService) ListDefinitions(ctx context.Context, owner string, project string, opts *ReleaseDefinitionsListOptions) ([]*ReleaseDefinition, *http.Response, error) {
+	URL := fmt.Sprintf("%s%s/%s/_apis/release/definitions?api-version=5.1",
+		s.client.VsrmBaseURL.String(),
+		owner,
+		project,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(ReleaseDefinitionsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Definitions, resp, err
+}
+
+// GetDefinition returns a release definition
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/release/definitions/get?view=azure-devops-rest-5.1
+func (s *ReleasesService) GetDefinition(ctx context.Context, owner string, project string, definitionID int) (*ReleaseDefinition, *http.Response, error) {
+	URL := fmt.Sprintf("%s%s/%s/_apis/release/definitions/%d?api-version=5.1",
+		s.client.VsrmBaseURL.String(),
+		owner,
+		project,
+		definitionID,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(ReleaseDefinition)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// CreateDefinition creates a release definition
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/release/definitions/create?view=azure-devops-rest-5.1
+func (s *ReleasesService) CreateDefinition(ctx context.Context, owner string, project string, definition *ReleaseDefinition) (*ReleaseDefinition, *http.Response, error) {
+	URL := fmt.Sprintf("%s%s/%s/_apis/release/definitions?api-version=5.1",
+		s.client.VsrmBaseURL.String(),
+		owner,
+		project,
+	)
+
+	req, err := s.client.NewRequest("POST", URL, definition)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(ReleaseDefinition)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
@@ -0,0 +1,82 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+func TestReleasesService_ListDefinitions(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	u, _ := url.Parse("")
+	c.VsrmBaseURL = *u
+
+	mux.HandleFunc("/o/p/_apis/release/definitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"searchText": "web", "$expand": "environments"})
+		fmt.Fprint(w, `{"count": 1, "value": [{
+			"id": 3,
+			"name": "web",
+			"releaseNameFormat": "Release-$(rev:r)",
+			"environments": [{"id": 7, "name": "production", "rank": 2, "executionPolicy": {"concurrencyCount": 1}}],
+			"artifacts": [{"alias": "_web", "type": "Build", "isPrimary": true, "definitionReference": {"definition": {"id": "12", "name": "web-ci"}}}]
+		}]}`)
+	})
+
+	opts := &azuredevops.ReleaseDefinitionsListOptions{SearchText: "web", Expand: "environments"}
+	definitions, _, err := c.Releases.ListDefinitions(context.Background(), "o", "p", opts)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if len(definitions) != 1 {
+		t.Fatalf("expected 1 definition, got %d", len(definitions))
+	}
+	definition := definitions[0]
+	if definition.Environments[0].GetExecutionPolicy().GetConcurrencyCount() != 1 {
+		t.Errorf("expected an execution policy")
+	}
+	if definition.Artifacts[0].DefinitionReference["definition"].GetName() != "web-ci" {
+		t.Errorf("expected the artifact source definition")
+	}
+}
+
+func TestReleasesService_GetCreateDefinition(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	u, _ := url.Parse("")
+	c.VsrmBaseURL = *u
+
+	mux.HandleFunc("/o/p/_apis/release/definitions/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 3, "name": "web", "revision": 4}`)
+	})
+	mux.HandleFunc("/o/p/_apis/release/definitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"web-copy","path":"\\"}`+"\n")
+		fmt.Fprint(w, `{"id": 4, "name": "web-copy", "revision": 1}`)
+	})
+
+	definition, _, err := c.Releases.GetDefinition(context.Background(), "o", "p", 3)
+	if err != nil {
+		t.Fatalf("GetDefinition returned error: %v", err)
+	}
+	if definition.GetRevision() != 4 {
+		t.Errorf("expected revision 4, got %d", definition.GetRevision())
+	}
+
+	created, _, err := c.Releases.CreateDefinition(context.Background(), "o", "p", &azuredevops.ReleaseDefinition{
+		Name: azuredevops.String("web-copy"),
+		Path: azuredevops.String(`\`),
+	})
+	if err != nil {
+		t.Fatalf("CreateDefinition returned error: %v", err)
+	}
+	if created.GetID() != 4 {
+		t.Errorf("expected definition 4, got %d", created.GetID())
+	}
+}
@@ -0,0 +1,416 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ReleasesService handles communication with the classic release management methods on the API
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/release
+type ReleasesService struct {
+	client *Client
+}
+
+// ReleasesListResponse describes a releases list response
+type ReleasesListResponse struct {
+	Count    int        `json:"count"`
+	Releases []*Release `json:"value"`
+}
+
+// DeploymentsListResponse describes a deployments list response
+type DeploymentsListResponse struct {
+	Count       int           `json:"count"`
+	Deployments []*Deployment `json:"value"`
+}
+
+// ReleaseStatus is enum type for the status of a release
+type ReleaseStatus string
+
+const (
+	// ReleaseUndefined No status.
+	ReleaseUndefined ReleaseStatus = "undefined"
+	// ReleaseDraft The release is a draft.
+	ReleaseDraft ReleaseStatus = "draft"
+	// ReleaseActive The release is active.
+	ReleaseActive ReleaseStatus = "active"
+	// ReleaseAbandoned The release was abandoned.
+	ReleaseAbandoned ReleaseStatus = "abandoned"
+)
+
+// EnvironmentStatus is enum type for the status of a release environment
+type EnvironmentStatus string
+
+const (
+	// EnvironmentUndefined No status.
+	EnvironmentUndefined EnvironmentStatus = "undefined"
+	// EnvironmentNotStarted The environment has not been deployed.
+	EnvironmentNotStarted EnvironmentStatus = "notStarted"
+	// EnvironmentInProgress The environment is being deployed.
+	EnvironmentInProgress EnvironmentStatus = "inProgress"
+	// EnvironmentSucceeded The deployment succeeded.
+	EnvironmentSucceeded EnvironmentStatus = "succeeded"
+	// EnvironmentCanceled The deployment was canceled.
+	EnvironmentCanceled EnvironmentStatus = "canceled"
+	// EnvironmentRejected The deployment was rejected.
+	EnvironmentRejected EnvironmentStatus = "rejected"
+	// EnvironmentQueued The deployment is queued.
+	EnvironmentQueued EnvironmentStatus = "queued"
+	// EnvironmentScheduled The deployment is scheduled.
+	EnvironmentScheduled EnvironmentStatus = "scheduled"
+	// EnvironmentPartiallySucceeded The deployment partially succeeded.
+	EnvironmentPartiallySucceeded EnvironmentStatus = "partiallySucceeded"
+)
+
+// DeploymentStatus is enum type for the status of a deployment
+type DeploymentStatus string
+
+const (
+	// DeploymentUndefined No status.
+	DeploymentUndefined DeploymentStatus = "undefined"
+	// DeploymentNotDeployed The release was not deployed.
+	DeploymentNotDeployed DeploymentStatus = "notDeployed"
+	// DeploymentInProgress The deployment is in progress.
+	DeploymentInProgress DeploymentStatus = "inProgress"
+	// DeploymentSucceeded The deployment succeeded.
+	DeploymentSucceeded DeploymentStatus = "succeeded"
+	// DeploymentPartiallySucceeded The deployment partially succeeded.
+	DeploymentPartiallySucceeded DeploymentStatus = "partiallySucceeded"
+	// DeploymentFailed The deployment failed.
+	DeploymentFailed DeploymentStatus = "failed"
+	// DeploymentAll All statuses, for use as a filter.
+	DeploymentAll DeploymentStatus = "all"
+)
+
+// ReleaseEnvironment Represents an environment (stage) of a release.
+type ReleaseEnvironment struct {
+	Conditions              []*ReleaseCondition                    `json:"conditions,omitempty"`
+	CreatedOn               *Time                                  `json:"createdOn,omitempty"`
+	DefinitionEnvironmentID *int                                   `json:"definitionEnvironmentId,omitempty"`
+	ID                      *int                                   `json:"id,omitempty"`
+	ModifiedOn              *Time                                  `json:"modifiedOn,omitempty"`
+	Name                    *string                                `json:"name,omitempty"`
+	Owner                   *IdentityRef                           `json:"owner,omitempty"`
+	PostDeployApprovals     []*ReleaseApproval                     `json:"postDeployApprovals,omitempty"`
+	PostDeploymentGates     *ReleaseGates                          `json:"postDeploymentGates,omitempty"`
+	PreDeployApprovals      []*ReleaseApproval                     `json:"preDeployApprovals,omitempty"`
+	PreDeploymentGates      *ReleaseGates                          `json:"preDeploymentGates,omitempty"`
+	Rank                    *int                                   `json:"rank,omitempty"`
+	Release                 *ReleaseShallowReference               `json:"release,omitempty"`
+	ReleaseDefinition       *ReleaseShallowReference               `json:"releaseDefinition,omitempty"`
+	ReleaseID               *int                                   `json:"releaseId,omitempty"`
+	ScheduledDeploymentTime *Time                                  `json:"scheduledDeploymentTime,omitempty"`
+	Status                  *EnvironmentStatus                     `json:"status,omitempty"`
+	TriggerReason           *string                                `json:"triggerReason,omitempty"`
+	Variables               map[string]*ConfigurationVariableValue `json:"variables,omitempty"`
+}
+
+// Release Represents a classic release.
+type Release struct {
+	Links                     *map[string]Link                       `json:"_links,omitempty"`
+	Artifacts                 []*ReleaseArtifact                     `json:"artifacts,omitempty"`
+	Comment                   *string                                `json:"comment,omitempty"`
+	CreatedBy                 *IdentityRef                           `json:"createdBy,omitempty"`
+	CreatedOn                 *Time                                  `json:"createdOn,omitempty"`
+	Description               *string                                `json:"description,omitempty"`
+	Environments              []*ReleaseEnvironment                  `json:"environments,omitempty"`
+	ID                        *int                                   `json:"id,omitempty"`
+	KeepForever               *bool                                  `json:"keepForever,omitempty"`
+	ModifiedBy                *IdentityRef                           `json:"modifiedBy,omitempty"`
+	ModifiedOn                *Time                                  `json:"modifiedOn,omitempty"`
+	Name                      *string                                `json:"name,omitempty"`
+	Properties                PropertiesCollection                   `json:"properties,omitempty"`
+	Reason                    *string                                `json:"reason,omitempty"`
+	ReleaseDefinition         *ReleaseShallowReference               `json:"releaseDefinition,omitempty"`
+	ReleaseDefinitionRevision *int                                   `json:"releaseDefinitionRevision,omitempty"`
+	Status                    *ReleaseStatus                         `json:"status,omitempty"`
+	Tags                      []string                               `json:"tags,omitempty"`
+	URL                       *string                                `json:"url,omitempty"`
+	VariableGroups            []int                                  `json:"variableGroups,omitempty"`
+	Variables                 map[string]*ConfigurationVariableValue `json:"variables,omitempty"`
+}
+
+// Environment returns the environment of the release with the given name,
+// or nil if there is none.
+func (r *Release) Environment(name string) *ReleaseEnvironment {
+	for _, environment := range r.Environments {
+		if environment.GetName() == name {
+			return environment
+		}
+	}
+	return nil
+}
+
+// Deployment Represents a deployment of a release to an environment.
+type Deployment struct {
+	Attempt                 *int                     `json:"attempt,omitempty"`
+	CompletedOn             *Time                    `json:"completedOn,omitempty"`
+	Conditions              []*ReleaseCondition      `json:"conditions,omitempty"`
+	DefinitionEnvironmentID *int                     `json:"definitionEnvironmentId,omitempty"`
+	DeploymentStatus        *DeploymentStatus        `json:"deploymentStatus,omitempty"`
+	ID                      *int                     `json:"id,omitempty"`
+	LastModifiedBy          *IdentityRef             `json:"lastModifiedBy,omitempty"`
+	LastModifiedOn          *Time                    `json:"lastModifiedOn,omitempty"`
+	OperationStatus         *string                  `json:"operationStatus,omitempty"`
+	PostDeployApprovals     []*ReleaseApproval       `json:"postDeployApprovals,omitempty"`
+	PreDeployApprovals      []*ReleaseApproval       `json:"preDeployApprovals,omitempty"`
+	QueuedOn                *Time                    `json:"queuedOn,omitempty"`
+	Reason                  *string                  `json:"reason,omitempty"`
+	Release                 *ReleaseShallowReference `json:"release,omitempty"`
+	ReleaseDefinition       *ReleaseShallowReference `json:"releaseDefinition,omitempty"`
+	ReleaseEnvironment      *ReleaseShallowReference `json:"releaseEnvironment,omitempty"`
+	RequestedBy             *IdentityRef             `json:"requestedBy,omitempty"`
+	RequestedFor            *IdentityRef             `json:"requestedFor,omitempty"`
+	ScheduledDeploymentTime *Time                    `json:"scheduledDeploymentTime,omitempty"`
+	StartedOn               *Time                    `json:"startedOn,omitempty"`
+}
+
+// BuildVersion Identifies the version of an artifact to release, e.g. a
+// build by its ID.
+type BuildVersion struct {
+	CommitMessage        *string `json:"commitMessage,omitempty"`
+	DefinitionID         *string `json:"definitionId,omitempty"`
+	DefinitionName       *string `json:"definitionName,omitempty"`
+	ID                   *string `json:"id,omitempty"`
+	Name                 *string `json:"name,omitempty"`
+	SourceBranch         *string `json:"sourceBranch,omitempty"`
+	SourceRepositoryID   *string `json:"sourceRepositoryId,omitempty"`
+	SourceRepositoryType *string `json:"sourceRepositoryType,omitempty"`
+	SourceVersion        *string `json:"sourceVersion,omitempty"`
+}
+
+// ArtifactMetadata Selects the version of an artifact of the release
+// definition, by its alias.
+type ArtifactMetadata struct {
+	Alias             *string       `json:"alias,omitempty"`
+	InstanceReference *BuildVersion `json:"instanceReference,omitempty"`
+}
+
+// ReleaseStartMetadata describes the release to create
+type ReleaseStartMetadata struct {
+	// Artifacts The artifact versions to release. Artifacts that are not
+	// listed use their default version.
+	Artifacts    []*ArtifactMetadata `json:"artifacts,omitempty"`
+	DefinitionID *int                `json:"definitionId,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	IsDraft      *bool               `json:"isDraft,omitempty"`
+	// ManualEnvironments The environments that are not deployed
+	// automatically when the release is created.
+	ManualEnvironments []string                               `json:"manualEnvironments,omitempty"`
+	Properties         PropertiesCollection                   `json:"properties,omitempty"`
+	Reason             *string                                `json:"reason,omitempty"`
+	Variables          map[string]*ConfigurationVariableValue `json:"variables,omitempty"`
+}
+
+// ReleaseUpdateMetadata describes the changes to make to a release
+type ReleaseUpdateMetadata struct {
+	Comment            *string        `json:"comment,omitempty"`
+	KeepForever        *bool          `json:"keepForever,omitempty"`
+	ManualEnvironments []string       `json:"manualEnvironments,omitempty"`
+	Name               *string        `json:"name,omitempty"`
+	Status             *ReleaseStatus `json:"status,omitempty"`
+}
+
+// ReleaseEnvironmentUpdateMetadata describes the changes to make to a
+// release environment
+type ReleaseEnvironmentUpdateMetadata struct {
+	Comment                 *string            `json:"comment,omitempty"`
+	ScheduledDeploymentTime *Time              `json:"scheduledDeploymentTime,omitempty"`
+	Status                  *EnvironmentStatus `json:"status,omitempty"`
+}
+
+// ReleasesListOptions describes what the request to the API should look like
+type ReleasesListOptions struct {
+	DefinitionID            int           `url:"definitionId,omitempty"`
+	DefinitionEnvironmentID int           `url:"definitionEnvironmentId,omitempty"`
+	SearchText              string        `url:"searchText,omitempty"`
+	CreatedBy               string        `url:"createdBy,omitempty"`
+	StatusFilter            ReleaseStatus `url:"statusFilter,omitempty"`
+	MinCreatedTime          time.Time     `url:"minCreatedTime,omitempty"`
+	MaxCreatedTime          time.Time     `url:"maxCreatedTime,omitempty"`
+	// QueryOrder Either "ascending" or "descending" by ID.
+	QueryOrder         string   `url:"queryOrder,omitempty"`
+	Top                int      `url:"$top,omitempty"`
+	ContinuationToken  int      `url:"continuationToken,omitempty"`
+	Expand             string   `url:"$expand,omitempty"`
+	SourceBranchFilter string   `url:"sourceBranchFilter,omitempty"`
+	TagFilter          []string `url:"tagFilter,comma,omitempty"`
+}
+
+// DeploymentsListOptions describes what the request to the API should look like
+type DeploymentsListOptions struct {
+	DefinitionID            int              `url:"definitionId,omitempty"`
+	DefinitionEnvironmentID int              `url:"definitionEnvironmentId,omitempty"`
+	CreatedBy               string           `url:"createdBy,omitempty"`
+	CreatedFor              string           `url:"createdFor,omitempty"`
+	DeploymentStatus        DeploymentStatus `url:"deploymentStatus,omitempty"`
+	// OperationStatus Filter on the state of the deployment operation, e.g.
+	// "pending" or "phaseInProgress".
+	OperationStatus string    `url:"operationStatus,omitempty"`
+	MinModifiedTime time.Time `url:"minModifiedTime,omitempty"`
+	MaxModifiedTime time.Time `url:"maxModifiedTime,omitempty"`
+	MinStartedTime  time.Time `url:"minStartedTime,omitempty"`
+	MaxStartedTime  time.Time `url:"maxStartedTime,omitempty"`
+	// LatestAttemptsOnly Only return the latest attempt of each deployment.
+	LatestAttemptsOnly bool   `url:"latestAttemptsOnly,omitempty"`
+	SourceBranch       string `url:"sourceBranch,omitempty"`
+	// QueryOrder Either "ascending" or "descending" by ID.
+	QueryOrder        string `url:"queryOrder,omitempty"`
+	Top               int    `url:"$top,omitempty"`
+	ContinuationToken int    `url:"continuationToken,omitempty"`
+}
+
+// CreateRelease creates a release from a release definition and the given
+// artifact versions
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/release/releases/create?view=azure-devops-rest-5.1
+func (s *ReleasesService) CreateRelease(ctx context.Context, owner string, project string, metadata *ReleaseStartMetadata) (*Release, *http.Response, error) {
+	if metadata.GetDefinitionID() == 0 {
+		return nil, nil, errors.New("Releases.CreateRelease: Must supply a DefinitionID")
+	}
+
+	URL := fmt.Sprintf("%s%s/%s/_apis/release/releases?api-version=5.1",
+		s.client.VsrmBaseURL.String(),
+		owner,
+		project,
+	)
+
+	req, err := s.client.NewRequest("POST", URL, metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Release)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// ListReleases returns the releases of a project
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/release/releases/list?view=azure-devops-rest-5.1
+func (s *ReleasesService) ListReleases(ctx context.Context, owner string, project string, opts *ReleasesListOptions) ([]*Release, *http.Response, error) {
+	URL := fmt.Sprintf("%s%s/%s/_apis/release/releases?api-version=5.1",
+		s.client.VsrmBaseURL.String(),
+		owner,
+		project,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(ReleasesListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Releases, resp, err
+}
+
+// GetRelease returns a release including its environments
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/release/releases/get%20release?view=azure-devops-rest-5.1
+func (s *ReleasesService) GetRelease(ctx context.Context, owner string, project string, releaseID int) (*Release, *http.Response, error) {
+	URL := fmt.Sprintf("%s%s/%s/_apis/release/releases/%d?api-version=5.1",
+		s.client.VsrmBaseURL.String(),
+		owner,
+		project,
+		releaseID,
+	)
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Release)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// UpdateRelease changes the status, name or retention of a release
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/release/releases/update%20release%20resource?view=azure-devops-rest-5.1
+func (s *ReleasesService) UpdateRelease(ctx context.Context, owner string, project string, releaseID int, metadata *ReleaseUpdateMetadata) (*Release, *http.Response, error) {
+	URL := fmt.Sprintf("%s%s/%s/_apis/release/releases/%d?api-version=5.1",
+		s.client.VsrmBaseURL.String(),
+		owner,
+		project,
+		releaseID,
+	)
+
+	req, err := s.client.NewRequest("PATCH", URL, metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(Release)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// AbandonRelease abandons a release, cancelling its pending deployments
+func (s *ReleasesService) AbandonRelease(ctx context.Context, owner string, project string, releaseID int, comment string) (*Release, *http.Response, error) {
+	status := ReleaseAbandoned
+	metadata := &ReleaseUpdateMetadata{Status: &status}
+	if comment != "" {
+		metadata.Comment = &comment
+	}
+	return s.UpdateRelease(ctx, owner, project, releaseID, metadata)
+}
+
+// UpdateEnvironment starts, schedules or cancels the deployment of a
+// release environment
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/release/releases/update%20release%20environment?view=azure-devops-rest-5.1
+func (s *ReleasesService) UpdateEnvironment(ctx context.Context, owner string, project string, releaseID int, environmentID int, metadata *ReleaseEnvironmentUpdateMetadata) (*ReleaseEnvironment, *http.Response, error) {
+	URL := fmt.Sprintf("%s%s/%s/_apis/release/releases/%d/environments/%d?api-version=5.1-preview.6",
+		s.client.VsrmBaseURL.String(),
+		owner,
+		project,
+		releaseID,
+		environmentID,
+	)
+
+	req, err := s.client.NewRequest("PATCH", URL, metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(ReleaseEnvironment)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// Redeploy deploys a release environment again, e.g. after a failed
+// deployment
+func (s *ReleasesService) Redeploy(ctx context.Context, owner string, project string, releaseID int, environmentID int, comment string) (*ReleaseEnvironment, *http.Response, error) {
+	status := EnvironmentInProgress
+	metadata := &ReleaseEnvironmentUpdateMetadata{Status: &status}
+	if comment != "" {
+		metadata.Comment = &comment
+	}
+	return s.UpdateEnvironment(ctx, owner, project, releaseID, environmentID, metadata)
+}
+
+// ListDeployments returns the deployments of a project
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/release/deployments/list?view=azure-devops-rest-5.1
+func (s *ReleasesService) ListDeployments(ctx context.Context, owner string, project string, opts *DeploymentsListOptions) ([]*Deployment, *http.Response, error) {
+	URL := fmt.Sprintf("%s%s/%s/_apis/release/deployments?api-version=5.1",
+		s.client.VsrmBaseURL.String(),
+		owner,
+		project,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := new(DeploymentsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Deployments, resp, err
+}
@@ -0,0 +1,181 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+func TestReleasesService_CreateRelease(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	u, _ := url.Parse("")
+	c.VsrmBaseURL = *u
+
+	mux.HandleFunc("/o/p/_apis/release/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"artifacts":[{"alias":"_web","instanceReference":{"id":"123","name":"20200101.1"}}],`+
+			`"definitionId":3,"description":"hotfix","manualEnvironments":["production"]}`+"\n")
+		fmt.Fprint(w, `{"id": 10, "name": "Release-10", "status": "active",
+			"environments": [{"id": 20, "name": "staging", "status": "inProgress"}, {"id": 21, "name": "production", "status": "notStarted"}]}`)
+	})
+
+	_, _, err := c.Releases.CreateRelease(context.Background(), "o", "p", &azuredevops.ReleaseStartMetadata{})
+	if err == nil {
+		t.Fatal("expected an error without a definition ID")
+	}
+
+	metadata := &azuredevops.ReleaseStartMetadata{
+		DefinitionID: azuredevops.Int(3),
+		Description:  azuredevops.String("hotfix"),
+		Artifacts: []*azuredevops.ArtifactMetadata{{
+			Alias: azuredevops.String("_web"),
+			InstanceReference: &azuredevops.BuildVersion{
+				ID:   azuredevops.String("123"),
+				Name: azuredevops.String("20200101.1"),
+			},
+		}},
+		ManualEnvironments: []string{"production"},
+	}
+	release, _, err := c.Releases.CreateRelease(context.Background(), "o", "p", metadata)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if *release.GetStatus() != azuredevops.ReleaseActive {
+		t.Errorf("expected active release, got %v", *release.GetStatus())
+	}
+	production := release.Environment("production")
+	if production == nil || *production.GetStatus() != azuredevops.EnvironmentNotStarted {
+		t.Errorf("expected production not started, got %+v", production)
+	}
+	if release.Environment("qa") != nil {
+		t.Errorf("expected no qa environment")
+	}
+}
+
+func TestReleasesService_ListGetReleases(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	u, _ := url.Parse("")
+	c.VsrmBaseURL = *u
+
+	mux.HandleFunc("/o/p/_apis/release/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"definitionId":   "3",
+			"statusFilter":   "active",
+			"minCreatedTime": "2020-01-01T00:00:00Z",
+			"$top":           "5",
+		})
+		fmt.Fprint(w, `{"count": 1, "value": [{"id": 10, "name": "Release-10"}]}`)
+	})
+	mux.HandleFunc("/o/p/_apis/release/releases/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 10, "name": "Release-10", "releaseDefinition": {"id": 3, "name": "web"}}`)
+	})
+
+	opts := &azuredevops.ReleasesListOptions{
+		DefinitionID:   3,
+		StatusFilter:   azuredevops.ReleaseActive,
+		MinCreatedTime: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Top:            5,
+	}
+	releases, _, err := c.Releases.ListReleases(context.Background(), "o", "p", opts)
+	if err != nil {
+		t.Fatalf("ListReleases returned error: %v", err)
+	}
+	if len(releases) != 1 {
+		t.Fatalf("expected 1 release, got %d", len(releases))
+	}
+
+	release, _, err := c.Releases.GetRelease(context.Background(), "o", "p", 10)
+	if err != nil {
+		t.Fatalf("GetRelease returned error: %v", err)
+	}
+	if release.GetReleaseDefinition().GetName() != "web" {
+		t.Errorf("expected release definition web")
+	}
+}
+
+func TestReleasesService_AbandonRelease(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	u, _ := url.Parse("")
+	c.VsrmBaseURL = *u
+
+	mux.HandleFunc("/o/p/_apis/release/releases/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"comment":"superseded","status":"abandoned"}`+"\n")
+		fmt.Fprint(w, `{"id": 10, "status": "abandoned"}`)
+	})
+
+	release, _, err := c.Releases.AbandonRelease(context.Background(), "o", "p", 10, "superseded")
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if *release.GetStatus() != azuredevops.ReleaseAbandoned {
+		t.Errorf("expected abandoned, got %v", *release.GetStatus())
+	}
+}
+
+func TestReleasesService_Redeploy(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	u, _ := url.Parse("")
+	c.VsrmBaseURL = *u
+
+	mux.HandleFunc("/o/p/_apis/release/releases/10/environments/21", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"status":"inProgress"}`+"\n")
+		fmt.Fprint(w, `{"id": 21, "name": "production", "status": "queued"}`)
+	})
+
+	environment, _, err := c.Releases.Redeploy(context.Background(), "o", "p", 10, 21, "")
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if *environment.GetStatus() != azuredevops.EnvironmentQueued {
+		t.Errorf("expected queued, got %v", *environment.GetStatus())
+	}
+}
+
+func TestReleasesService_ListDeployments(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+	u, _ := url.Parse("")
+	c.VsrmBaseURL = *u
+
+	mux.HandleFunc("/o/p/_apis/release/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"definitionId":       "3",
+			"deploymentStatus":   "failed",
+			"latestAttemptsOnly": "true",
+		})
+		fmt.Fprint(w, `{"count": 1, "value": [{
+			"id": 30,
+			"attempt": 2,
+			"deploymentStatus": "failed",
+			"release": {"id": 10, "name": "Release-10"},
+			"releaseEnvironment": {"id": 21, "name": "production"}
+		}]}`)
+	})
+
+	opts := &azuredevops.DeploymentsListOptions{
+		DefinitionID:       3,
+		DeploymentStatus:   azuredevops.DeploymentFailed,
+		LatestAttemptsOnly: true,
+	}
+	deployments, _, err := c.Releases.ListDeployments(context.Background(), "o", "p", opts)
+	if err != nil {
+		t.Fatalf("returned error: %v", err)
+	}
+	if len(deployments) != 1 || deployments[0].GetReleaseEnvironment().GetName() != "production" {
+		t.Errorf("unexpected deployments %+v", deployments)
+	}
+}
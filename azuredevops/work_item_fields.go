@@ -0,0 +1,283 @@
+package azuredevops
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Common work item field reference names
+const (
+	FieldID            = "System.Id"
+	FieldTitle         = "System.Title"
+	FieldState         = "System.State"
+	FieldReason        = "System.Reason"
+	FieldWorkItemType  = "System.WorkItemType"
+	FieldAssignedTo    = "System.AssignedTo"
+	FieldCreatedBy     = "System.CreatedBy"
+	FieldCreatedDate   = "System.CreatedDate"
+	FieldChangedBy     = "System.ChangedBy"
+	FieldChangedDate   = "System.ChangedDate"
+	FieldTags          = "System.Tags"
+	FieldAreaPath      = "System.AreaPath"
+	FieldIterationPath = "System.IterationPath"
+	FieldDescription   = "System.Description"
+	FieldBoardColumn   = "System.BoardColumn"
+	FieldStoryPoints   = "Microsoft.VSTS.Scheduling.StoryPoints"
+)
+
+// tagSeparator separates the tags of the System.Tags field
+const tagSeparator = "; "
+
+// Field returns the raw value of a work item field and whether it is set.
+func (w *WorkItem) Field(name string) (interface{}, bool) {
+	if w == nil || w.Fields == nil {
+		return nil, false
+	}
+	value, ok := (*w.Fields)[name]
+	return value, ok
+}
+
+// stringField returns the value of a string field, or "" if it is not set.
+func (w *WorkItem) stringField(name string) string {
+	value, _ := w.Field(name)
+	s, _ := value.(string)
+	return s
+}
+
+// decodeField decodes the value of a field into v, returning false if the
+// field is not set or has a different shape.
+func (w *WorkItem) decodeField(name string, v interface{}) bool {
+	value, ok := w.Field(name)
+	if !ok || value == nil {
+		return false
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, v) == nil
+}
+
+// identityField returns the value of an identity field, or nil if it is
+// not set.
+func (w *WorkItem) identityField(name string) *IdentityRef {
+	identity := new(IdentityRef)
+	if !w.decodeField(name, identity) {
+		return nil
+	}
+	return identity
+}
+
+// timeField returns the value of a date field, or nil if it is not set.
+func (w *WorkItem) timeField(name string) *Time {
+	t := new(Time)
+	if !w.decodeField(name, t) {
+		return nil
+	}
+	return t
+}
+
+// Title returns the System.Title field.
+func (w *WorkItem) Title() string { return w.stringField(FieldTitle) }
+
+// State returns the System.State field.
+func (w *WorkItem) State() string { return w.stringField(FieldState) }
+
+// Reason returns the System.Reason field.
+func (w *WorkItem) Reason() string { return w.stringField(FieldReason) }
+
+// WorkItemType returns the System.WorkItemType field, e.g. Bug.
+func (w *WorkItem) WorkItemType() string { return w.stringField(FieldWorkItemType) }
+
+// AreaPath returns the System.AreaPath field.
+func (w *WorkItem) AreaPath() string { return w.stringField(FieldAreaPath) }
+
+// IterationPath returns the System.IterationPath field.
+func (w *WorkItem) IterationPath() string { return w.stringField(FieldIterationPath) }
+
+// AssignedTo returns the System.AssignedTo field, or nil if the work item
+// is unassigned.
+func (w *WorkItem) AssignedTo() *IdentityRef { return w.identityField(FieldAssignedTo) }
+
+// CreatedBy returns the System.CreatedBy field.
+func (w *WorkItem) CreatedBy() *IdentityRef { return w.identityField(FieldCreatedBy) }
+
+// ChangedBy returns the System.ChangedBy field.
+func (w *WorkItem) ChangedBy() *IdentityRef { return w.identityField(FieldChangedBy) }
+
+// CreatedDate returns the System.CreatedDate field.
+func (w *WorkItem) CreatedDate() *Time { return w.timeField(FieldCreatedDate) }
+
+// ChangedDate returns the System.ChangedDate field.
+func (w *WorkItem) ChangedDate() *Time { return w.timeField(FieldChangedDate) }
+
+// Tags returns the tags of the System.Tags field.
+func (w *WorkItem) Tags() []string { return splitTags(w.stringField(FieldTags)) }
+
+// StoryPoints returns the Microsoft.VSTS.Scheduling.StoryPoints field, or
+// 0 if it is not set.
+func (w *WorkItem) StoryPoints() float64 {
+	value, _ := w.Field(FieldStoryPoints)
+	f, _ := value.(float64)
+	return f
+}
+
+func splitTags(s string) []string {
+	var tags []string
+	for _, tag := range strings.Split(s, ";") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// witFields returns the struct fields of v tagged with `wit:"..."` and
+// their field reference names. v must be a struct value.
+func witFields(v reflect.Value) map[string]reflect.Value {
+	fields := map[string]reflect.Value{}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Tag.Get("wit")
+		if name == "" || name == "-" || t.Field(i).PkgPath != "" {
+			continue
+		}
+		fields[name] = v.Field(i)
+	}
+	return fields
+}
+
+var stringSliceType = reflect.TypeOf([]string(nil))
+
+// UnmarshalFields copies the fields of the work item onto the struct
+// pointed to by v. Struct fields are mapped with `wit` tags holding the
+// field reference name, e.g.
+//
+//	type Story struct {
+//		Title    string       `wit:"System.Title"`
+//		Owner    *IdentityRef `wit:"System.AssignedTo"`
+//		Points   float64      `wit:"Microsoft.VSTS.Scheduling.StoryPoints"`
+//		Tags     []string     `wit:"System.Tags"`
+//		Changed  Time         `wit:"System.ChangedDate"`
+//	}
+//
+// Values are converted as if decoded from JSON, except that a []string
+// field receives the tags of a semicolon separated string. Fields the work
+// item does not have are left unchanged.
+func (w *WorkItem) UnmarshalFields(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("UnmarshalFields: v must be a non-nil pointer to a struct")
+	}
+
+	for name, field := range witFields(rv.Elem()) {
+		value, ok := w.Field(name)
+		if !ok || value == nil {
+			continue
+		}
+		if s, ok := value.(string); ok && field.Type() == stringSliceType {
+			field.Set(reflect.ValueOf(splitTags(s)))
+			continue
+		}
+
+		b, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("UnmarshalFields: %s: %v", name, err)
+		}
+		if err := json.Unmarshal(b, field.Addr().Interface()); err != nil {
+			return fmt.Errorf("UnmarshalFields: %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// DiffFields returns a patch that changes the fields of a work item from
+// the values of original to the values of updated. Both must be pointers
+// to structs of the same type with `wit` tags, as used by UnmarshalFields;
+// original may be nil to set every non-zero field, e.g. when creating a
+// work item. Fields that changed to an unset value, i.e. a nil pointer, an
+// empty slice, an empty string or a zero Time, are removed; other zero
+// values such as 0 or false are set. Operations are ordered by field
+// reference name.
+func DiffFields(original, updated interface{}) (WorkItemPatch, error) {
+	uv := reflect.ValueOf(updated)
+	if uv.Kind() != reflect.Ptr || uv.IsNil() || uv.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("DiffFields: updated must be a non-nil pointer to a struct")
+	}
+	uv = uv.Elem()
+
+	ov := reflect.Zero(uv.Type())
+	if original != nil {
+		o := reflect.ValueOf(original)
+		if o.Kind() != reflect.Ptr || o.IsNil() || o.Elem().Type() != uv.Type() {
+			return nil, errors.New("DiffFields: original must be nil or a pointer to the same struct type as updated")
+		}
+		ov = o.Elem()
+	}
+
+	updatedFields := witFields(uv)
+	originalFields := witFields(ov)
+	names := make([]string, 0, len(updatedFields))
+	for name := range updatedFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	patch := WorkItemPatch{}
+	for _, name := range names {
+		u, o := updatedFields[name], originalFields[name]
+		if reflect.DeepEqual(u.Interface(), o.Interface()) {
+			continue
+		}
+		if isUnset(u) {
+			patch = patch.RemoveField(name)
+			continue
+		}
+		patch = patch.AddField(name, fieldPatchValue(u))
+	}
+	return patch, nil
+}
+
+// fieldPatchValue converts a struct field to the value sent in a patch.
+// Identities are sent by unique name, tags are joined and dates are sent
+// as time.Time, since Time only marshals as a date through a pointer.
+func fieldPatchValue(v reflect.Value) interface{} {
+	switch value := v.Interface().(type) {
+	case []string:
+		return strings.Join(value, tagSeparator)
+	case *IdentityRef:
+		if value.GetUniqueName() != "" {
+			return value.GetUniqueName()
+		}
+		return value.GetDisplayName()
+	case IdentityRef:
+		if value.GetUniqueName() != "" {
+			return value.GetUniqueName()
+		}
+		return value.GetDisplayName()
+	case *Time:
+		return value.Time
+	case Time:
+		return value.Time
+	}
+	return v.Interface()
+}
+
+// isUnset reports whether a struct field holds no value for its work item
+// field, as opposed to a zero value such as 0 or false.
+func isUnset(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map:
+		return v.IsNil()
+	case reflect.Slice, reflect.String:
+		return v.Len() == 0
+	}
+	if t, ok := v.Interface().(Time); ok {
+		return t.Time.IsZero()
+	}
+	return false
+}
@@ -0,0 +1,207 @@
+package azuredevops_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+const workItemFieldsResponse = `{
+	"id": 42,
+	"rev": 3,
+	"fields": {
+		"System.Title": "Fix login",
+		"System.State": "Active",
+		"System.WorkItemType": "Bug",
+		"System.AreaPath": "Fabrikam\\Web",
+		"System.IterationPath": "Fabrikam\\Sprint 3",
+		"System.AssignedTo": {
+			"displayName": "Jamal Hartnett",
+			"uniqueName": "fabrikamfiber4@hotmail.com",
+			"id": "d291b0c4-a05c-4ea6-8df1-4b41d5f39eff"
+		},
+		"System.Tags": "web; login ;urgent",
+		"System.ChangedDate": "2019-10-01T12:30:00Z",
+		"Microsoft.VSTS.Scheduling.StoryPoints": 5
+	}
+}`
+
+type story struct {
+	Title      string                   `wit:"System.Title"`
+	State      string                   `wit:"System.State"`
+	AssignedTo *azuredevops.IdentityRef `wit:"System.AssignedTo"`
+	Tags       []string                 `wit:"System.Tags"`
+	Changed    *azuredevops.Time        `wit:"System.ChangedDate"`
+	Points     float64                  `wit:"Microsoft.VSTS.Scheduling.StoryPoints"`
+	Priority   int                      `wit:"Microsoft.VSTS.Common.Priority"`
+	Notes      string
+}
+
+func TestWorkItem_FieldAccessors(t *testing.T) {
+	workItem := new(azuredevops.WorkItem)
+	if err := json.Unmarshal([]byte(workItemFieldsResponse), workItem); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	if got, want := workItem.Title(), "Fix login"; got != want {
+		t.Errorf("Title returned %q, want %q", got, want)
+	}
+	if got, want := workItem.State(), "Active"; got != want {
+		t.Errorf("State returned %q, want %q", got, want)
+	}
+	if got, want := workItem.WorkItemType(), "Bug"; got != want {
+		t.Errorf("WorkItemType returned %q, want %q", got, want)
+	}
+	if got, want := workItem.IterationPath(), `Fabrikam\Sprint 3`; got != want {
+		t.Errorf("IterationPath returned %q, want %q", got, want)
+	}
+	if got, want := workItem.AssignedTo().GetUniqueName(), "fabrikamfiber4@hotmail.com"; got != want {
+		t.Errorf("AssignedTo returned %q, want %q", got, want)
+	}
+	if workItem.CreatedBy() != nil {
+		t.Errorf("CreatedBy returned %+v, want nil", workItem.CreatedBy())
+	}
+	if got, want := workItem.Tags(), []string{"web", "login", "urgent"}; !cmp.Equal(got, want) {
+		t.Errorf("Tags returned %+v, want %+v", got, want)
+	}
+	if got, want := workItem.ChangedDate().Time, time.Date(2019, 10, 1, 12, 30, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("ChangedDate returned %v, want %v", got, want)
+	}
+	if got, want := workItem.StoryPoints(), 5.0; got != want {
+		t.Errorf("StoryPoints returned %v, want %v", got, want)
+	}
+	if got := new(azuredevops.WorkItem).Title(); got != "" {
+		t.Errorf("Title of empty work item returned %q, want empty", got)
+	}
+}
+
+func TestWorkItem_UnmarshalFields(t *testing.T) {
+	workItem := new(azuredevops.WorkItem)
+	if err := json.Unmarshal([]byte(workItemFieldsResponse), workItem); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	got := story{Priority: 2, Notes: "kept"}
+	if err := workItem.UnmarshalFields(&got); err != nil {
+		t.Fatalf("WorkItem.UnmarshalFields returned error: %v", err)
+	}
+
+	want := story{
+		Title: "Fix login",
+		State: "Active",
+		AssignedTo: &azuredevops.IdentityRef{
+			DisplayName: azuredevops.String("Jamal Hartnett"),
+			UniqueName:  azuredevops.String("fabrikamfiber4@hotmail.com"),
+			ID:          azuredevops.String("d291b0c4-a05c-4ea6-8df1-4b41d5f39eff"),
+		},
+		Tags:     []string{"web", "login", "urgent"},
+		Changed:  &azuredevops.Time{Time: time.Date(2019, 10, 1, 12, 30, 0, 0, time.UTC)},
+		Points:   5,
+		Priority: 2,
+		Notes:    "kept",
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("WorkItem.UnmarshalFields diff: %s", cmp.Diff(want, got))
+	}
+
+	if err := workItem.UnmarshalFields(got); err == nil {
+		t.Error("WorkItem.UnmarshalFields of a non-pointer returned no error")
+	}
+	var wrong struct {
+		Title int `wit:"System.Title"`
+	}
+	if err := workItem.UnmarshalFields(&wrong); err == nil {
+		t.Error("WorkItem.UnmarshalFields into a mismatched type returned no error")
+	}
+}
+
+func TestDiffFields(t *testing.T) {
+	original := &story{
+		Title:      "Fix login",
+		State:      "Active",
+		AssignedTo: &azuredevops.IdentityRef{UniqueName: azuredevops.String("fabrikamfiber4@hotmail.com")},
+		Points:     5,
+		Notes:      "ignored",
+	}
+	updated := *original
+	updated.State = "Resolved"
+	updated.AssignedTo = nil
+	updated.Tags = []string{"web", "login"}
+	updated.Notes = "still ignored"
+
+	got, err := azuredevops.DiffFields(original, &updated)
+	if err != nil {
+		t.Fatalf("DiffFields returned error: %v", err)
+	}
+	want := azuredevops.WorkItemPatch{}.
+		RemoveField("System.AssignedTo").
+		AddField("System.State", "Resolved").
+		AddField("System.Tags", "web; login")
+	if !cmp.Equal(got, want) {
+		t.Errorf("DiffFields diff: %s", cmp.Diff(want, got))
+	}
+
+	got, err = azuredevops.DiffFields(nil, original)
+	if err != nil {
+		t.Fatalf("DiffFields returned error: %v", err)
+	}
+	want = azuredevops.WorkItemPatch{}.
+		AddField("Microsoft.VSTS.Scheduling.StoryPoints", 5.0).
+		AddField("System.AssignedTo", "fabrikamfiber4@hotmail.com").
+		AddField("System.State", "Active").
+		AddField("System.Title", "Fix login")
+	if !cmp.Equal(got, want) {
+		t.Errorf("DiffFields diff: %s", cmp.Diff(want, got))
+	}
+
+	if _, err := azuredevops.DiffFields(&struct{}{}, original); err == nil {
+		t.Error("DiffFields of mismatched types returned no error")
+	}
+}
+
+func TestDiffFields_zeroValuesAndDates(t *testing.T) {
+	type bug struct {
+		Title    string           `wit:"System.Title"`
+		Due      azuredevops.Time `wit:"Microsoft.VSTS.Scheduling.DueDate"`
+		Priority int              `wit:"Microsoft.VSTS.Common.Priority"`
+		Blocked  bool             `wit:"Custom.Blocked"`
+	}
+	original := &bug{
+		Title:    "Fix login",
+		Due:      azuredevops.Time{Time: time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)},
+		Priority: 2,
+		Blocked:  true,
+	}
+	updated := &bug{
+		Due: azuredevops.Time{Time: time.Date(2019, 10, 8, 0, 0, 0, 0, time.UTC)},
+	}
+
+	got, err := azuredevops.DiffFields(original, updated)
+	if err != nil {
+		t.Fatalf("DiffFields returned error: %v", err)
+	}
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	want := `[` +
+		`{"op":"add","path":"/fields/Custom.Blocked","value":false},` +
+		`{"op":"add","path":"/fields/Microsoft.VSTS.Common.Priority","value":0},` +
+		`{"op":"add","path":"/fields/Microsoft.VSTS.Scheduling.DueDate","value":"2019-10-08T00:00:00Z"},` +
+		`{"op":"remove","path":"/fields/System.Title"}` +
+		`]`
+	if string(b) != want {
+		t.Errorf("DiffFields patch is %s, want %s", b, want)
+	}
+
+	got, err = azuredevops.DiffFields(updated, &bug{})
+	if err != nil {
+		t.Fatalf("DiffFields returned error: %v", err)
+	}
+	if want := (azuredevops.WorkItemPatch{}).RemoveField("Microsoft.VSTS.Scheduling.DueDate"); !cmp.Equal(got, want) {
+		t.Errorf("DiffFields diff: %s", cmp.Diff(want, got))
+	}
+}
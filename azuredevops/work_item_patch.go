@@ -0,0 +1,51 @@
+package azuredevops
+
+import "fmt"
+
+// WorkItemPatch A JSON Patch document describing changes to a work item.
+// Operations are appended with its methods, e.g.
+//
+//	WorkItemPatch{}.
+//		TestRev(3).
+//		ReplaceField("System.State", "Active").
+//		AddRelation(&WorkItemRelation{Rel: String("System.LinkTypes.Hierarchy-Reverse"), URL: String(parentURL)})
+type WorkItemPatch []*JSONPatchOperation
+
+// AddField sets the value of a work item field.
+func (p WorkItemPatch) AddField(field string, value interface{}) WorkItemPatch {
+	return append(p, &JSONPatchOperation{Op: PatchOpAdd, Path: "/fields/" + field, Value: value})
+}
+
+// ReplaceField replaces the value of a work item field.
+func (p WorkItemPatch) ReplaceField(field string, value interface{}) WorkItemPatch {
+	return append(p, &JSONPatchOperation{Op: PatchOpReplace, Path: "/fields/" + field, Value: value})
+}
+
+// RemoveField clears the value of a work item field.
+func (p WorkItemPatch) RemoveField(field string) WorkItemPatch {
+	return append(p, &JSONPatchOperation{Op: PatchOpRemove, Path: "/fields/" + field})
+}
+
+// TestField makes the whole patch fail unless a work item field has the
+// given value.
+func (p WorkItemPatch) TestField(field string, value interface{}) WorkItemPatch {
+	return append(p, &JSONPatchOperation{Op: PatchOpTest, Path: "/fields/" + field, Value: value})
+}
+
+// TestRev makes the whole patch fail unless the work item is at the given
+// revision, guarding against concurrent updates.
+func (p WorkItemPatch) TestRev(rev int) WorkItemPatch {
+	return append(p, &JSONPatchOperation{Op: PatchOpTest, Path: "/rev", Value: rev})
+}
+
+// AddRelation adds a link to another work item, or to an artifact such as
+// a pull request or a hyperlink.
+func (p WorkItemPatch) AddRelation(relation *WorkItemRelation) WorkItemPatch {
+	return append(p, &JSONPatchOperation{Op: PatchOpAdd, Path: "/relations/-", Value: relation})
+}
+
+// RemoveRelation removes the relation at the given index of the work
+// item's Relations.
+func (p WorkItemPatch) RemoveRelation(index int) WorkItemPatch {
+	return append(p, &JSONPatchOperation{Op: PatchOpRemove, Path: fmt.Sprintf("/relations/%d", index)})
+}
@@ -0,0 +1,315 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// QueryType is enum type for the shape of a work item query
+type QueryType string
+
+const (
+	// QueryTypeFlat Returns a flat list of work items.
+	QueryTypeFlat QueryType = "flat"
+	// QueryTypeTree Returns a tree of work items linked by a tree link
+	// type such as parent/child.
+	QueryTypeTree QueryType = "tree"
+	// QueryTypeOneHop Returns work items and their direct links.
+	QueryTypeOneHop QueryType = "oneHop"
+)
+
+// QueryResultType is enum type for the result of a work item query
+type QueryResultType string
+
+const (
+	// QueryResultWorkItem The result is a list of work items.
+	QueryResultWorkItem QueryResultType = "workItem"
+	// QueryResultWorkItemLink The result is a list of links between work
+	// items.
+	QueryResultWorkItemLink QueryResultType = "workItemLink"
+)
+
+// WorkItemFieldReference A reference to a work item field.
+type WorkItemFieldReference struct {
+	Name          *string `json:"name,omitempty"`
+	ReferenceName *string `json:"referenceName,omitempty"`
+	URL           *string `json:"url,omitempty"`
+}
+
+// WorkItemQuerySortColumn A sort column of a work item query.
+type WorkItemQuerySortColumn struct {
+	Descending *bool                   `json:"descending,omitempty"`
+	Field      *WorkItemFieldReference `json:"field,omitempty"`
+}
+
+// WorkItemQueryResult The result of a WIQL query. Flat queries return
+// WorkItems, tree and one-hop queries return WorkItemRelations.
+type WorkItemQueryResult struct {
+	AsOf              *Time                      `json:"asOf,omitempty"`
+	Columns           []*WorkItemFieldReference  `json:"columns,omitempty"`
+	QueryResultType   *QueryResultType           `json:"queryResultType,omitempty"`
+	QueryType         *QueryType                 `json:"queryType,omitempty"`
+	SortColumns       []*WorkItemQuerySortColumn `json:"sortColumns,omitempty"`
+	WorkItemRelations []*WorkItemLink            `json:"workItemRelations,omitempty"`
+	WorkItems         []*WorkItemReference       `json:"workItems,omitempty"`
+}
+
+// IDs returns the IDs of the work items in the result in the order they
+// were returned. For link results each work item appears once, whether it
+// is the source or the target of links.
+func (r *WorkItemQueryResult) IDs() []int {
+	var ids []int
+	seen := map[int]bool{}
+	add := func(ref *WorkItemReference) {
+		if ref == nil || ref.ID == nil || seen[*ref.ID] {
+			return
+		}
+		seen[*ref.ID] = true
+		ids = append(ids, *ref.ID)
+	}
+
+	for _, ref := range r.WorkItems {
+		add(ref)
+	}
+	for _, link := range r.WorkItemRelations {
+		add(link.Source)
+		add(link.Target)
+	}
+	return ids
+}
+
+// WorkItemQueryOptions describes what the request to the API should look like
+type WorkItemQueryOptions struct {
+	// Top The maximum number of results to return.
+	Top int `url:"$top,omitempty"`
+	// TimePrecision Compare dates in the query including their time, not
+	// just their day.
+	TimePrecision bool `url:"timePrecision,omitempty"`
+}
+
+// wiql describes a WIQL query
+type wiql struct {
+	Query string `json:"query"`
+}
+
+// QueryHierarchyItem Represents a saved query or a folder of queries.
+type QueryHierarchyItem struct {
+	Links            *map[string]Link           `json:"_links,omitempty"`
+	Children         []*QueryHierarchyItem      `json:"children,omitempty"`
+	Columns          []*WorkItemFieldReference  `json:"columns,omitempty"`
+	CreatedBy        *IdentityRef               `json:"createdBy,omitempty"`
+	CreatedDate      *Time                      `json:"createdDate,omitempty"`
+	HasChildren      *bool                      `json:"hasChildren,omitempty"`
+	ID               *string                    `json:"id,omitempty"`
+	IsDeleted        *bool                      `json:"isDeleted,omitempty"`
+	IsFolder         *bool                      `json:"isFolder,omitempty"`
+	IsPublic         *bool                      `json:"isPublic,omitempty"`
+	LastModifiedBy   *IdentityRef               `json:"lastModifiedBy,omitempty"`
+	LastModifiedDate *Time                      `json:"lastModifiedDate,omitempty"`
+	Name             *string                    `json:"name,omitempty"`
+	Path             *string                    `json:"path,omitempty"`
+	QueryType        *QueryType                 `json:"queryType,omitempty"`
+	SortColumns      []*WorkItemQuerySortColumn `json:"sortColumns,omitempty"`
+	URL              *string                    `json:"url,omitempty"`
+	Wiql             *string                    `json:"wiql,omitempty"`
+}
+
+// QueryHierarchyItemsListResponse describes a saved queries list response
+type QueryHierarchyItemsListResponse struct {
+	Count   int                   `json:"count"`
+	Queries []*QueryHierarchyItem `json:"value"`
+}
+
+// QueryGetOptions describes what the request to the API should look like
+type QueryGetOptions struct {
+	// Expand Include additional details: none, wiql, clauses, all or
+	// minimal.
+	Expand string `url:"$expand,omitempty"`
+	// Depth Include the children of folders to this depth, at most 2.
+	Depth          int  `url:"$depth,omitempty"`
+	IncludeDeleted bool `url:"$includeDeleted,omitempty"`
+}
+
+// queryPath escapes a query ID or a folder path such as
+// "Shared Queries/Bugs" for use in a URL
+func queryPath(query string) string {
+	segments := strings.Split(strings.Trim(query, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// wiqlURL returns the WIQL URL of a project, or of a team if team is not
+// empty. Team queries resolve macros such as @currentIteration.
+func wiqlURL(owner, project, team string) string {
+	if team == "" {
+		return fmt.Sprintf("%s/%s/_apis/wit/wiql", owner, project)
+	}
+	return fmt.Sprintf("%s/%s/%s/_apis/wit/wiql", owner, project, url.PathEscape(team))
+}
+
+// QueryWIQL runs a WIQL query. team may be empty; it is needed for queries
+// using team macros such as @currentIteration. The result holds only work
+// item references, which can be expanded with ListByIDs.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/wiql/query%20by%20wiql?view=azure-devops-rest-5.1
+func (s *WorkItemsService) QueryWIQL(ctx context.Context, owner, project, team, query string, opts *WorkItemQueryOptions) (*WorkItemQueryResult, *http.Response, error) {
+	if query == "" {
+		return nil, nil, errors.New("WorkItems.QueryWIQL: Must supply a query")
+	}
+
+	URL := wiqlURL(owner, project, team) + "?api-version=5.1"
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("POST", URL, &wiql{Query: query})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(WorkItemQueryResult)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// QueryByID runs a saved query. team may be empty, as for QueryWIQL.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/wiql/query%20by%20id?view=azure-devops-rest-5.1
+func (s *WorkItemsService) QueryByID(ctx context.Context, owner, project, team, queryID string, opts *WorkItemQueryOptions) (*WorkItemQueryResult, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s?api-version=5.1",
+		wiqlURL(owner, project, team),
+		url.PathEscape(queryID),
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(WorkItemQueryResult)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// ListQueries returns the root folders of the saved queries of a project,
+// usually "My Queries" and "Shared Queries", and their children down to
+// opts.Depth
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/queries/list?view=azure-devops-rest-5.1
+func (s *WorkItemsService) ListQueries(ctx context.Context, owner, project string, opts *QueryGetOptions) ([]*QueryHierarchyItem, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/wit/queries?api-version=5.1",
+		owner,
+		project,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(QueryHierarchyItemsListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.Queries, resp, err
+}
+
+// GetQuery returns a saved query or folder by its ID or its path, e.g.
+// "Shared Queries/Active Bugs"
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/queries/get?view=azure-devops-rest-5.1
+func (s *WorkItemsService) GetQuery(ctx context.Context, owner, project, query string, opts *QueryGetOptions) (*QueryHierarchyItem, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/wit/queries/%s?api-version=5.1",
+		owner,
+		project,
+		queryPath(query),
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(QueryHierarchyItem)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// CreateQuery creates a saved query, or a folder if IsFolder is set, in
+// the folder with the given ID or path
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/queries/create?view=azure-devops-rest-5.1
+func (s *WorkItemsService) CreateQuery(ctx context.Context, owner, project, parent string, query *QueryHierarchyItem) (*QueryHierarchyItem, *http.Response, error) {
+	if query.GetName() == "" {
+		return nil, nil, errors.New("WorkItems.CreateQuery: Must supply a query name")
+	}
+
+	URL := fmt.Sprintf("%s/%s/_apis/wit/queries/%s?api-version=5.1",
+		owner,
+		project,
+		queryPath(parent),
+	)
+
+	req, err := s.client.NewRequest("POST", URL, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(QueryHierarchyItem)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// UpdateQuery renames a saved query or folder, or changes its WIQL
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/queries/update?view=azure-devops-rest-5.1
+func (s *WorkItemsService) UpdateQuery(ctx context.Context, owner, project, query string, update *QueryHierarchyItem) (*QueryHierarchyItem, *http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/wit/queries/%s?api-version=5.1",
+		owner,
+		project,
+		queryPath(query),
+	)
+
+	req, err := s.client.NewRequest("PATCH", URL, update)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(QueryHierarchyItem)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// DeleteQuery deletes a saved query, or a folder and its contents
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/queries/delete?view=azure-devops-rest-5.1
+func (s *WorkItemsService) DeleteQuery(ctx context.Context, owner, project, query string) (*http.Response, error) {
+	URL := fmt.Sprintf("%s/%s/_apis/wit/queries/%s?api-version=5.1",
+		owner,
+		project,
+		queryPath(query),
+	)
+
+	req, err := s.client.NewRequest("DELETE", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Execute(ctx, req, nil)
+}
@@ -0,0 +1,187 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+func TestWorkItems_QueryWIQL(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	query := "SELECT [System.Id] FROM WorkItems WHERE [System.IterationPath] = @currentIteration"
+	mux.HandleFunc("/o/p/my team/_apis/wit/wiql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testFormValues(t, r, values{"$top": "50", "timePrecision": "true"})
+		testBody(t, r, fmt.Sprintf(`{"query":%q}`+"\n", query))
+		fmt.Fprint(w, `{
+			"queryType": "flat",
+			"queryResultType": "workItem",
+			"columns": [{"referenceName": "System.Id", "name": "ID"}],
+			"workItems": [{"id": 300}, {"id": 299}]
+		}`)
+	})
+
+	opts := &azuredevops.WorkItemQueryOptions{Top: 50, TimePrecision: true}
+	got, _, err := c.WorkItems.QueryWIQL(context.Background(), "o", "p", "my team", query, opts)
+	if err != nil {
+		t.Fatalf("WorkItems.QueryWIQL returned error: %v", err)
+	}
+
+	queryType := azuredevops.QueryTypeFlat
+	resultType := azuredevops.QueryResultWorkItem
+	want := &azuredevops.WorkItemQueryResult{
+		QueryType:       &queryType,
+		QueryResultType: &resultType,
+		Columns:         []*azuredevops.WorkItemFieldReference{{ReferenceName: String("System.Id"), Name: String("ID")}},
+		WorkItems:       []*azuredevops.WorkItemReference{{ID: Int(300)}, {ID: Int(299)}},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("WorkItems.QueryWIQL diff: %s", cmp.Diff(want, got))
+	}
+	if ids := got.IDs(); !cmp.Equal(ids, []int{300, 299}) {
+		t.Errorf("WorkItemQueryResult.IDs returned %v, want [300 299]", ids)
+	}
+
+	if _, _, err := c.WorkItems.QueryWIQL(context.Background(), "o", "p", "", "", nil); err == nil {
+		t.Error("WorkItems.QueryWIQL without a query returned no error")
+	}
+}
+
+func TestWorkItems_QueryByID(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/wit/wiql/8a8c8212-15ca-41ed-97aa-1d6fbfbcd581", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"queryType": "tree",
+			"queryResultType": "workItemLink",
+			"workItemRelations": [
+				{"target": {"id": 1}},
+				{"rel": "System.LinkTypes.Hierarchy-Forward", "source": {"id": 1}, "target": {"id": 3}},
+				{"rel": "System.LinkTypes.Hierarchy-Forward", "source": {"id": 1}, "target": {"id": 2}}
+			]
+		}`)
+	})
+
+	got, _, err := c.WorkItems.QueryByID(context.Background(), "o", "p", "", "8a8c8212-15ca-41ed-97aa-1d6fbfbcd581", nil)
+	if err != nil {
+		t.Fatalf("WorkItems.QueryByID returned error: %v", err)
+	}
+
+	if *got.GetQueryType() != azuredevops.QueryTypeTree || len(got.WorkItemRelations) != 3 {
+		t.Errorf("WorkItems.QueryByID returned %+v, want a tree of 3 links", got)
+	}
+	if ids := got.IDs(); !cmp.Equal(ids, []int{1, 3, 2}) {
+		t.Errorf("WorkItemQueryResult.IDs returned %v, want [1 3 2]", ids)
+	}
+}
+
+func TestWorkItems_ListQueries(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/wit/queries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"$depth": "1", "$expand": "wiql"})
+		fmt.Fprint(w, `{
+			"count": 1,
+			"value": [{
+				"id": "342f0f44-4069-46b1-a940-3d0468979ceb",
+				"name": "Shared Queries",
+				"path": "Shared Queries",
+				"isFolder": true,
+				"hasChildren": true,
+				"children": [{"id": "8a8c8212-15ca-41ed-97aa-1d6fbfbcd581", "name": "Active Bugs", "path": "Shared Queries/Active Bugs", "wiql": "SELECT [System.Id] FROM WorkItems"}]
+			}]
+		}`)
+	})
+
+	opts := &azuredevops.QueryGetOptions{Expand: "wiql", Depth: 1}
+	got, _, err := c.WorkItems.ListQueries(context.Background(), "o", "p", opts)
+	if err != nil {
+		t.Fatalf("WorkItems.ListQueries returned error: %v", err)
+	}
+
+	if len(got) != 1 || len(got[0].Children) != 1 {
+		t.Fatalf("WorkItems.ListQueries returned %+v, want one folder with one query", got)
+	}
+	if wiql := got[0].Children[0].GetWiql(); wiql != "SELECT [System.Id] FROM WorkItems" {
+		t.Errorf("WorkItems.ListQueries returned query with WIQL %q", wiql)
+	}
+}
+
+func TestWorkItems_GetQuery(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/wit/queries/Shared Queries/Active Bugs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/o/p/_apis/wit/queries/Shared%20Queries/Active%20Bugs?api-version=5.1")
+		fmt.Fprint(w, `{"id": "8a8c8212-15ca-41ed-97aa-1d6fbfbcd581", "name": "Active Bugs"}`)
+	})
+
+	got, _, err := c.WorkItems.GetQuery(context.Background(), "o", "p", "Shared Queries/Active Bugs", nil)
+	if err != nil {
+		t.Fatalf("WorkItems.GetQuery returned error: %v", err)
+	}
+
+	want := &azuredevops.QueryHierarchyItem{ID: String("8a8c8212-15ca-41ed-97aa-1d6fbfbcd581"), Name: String("Active Bugs")}
+	if !cmp.Equal(got, want) {
+		t.Errorf("WorkItems.GetQuery diff: %s", cmp.Diff(want, got))
+	}
+}
+
+func TestWorkItems_CreateUpdateDeleteQuery(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/wit/queries/Shared Queries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"Active Bugs","wiql":"SELECT [System.Id] FROM WorkItems"}`+"\n")
+		fmt.Fprint(w, `{"id": "8a8c8212-15ca-41ed-97aa-1d6fbfbcd581", "name": "Active Bugs"}`)
+	})
+	mux.HandleFunc("/o/p/_apis/wit/queries/8a8c8212-15ca-41ed-97aa-1d6fbfbcd581", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PATCH":
+			testBody(t, r, `{"name":"Open Bugs"}`+"\n")
+			fmt.Fprint(w, `{"id": "8a8c8212-15ca-41ed-97aa-1d6fbfbcd581", "name": "Open Bugs"}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Request method: %v, want PATCH or DELETE", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	query := &azuredevops.QueryHierarchyItem{
+		Name: String("Active Bugs"),
+		Wiql: String("SELECT [System.Id] FROM WorkItems"),
+	}
+	created, _, err := c.WorkItems.CreateQuery(ctx, "o", "p", "Shared Queries", query)
+	if err != nil {
+		t.Fatalf("WorkItems.CreateQuery returned error: %v", err)
+	}
+
+	updated, _, err := c.WorkItems.UpdateQuery(ctx, "o", "p", created.GetID(), &azuredevops.QueryHierarchyItem{Name: String("Open Bugs")})
+	if err != nil {
+		t.Fatalf("WorkItems.UpdateQuery returned error: %v", err)
+	}
+	if updated.GetName() != "Open Bugs" {
+		t.Errorf("WorkItems.UpdateQuery returned name %q, want %q", updated.GetName(), "Open Bugs")
+	}
+
+	if _, err := c.WorkItems.DeleteQuery(ctx, "o", "p", created.GetID()); err != nil {
+		t.Errorf("WorkItems.DeleteQuery returned error: %v", err)
+	}
+
+	if _, _, err := c.WorkItems.CreateQuery(ctx, "o", "p", "Shared Queries", &azuredevops.QueryHierarchyItem{}); err == nil {
+		t.Error("WorkItems.CreateQuery without a name returned no error")
+	}
+}
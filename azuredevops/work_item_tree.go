@@ -0,0 +1,132 @@
+package azuredevops
+
+// WorkItemNode A work item in a backlog and the work items beneath it,
+// e.g. a user story and its tasks.
+type WorkItemNode struct {
+	WorkItem *WorkItem
+	// Rel The type of the link from the parent work item, e.g.
+	// System.LinkTypes.Hierarchy-Forward. Empty for top level work items.
+	Rel      string
+	Children []*WorkItemNode
+}
+
+// WorkItemTree The top level work items of a backlog in backlog order.
+type WorkItemTree []*WorkItemNode
+
+// WorkItems returns every work item of the tree, each parent followed by
+// its children, as they appear in the backlog.
+func (t WorkItemTree) WorkItems() []*WorkItem {
+	var workItems []*WorkItem
+	var walk func(nodes []*WorkItemNode)
+	walk = func(nodes []*WorkItemNode) {
+		for _, node := range nodes {
+			if node.WorkItem != nil {
+				workItems = append(workItems, node.WorkItem)
+			}
+			walk(node.Children)
+		}
+	}
+	walk(t)
+	return workItems
+}
+
+// Find returns the node of the work item with the given ID, or nil if it
+// is not in the tree.
+func (t WorkItemTree) Find(id int) *WorkItemNode {
+	for _, node := range t {
+		if node.WorkItem.GetID() == id {
+			return node
+		}
+		if found := WorkItemTree(node.Children).Find(id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// newWorkItemTree builds a tree from the links of an iteration backlog or
+// a tree query. Links without a source are top level work items; the
+// others place their target beneath their source. The order of the links
+// is kept. It returns the tree and the IDs of its work items in order.
+func newWorkItemTree(links []*WorkItemLink) (WorkItemTree, []int) {
+	var tree WorkItemTree
+	var ids []int
+	nodes := map[int]*WorkItemNode{}
+
+	node := func(id int) *WorkItemNode {
+		if n, ok := nodes[id]; ok {
+			return n
+		}
+		n := &WorkItemNode{WorkItem: &WorkItem{ID: Int(id)}}
+		nodes[id] = n
+		ids = append(ids, id)
+		return n
+	}
+
+	for _, link := range links {
+		if link.Target == nil || link.Target.ID == nil {
+			continue
+		}
+		if _, seen := nodes[*link.Target.ID]; seen && link.Source == nil {
+			continue
+		}
+		if link.Source == nil || link.Source.ID == nil {
+			tree = append(tree, node(*link.Target.ID))
+			continue
+		}
+		if *link.Source.ID == *link.Target.ID {
+			continue
+		}
+
+		parent, parentSeen := nodes[*link.Source.ID]
+		if !parentSeen {
+			parent = node(*link.Source.ID)
+			tree = append(tree, parent)
+		}
+		child, childSeen := nodes[*link.Target.ID]
+		if childSeen {
+			// The target was listed as a top level work item first, unless
+			// it is already beneath another work item
+			rest := tree.without(child)
+			if len(rest) == len(tree) {
+				continue
+			}
+			tree = rest
+		} else {
+			child = node(*link.Target.ID)
+		}
+		child.Rel = link.GetRel()
+		parent.Children = append(parent.Children, child)
+	}
+	return tree, ids
+}
+
+// without returns the tree without the given top level node
+func (t WorkItemTree) without(n *WorkItemNode) WorkItemTree {
+	for i, node := range t {
+		if node == n {
+			return append(t[:i:i], t[i+1:]...)
+		}
+	}
+	return t
+}
+
+// fill replaces the placeholder work items of the tree with the given
+// work items, keyed by ID. Nodes whose work item is missing, e.g. because
+// it was omitted by an error policy, are removed and their children moved
+// up in their place.
+func (t WorkItemTree) fill(byID map[int]*WorkItem) WorkItemTree {
+	var filled WorkItemTree
+	for _, node := range t {
+		children := WorkItemTree(node.Children).fill(byID)
+		workItem, ok := byID[node.WorkItem.GetID()]
+		if !ok {
+			filled = append(filled, children...)
+			continue
+		}
+		node.WorkItem = workItem
+		node.Children = children
+		filled = append(filled, node)
+	}
+	return filled
+}
@@ -0,0 +1,66 @@
+package azuredevops_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mcdafydd/go-azuredevops/azuredevops"
+)
+
+func TestWorkItemTree(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	// 10 is a feature with stories 11 and 12; 12 was listed at the top
+	// level before its link. 11 has task 13. 20 is a story of its own
+	// and its task 21 was deleted, so it is omitted from the batch.
+	mux.HandleFunc(getIdsURL, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"workItemRelations": [
+			{"target": {"id": 10}},
+			{"target": {"id": 12}},
+			{"rel": "System.LinkTypes.Hierarchy-Forward", "source": {"id": 10}, "target": {"id": 11}},
+			{"rel": "System.LinkTypes.Hierarchy-Forward", "source": {"id": 10}, "target": {"id": 12}},
+			{"rel": "System.LinkTypes.Hierarchy-Forward", "source": {"id": 11}, "target": {"id": 13}},
+			{"target": {"id": 20}},
+			{"rel": "System.LinkTypes.Hierarchy-Forward", "source": {"id": 20}, "target": {"id": 21}},
+			{"rel": "System.LinkTypes.Hierarchy-Forward", "source": {"id": 21}, "target": {"id": 22}}
+		]}`)
+	})
+	mux.HandleFunc("/o/p/_apis/wit/workitemsbatch", func(w http.ResponseWriter, r *http.Request) {
+		testBody(t, r, `{"ids":[10,12,11,13,20,21,22],"fields":["System.Title"],"errorPolicy":"omit"}`+"\n")
+		fmt.Fprint(w, `{"value": [{"id": 10}, {"id": 12}, {"id": 11}, {"id": 13}, {"id": 20}, {"id": 22}]}`)
+	})
+
+	iteration := azuredevops.Iteration{ID: String("a589a806-bf11-4d4f-a031-c19813331553")}
+	tree, _, err := c.WorkItems.GetForIteration(context.Background(), "o", "p", "t", iteration, &azuredevops.IterationWorkItemsOptions{
+		Fields:      []string{"System.Title"},
+		ErrorPolicy: "omit",
+	})
+	if err != nil {
+		t.Fatalf("WorkItems.GetForIteration returned error: %v", err)
+	}
+
+	var ids []int
+	for _, workItem := range tree.WorkItems() {
+		ids = append(ids, workItem.GetID())
+	}
+	if want := []int{10, 11, 13, 12, 20, 22}; !cmp.Equal(ids, want) {
+		t.Errorf("WorkItemTree.WorkItems returned %v, want %v", ids, want)
+	}
+
+	if len(tree) != 2 {
+		t.Fatalf("WorkItemTree has %d top level work items, want 2", len(tree))
+	}
+	if node := tree.Find(13); node == nil || node.Rel != "System.LinkTypes.Hierarchy-Forward" {
+		t.Errorf("WorkItemTree.Find(13) returned %+v", node)
+	}
+	if node := tree.Find(20); node == nil || len(node.Children) != 1 || node.Children[0].WorkItem.GetID() != 22 {
+		t.Errorf("WorkItemTree.Find(20) returned %+v, want 22 moved up in place of 21", node)
+	}
+	if node := tree.Find(21); node != nil {
+		t.Errorf("WorkItemTree.Find(21) returned %+v, want nil", node)
+	}
+}
@@ -2,6 +2,7 @@ package azuredevops
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -125,35 +126,46 @@ type WorkItemUpdate struct {
 	URL         *string                         `json:"url,omitempty"`
 }
 
-// GetForIteration will get a list of work items based on an iteration name
-// utilising https://docs.microsoft.com/en-gb/rest/api/vsts/wit/work%20items/list
-func (s *WorkItemsService) GetForIteration(ctx context.Context, owner, project, team string, iteration Iteration) ([]*WorkItem, *http.Response, error) {
-	iterationWorkItems, resp, err := s.GetIdsForIteration(ctx, owner, project, team, iteration)
-	if err != nil {
-		return nil, resp, err
-	}
+// maxWorkItemsPerRequest is the maximum number of work items that can be
+// retrieved in a single List request
+const maxWorkItemsPerRequest = 200
+
+// WorkItemListOptions describes what the request to the API should look like
+type WorkItemListOptions struct {
+	// Fields The fields to return. All fields are returned if empty.
+	Fields []string `url:"fields,comma,omitempty"`
+	// AsOf Return the work items as they were at this date and time.
+	AsOf string `url:"asOf,omitempty"`
+	// Expand The expand parameters for work item attributes: none,
+	// relations, fields, links or all.
+	Expand string `url:"$expand,omitempty"`
+	// ErrorPolicy The flag to control error policy: fail or omit.
+	ErrorPolicy string `url:"errorPolicy,omitempty"`
+}
 
-	var workIds []string
-	for index := 0; index < len(iterationWorkItems.WorkItemRelations); index++ {
-		relationship := (iterationWorkItems.WorkItemRelations)[index]
-		workIds = append(workIds, strconv.Itoa(*relationship.Target.ID))
+// List returns the work items with the given IDs. At most 200 IDs can be
+// requested at once.
+// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work%20items/list?view=azure-devops-rest-5.1
+func (s *WorkItemsService) List(ctx context.Context, owner, project string, ids []int, opts *WorkItemListOptions) ([]*WorkItem, *http.Response, error) {
+	if len(ids) == 0 || len(ids) > maxWorkItemsPerRequest {
+		return nil, nil, fmt.Errorf("WorkItems.List: Must request between 1 and %d work items", maxWorkItemsPerRequest)
 	}
 
-	// https://docs.microsoft.com/en-us/rest/api/vsts/wit/work%20item%20types%20field/list
-	fields := []string{
-		"System.Id", "System.Title", "System.State", "System.WorkItemType",
-		"Microsoft.VSTS.Scheduling.StoryPoints", "System.BoardColumn",
-		"System.CreatedBy", "System.AssignedTo", "System.Tags",
+	workIds := make([]string, len(ids))
+	for i, id := range ids {
+		workIds[i] = strconv.Itoa(id)
 	}
 
-	// Now we want to pad out the fields for the work items
 	URL := fmt.Sprintf(
-		"%s/%s/_apis/wit/workitems?ids=%s&fields=%s&api-version=5.1-preview.1",
+		"%s/%s/_apis/wit/workitems?ids=%s&api-version=5.1",
 		owner,
 		project,
 		strings.Join(workIds, ","),
-		strings.Join(fields, ","),
 	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := s.client.NewRequest("GET", URL, nil)
 	if err != nil {
@@ -161,11 +173,341 @@ func (s *WorkItemsService) GetForIteration(ctx context.Context, owner, project,
 	}
 
 	r := new(WorkItemListResponse)
-	resp, err = s.client.Execute(ctx, req, r)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r.WorkItems, resp, err
+}
+
+// defaultBatchWorkers is the number of concurrent requests ListByIDs makes
+// when no number is given
+const defaultBatchWorkers = 4
+
+// workItemsBatchRequest describes a request for a batch of work items
+type workItemsBatchRequest struct {
+	IDs         []int    `json:"ids"`
+	Fields      []string `json:"fields,omitempty"`
+	AsOf        string   `json:"asOf,omitempty"`
+	Expand      string   `json:"$expand,omitempty"`
+	ErrorPolicy string   `json:"errorPolicy,omitempty"`
+}
+
+// GetBatch returns the work items with the given IDs like List, but sends
+// the IDs in the request body so they are not limited by the length of
+// the URL. At most 200 IDs can be requested at once. Work items omitted
+// by opts.ErrorPolicy are returned as nil entries.
+// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work%20items/get%20work%20items%20batch?view=azure-devops-rest-5.1
+func (s *WorkItemsService) GetBatch(ctx context.Context, owner, project string, ids []int, opts *WorkItemListOptions) ([]*WorkItem, *http.Response, error) {
+	if len(ids) == 0 || len(ids) > maxWorkItemsPerRequest {
+		return nil, nil, fmt.Errorf("WorkItems.GetBatch: Must request between 1 and %d work items", maxWorkItemsPerRequest)
+	}
+
+	URL := fmt.Sprintf(
+		"%s/%s/_apis/wit/workitemsbatch?api-version=5.1",
+		owner,
+		project,
+	)
+
+	body := &workItemsBatchRequest{IDs: ids}
+	if opts != nil {
+		body.Fields = opts.Fields
+		body.AsOf = opts.AsOf
+		body.Expand = opts.Expand
+		body.ErrorPolicy = opts.ErrorPolicy
+	}
+
+	req, err := s.client.NewRequest("POST", URL, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(WorkItemListResponse)
+	resp, err := s.client.Execute(ctx, req, r)
 
 	return r.WorkItems, resp, err
 }
 
+// ListByIDs returns the work items with the given IDs, any number of them,
+// e.g. the IDs of a WorkItemQueryResult. The IDs are requested with
+// GetBatch in chunks of 200 by at most workers goroutines (4 if workers is
+// not positive). Work items are returned in the order of ids, except
+// those omitted by opts.ErrorPolicy, which are left out rather than
+// returned as nil entries. The first error cancels the remaining
+// requests and is returned with the response to the failed request.
+// Otherwise the response is the one to the request that finished last.
+func (s *WorkItemsService) ListByIDs(ctx context.Context, owner, project string, ids []int, opts *WorkItemListOptions, workers int) ([]*WorkItem, *http.Response, error) {
+	if len(ids) == 0 {
+		return nil, nil, nil
+	}
+	if workers <= 0 {
+		workers = defaultBatchWorkers
+	}
+
+	var chunks [][]int
+	for start := 0; start < len(ids); start += maxWorkItemsPerRequest {
+		end := start + maxWorkItemsPerRequest
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunks = append(chunks, ids[start:end])
+	}
+
+	results := make([][]*WorkItem, len(chunks))
+	resp, err := forEach(ctx, len(chunks), workers, func(ctx context.Context, i int) (*http.Response, error) {
+		workItems, resp, err := s.GetBatch(ctx, owner, project, chunks[i], opts)
+		results[i] = workItems
+		return resp, err
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var workItems []*WorkItem
+	for _, r := range results {
+		for _, workItem := range r {
+			if workItem != nil {
+				workItems = append(workItems, workItem)
+			}
+		}
+	}
+	return workItems, resp, nil
+}
+
+// WorkItemGetOptions describes what the request to the API should look like
+type WorkItemGetOptions struct {
+	// Fields The fields to return. All fields are returned if empty.
+	Fields []string `url:"fields,comma,omitempty"`
+	// AsOf Return the work item as it was at this date and time.
+	AsOf string `url:"asOf,omitempty"`
+	// Expand The expand parameters for work item attributes: none,
+	// relations, fields, links or all.
+	Expand string `url:"$expand,omitempty"`
+}
+
+// WorkItemUpdateOptions describes what the request to the API should look like
+type WorkItemUpdateOptions struct {
+	// ValidateOnly Validate the changes without saving the work item.
+	ValidateOnly bool `url:"validateOnly,omitempty"`
+	// BypassRules Do not enforce the work item type rules on this update.
+	BypassRules bool `url:"bypassRules,omitempty"`
+	// SuppressNotifications Do not fire any notifications for this change.
+	SuppressNotifications bool `url:"suppressNotifications,omitempty"`
+	// Expand The expand parameters for the returned work item: none,
+	// relations, fields, links or all.
+	Expand string `url:"$expand,omitempty"`
+}
+
+// WorkItemDelete Describes a work item that was moved to the recycle bin.
+type WorkItemDelete struct {
+	Code        *int      `json:"code,omitempty"`
+	DeletedBy   *string   `json:"deletedBy,omitempty"`
+	DeletedDate *string   `json:"deletedDate,omitempty"`
+	ID          *int      `json:"id,omitempty"`
+	Message     *string   `json:"message,omitempty"`
+	Name        *string   `json:"name,omitempty"`
+	Project     *string   `json:"project,omitempty"`
+	Resource    *WorkItem `json:"resource,omitempty"`
+	Type        *string   `json:"type,omitempty"`
+	URL         *string   `json:"url,omitempty"`
+}
+
+// Get returns a single work item
+// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work%20items/get%20work%20item?view=azure-devops-rest-5.1
+func (s *WorkItemsService) Get(ctx context.Context, owner, project string, workItemID int, opts *WorkItemGetOptions) (*WorkItem, *http.Response, error) {
+	URL := fmt.Sprintf(
+		"%s/%s/_apis/wit/workitems/%d?api-version=5.1",
+		owner,
+		project,
+		workItemID,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(WorkItem)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// Create creates a work item of the given type, e.g. Bug or User Story,
+// from a patch that sets its fields and relations
+// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work%20items/create?view=azure-devops-rest-5.1
+func (s *WorkItemsService) Create(ctx context.Context, owner, project, workItemType string, patch WorkItemPatch, opts *WorkItemUpdateOptions) (*WorkItem, *http.Response, error) {
+	if len(patch) == 0 {
+		return nil, nil, errors.New("WorkItems.Create: Must supply at least one patch operation")
+	}
+
+	URL := fmt.Sprintf(
+		"%s/%s/_apis/wit/workitems/$%s?api-version=5.1",
+		owner,
+		project,
+		url.PathEscape(workItemType),
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewJSONPatchRequest("POST", URL, patch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(WorkItem)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// Update applies a patch to a work item. Include a TestRev operation to
+// fail the update if the work item has changed since it was read.
+// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work%20items/update?view=azure-devops-rest-5.1
+func (s *WorkItemsService) Update(ctx context.Context, owner, project string, workItemID int, patch WorkItemPatch, opts *WorkItemUpdateOptions) (*WorkItem, *http.Response, error) {
+	if len(patch) == 0 {
+		return nil, nil, errors.New("WorkItems.Update: Must supply at least one patch operation")
+	}
+
+	URL := fmt.Sprintf(
+		"%s/%s/_apis/wit/workitems/%d?api-version=5.1",
+		owner,
+		project,
+		workItemID,
+	)
+	URL, err := addOptions(URL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewJSONPatchRequest("PATCH", URL, patch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(WorkItem)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// Delete moves a work item to the recycle bin, from where it can be
+// restored
+// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work%20items/delete?view=azure-devops-rest-5.1
+func (s *WorkItemsService) Delete(ctx context.Context, owner, project string, workItemID int) (*WorkItemDelete, *http.Response, error) {
+	URL := fmt.Sprintf(
+		"%s/%s/_apis/wit/workitems/%d?api-version=5.1",
+		owner,
+		project,
+		workItemID,
+	)
+
+	req, err := s.client.NewRequest("DELETE", URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(WorkItemDelete)
+	resp, err := s.client.Execute(ctx, req, r)
+
+	return r, resp, err
+}
+
+// Destroy permanently deletes a work item. It cannot be restored.
+// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work%20items/delete?view=azure-devops-rest-5.1
+func (s *WorkItemsService) Destroy(ctx context.Context, owner, project string, workItemID int) (*http.Response, error) {
+	URL := fmt.Sprintf(
+		"%s/%s/_apis/wit/workitems/%d?destroy=true&api-version=5.1",
+		owner,
+		project,
+		workItemID,
+	)
+
+	req, err := s.client.NewRequest("DELETE", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Execute(ctx, req, nil)
+}
+
+// iterationWorkItemFields are the fields GetForIteration returns by default
+// https://docs.microsoft.com/en-us/rest/api/vsts/wit/work%20item%20types%20field/list
+var iterationWorkItemFields = []string{
+	"System.Id", "System.Title", "System.State", "System.WorkItemType",
+	"Microsoft.VSTS.Scheduling.StoryPoints", "System.BoardColumn",
+	"System.CreatedBy", "System.AssignedTo", "System.Tags",
+}
+
+// IterationWorkItemsOptions describes what the request to the API should look like
+type IterationWorkItemsOptions struct {
+	// Fields The fields to return, e.g. including custom fields such as
+	// Custom.Risk. Defaults to the id, title, state, type, story points,
+	// board column, created by, assigned to and tags fields unless Expand
+	// is set. The two cannot be combined; GetForIteration returns an error
+	// if both are set.
+	Fields []string
+	// Expand The expand parameters for work item attributes: none,
+	// relations, fields, links or all.
+	Expand string
+	// AsOf Return the work items as they were at this date and time.
+	AsOf string
+	// ErrorPolicy The flag to control error policy: fail or omit. Omitted
+	// work items, e.g. deleted ones, are left out of the tree and their
+	// children take their place.
+	ErrorPolicy string
+}
+
+// GetForIteration will get the work items of an iteration as a tree that
+// mirrors the sprint backlog: top level work items such as user stories
+// with the work items linked beneath them, such as tasks. Work items are
+// requested in batches, so iterations of any size are supported.
+// utilising https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work%20items/get%20work%20items%20batch?view=azure-devops-rest-5.1
+func (s *WorkItemsService) GetForIteration(ctx context.Context, owner, project, team string, iteration Iteration, opts *IterationWorkItemsOptions) (WorkItemTree, *http.Response, error) {
+	if opts != nil && len(opts.Fields) > 0 && opts.Expand != "" {
+		return nil, nil, errors.New("WorkItems.GetForIteration: Fields and Expand cannot be combined")
+	}
+
+	iterationWorkItems, resp, err := s.GetIdsForIteration(ctx, owner, project, team, iteration)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	tree, ids := newWorkItemTree(iterationWorkItems.WorkItemRelations)
+	if len(ids) == 0 {
+		return nil, resp, nil
+	}
+
+	listOpts := &WorkItemListOptions{Fields: iterationWorkItemFields}
+	if opts != nil {
+		listOpts.AsOf = opts.AsOf
+		listOpts.Expand = opts.Expand
+		listOpts.ErrorPolicy = opts.ErrorPolicy
+		if len(opts.Fields) > 0 {
+			listOpts.Fields = opts.Fields
+		} else if opts.Expand != "" {
+			listOpts.Fields = nil
+		}
+	}
+
+	// Now we want to pad out the fields for the work items, in batches so
+	// large iterations do not exceed the URL length limit
+	workItems, resp, err := s.ListByIDs(ctx, owner, project, ids, listOpts, 0)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	byID := make(map[int]*WorkItem, len(workItems))
+	for _, workItem := range workItems {
+		byID[workItem.GetID()] = workItem
+	}
+	return tree.fill(byID), resp, nil
+}
+
 // GetIdsForIteration will return an array of ids for a given iteration
 // utilising https://docs.microsoft.com/en-gb/rest/api/vsts/work/iterations/get%20iteration%20work%20items
 func (s *WorkItemsService) GetIdsForIteration(ctx context.Context, owner, project, team string, iteration Iteration) (*IterationWorkItems, *http.Response, error) {
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -129,29 +131,30 @@ const (
 
 func TestWorkItems_GetForIteration(t *testing.T) {
 	actualIdsURL := fmt.Sprintf("/o/p/t/_apis/work/teamsettings/iterations/a589a806-bf11-4d4f-a031-c19813331553/workitems?api-version=5.1-preview.1")
-	actualGetURL := fmt.Sprintf("/o/p/_apis/wit/workitems?ids=1,3&fields=System.Id,System.Title,System.State,System.WorkItemType,Microsoft.VSTS.Scheduling.StoryPoints,System.BoardColumn,System.CreatedBy,System.AssignedTo,System.Tags&api-version=5.1-preview.1")
+	actualGetURL := "/o/p/_apis/wit/workitemsbatch?api-version=5.1"
 
 	tt := []struct {
-		name              string
-		idsBaseURL        string
-		getBaseURL        string
-		actualIdsURL      string
-		actualGetURL      string
-		idsResponse       string
-		getResponse       string
-		expectedWorkItems int
-		tagString         string
+		name        string
+		opts        *azuredevops.IterationWorkItemsOptions
+		actualBody  string
+		getResponse string
 	}{
 		{
-			name:              "we get ids and we get iterations",
-			idsBaseURL:        getIdsURL,
-			getBaseURL:        "/o/p/_apis/wit/workitems",
-			actualIdsURL:      actualIdsURL,
-			actualGetURL:      actualGetURL,
-			idsResponse:       getIdsResponse,
-			getResponse:       getResponse,
-			expectedWorkItems: 3,
-			tagString:         "Tag1; Tag2",
+			name:        "default fields",
+			actualBody:  `{"ids":[1,3],"fields":["System.Id","System.Title","System.State","System.WorkItemType","Microsoft.VSTS.Scheduling.StoryPoints","System.BoardColumn","System.CreatedBy","System.AssignedTo","System.Tags"]}` + "\n",
+			getResponse: `{"count": 2, "value": [{"id": 1, "fields": {"System.Title": "Story"}}, {"id": 3, "fields": {"System.Title": "Task"}}]}`,
+		},
+		{
+			name:        "custom fields as of a date",
+			opts:        &azuredevops.IterationWorkItemsOptions{Fields: []string{"System.Title", "Custom.Risk"}, AsOf: "2019-10-01T00:00:00Z"},
+			actualBody:  `{"ids":[1,3],"fields":["System.Title","Custom.Risk"],"asOf":"2019-10-01T00:00:00Z"}` + "\n",
+			getResponse: `{"count": 2, "value": [{"id": 3, "fields": {"System.Title": "Task"}}, {"id": 1, "fields": {"System.Title": "Story", "Custom.Risk": "High"}}]}`,
+		},
+		{
+			name:        "expand without fields",
+			opts:        &azuredevops.IterationWorkItemsOptions{Expand: "relations"},
+			actualBody:  `{"ids":[1,3],"$expand":"relations"}` + "\n",
+			getResponse: `{"count": 2, "value": [{"id": 1, "fields": {"System.Title": "Story"}}, {"id": 3, "fields": {"System.Title": "Task"}}]}`,
 		},
 	}
 
@@ -160,27 +163,33 @@ func TestWorkItems_GetForIteration(t *testing.T) {
 			c, mux, _, teardown := setup()
 			defer teardown()
 
-			mux.HandleFunc(tc.idsBaseURL, func(w http.ResponseWriter, r *http.Request) {
+			mux.HandleFunc(getIdsURL, func(w http.ResponseWriter, r *http.Request) {
 				testMethod(t, r, "GET")
-				testURL(t, r, tc.actualIdsURL)
-				json := tc.idsResponse
-				fmt.Fprint(w, json)
+				testURL(t, r, actualIdsURL)
+				fmt.Fprint(w, getIdsResponse)
 			})
-			mux.HandleFunc(tc.getBaseURL, func(w http.ResponseWriter, r *http.Request) {
-				testMethod(t, r, "GET")
-				testURL(t, r, tc.actualGetURL)
-				json := tc.getResponse
-				fmt.Fprint(w, json)
+			mux.HandleFunc("/o/p/_apis/wit/workitemsbatch", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				testURL(t, r, actualGetURL)
+				testBody(t, r, tc.actualBody)
+				fmt.Fprint(w, tc.getResponse)
 			})
 
 			iteration := azuredevops.Iteration{ID: String("a589a806-bf11-4d4f-a031-c19813331553")}
-			got, _, err := c.WorkItems.GetForIteration(context.Background(), "o", "p", "t", iteration)
+			got, _, err := c.WorkItems.GetForIteration(context.Background(), "o", "p", "t", iteration, tc.opts)
 			if err != nil {
 				t.Fatalf("returned error: %v", err)
 			}
 
-			if len(got) != tc.expectedWorkItems {
-				t.Fatalf("expected %d work items; got %d", tc.expectedWorkItems, len(got))
+			if len(got) != 1 || len(got[0].Children) != 1 {
+				t.Fatalf("expected one work item with one child; got %+v", got)
+			}
+			if title := got[0].WorkItem.Title(); title != "Story" {
+				t.Errorf("expected parent Story; got %q", title)
+			}
+			child := got[0].Children[0]
+			if child.WorkItem.Title() != "Task" || child.Rel != "System.LinkTypes.Hierarchy-Forward" {
+				t.Errorf("expected child Task linked by Hierarchy-Forward; got %q linked by %q", child.WorkItem.Title(), child.Rel)
 			}
 		})
 	}
@@ -278,3 +287,237 @@ func TestWorkItems_CreateComment(t *testing.T) {
 		t.Errorf("WorkItems.CreateComment error: %s", diff)
 	}
 }
+
+func TestWorkItems_Get(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/wit/workitems/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"fields": "System.Title,System.State",
+			"asOf":   "2020-01-01T00:00:00Z",
+		})
+		fmt.Fprint(w, `{"id": 1, "rev": 4, "fields": {"System.Title": "Fix login", "System.State": "Active"}}`)
+	})
+
+	opts := &azuredevops.WorkItemGetOptions{
+		Fields: []string{"System.Title", "System.State"},
+		AsOf:   "2020-01-01T00:00:00Z",
+	}
+	got, _, err := c.WorkItems.Get(context.Background(), "o", "p", 1, opts)
+	if err != nil {
+		t.Fatalf("WorkItems.Get returned error: %v", err)
+	}
+	if got.GetRev() != 4 || (*got.Fields)["System.Title"] != "Fix login" {
+		t.Errorf("WorkItems.Get returned %+v", got)
+	}
+}
+
+func TestWorkItems_Create(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/wit/workitems/$User Story", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json-patch+json")
+		testFormValues(t, r, values{"validateOnly": "true", "bypassRules": "true"})
+		testBody(t, r, `[{"op":"add","path":"/fields/System.Title","value":"Fix login"},`+
+			`{"op":"add","path":"/relations/-","value":{"rel":"System.LinkTypes.Hierarchy-Reverse","url":"https://o/_apis/wit/workItems/2"}}]`+"\n")
+		fmt.Fprint(w, `{"id": -1, "fields": {"System.Title": "Fix login"}}`)
+	})
+
+	patch := azuredevops.WorkItemPatch{}.
+		AddField("System.Title", "Fix login").
+		AddRelation(&azuredevops.WorkItemRelation{
+			Rel: azuredevops.String("System.LinkTypes.Hierarchy-Reverse"),
+			URL: azuredevops.String("https://o/_apis/wit/workItems/2"),
+		})
+	opts := &azuredevops.WorkItemUpdateOptions{ValidateOnly: true, BypassRules: true}
+	if _, _, err := c.WorkItems.Create(context.Background(), "o", "p", "User Story", patch, opts); err != nil {
+		t.Fatalf("WorkItems.Create returned error: %v", err)
+	}
+
+	if _, _, err := c.WorkItems.Create(context.Background(), "o", "p", "Bug", nil, nil); err == nil {
+		t.Errorf("WorkItems.Create expected an error without patch operations")
+	}
+}
+
+func TestWorkItems_Update(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/wit/workitems/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Content-Type", "application/json-patch+json")
+		testFormValues(t, r, values{"suppressNotifications": "true"})
+		testBody(t, r, `[{"op":"test","path":"/rev","value":4},`+
+			`{"op":"replace","path":"/fields/System.State","value":"Closed"},`+
+			`{"op":"remove","path":"/fields/System.AssignedTo"},`+
+			`{"op":"remove","path":"/relations/0"}]`+"\n")
+		fmt.Fprint(w, `{"id": 1, "rev": 5, "fields": {"System.State": "Closed"}}`)
+	})
+
+	patch := azuredevops.WorkItemPatch{}.
+		TestRev(4).
+		ReplaceField("System.State", "Closed").
+		RemoveField("System.AssignedTo").
+		RemoveRelation(0)
+	opts := &azuredevops.WorkItemUpdateOptions{SuppressNotifications: true}
+	got, _, err := c.WorkItems.Update(context.Background(), "o", "p", 1, patch, opts)
+	if err != nil {
+		t.Fatalf("WorkItems.Update returned error: %v", err)
+	}
+	if got.GetRev() != 5 {
+		t.Errorf("WorkItems.Update returned rev %d, want 5", got.GetRev())
+	}
+}
+
+func TestWorkItems_DeleteDestroy(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/wit/workitems/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if r.FormValue("destroy") == "true" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1, "code": 200, "name": "Fix login", "type": "Bug"}`)
+	})
+
+	deleted, _, err := c.WorkItems.Delete(context.Background(), "o", "p", 1)
+	if err != nil {
+		t.Fatalf("WorkItems.Delete returned error: %v", err)
+	}
+	if deleted.GetName() != "Fix login" {
+		t.Errorf("WorkItems.Delete returned %+v", deleted)
+	}
+
+	if _, err := c.WorkItems.Destroy(context.Background(), "o", "p", 1); err != nil {
+		t.Fatalf("WorkItems.Destroy returned error: %v", err)
+	}
+}
+
+func TestWorkItems_GetBatch(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/wit/workitemsbatch", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"ids":[297,299],"fields":["System.Title"],"errorPolicy":"omit"}`+"\n")
+		fmt.Fprint(w, `{"count": 1, "value": [{"id": 297, "fields": {"System.Title": "Customer can sign in"}}]}`)
+	})
+
+	opts := &azuredevops.WorkItemListOptions{Fields: []string{"System.Title"}, ErrorPolicy: "omit"}
+	got, _, err := c.WorkItems.GetBatch(context.Background(), "o", "p", []int{297, 299}, opts)
+	if err != nil {
+		t.Fatalf("WorkItems.GetBatch returned error: %v", err)
+	}
+
+	if len(got) != 1 || got[0].Title() != "Customer can sign in" {
+		t.Errorf("WorkItems.GetBatch returned %+v", got)
+	}
+
+	if _, _, err := c.WorkItems.GetBatch(context.Background(), "o", "p", make([]int, 201), nil); err == nil {
+		t.Error("WorkItems.GetBatch of 201 work items returned no error")
+	}
+}
+
+func TestWorkItems_ListByIDs(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var sizes []int
+	mux.HandleFunc("/o/p/_apis/wit/workitemsbatch", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body struct {
+			IDs []int `json:"ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Decode request body returned error: %v", err)
+		}
+
+		mu.Lock()
+		sizes = append(sizes, len(body.IDs))
+		mu.Unlock()
+
+		r2 := azuredevops.WorkItemListResponse{Count: len(body.IDs)}
+		for _, id := range body.IDs {
+			r2.WorkItems = append(r2.WorkItems, &azuredevops.WorkItem{ID: Int(id)})
+		}
+		json.NewEncoder(w).Encode(r2)
+	})
+
+	ids := make([]int, 450)
+	for i := range ids {
+		ids[i] = 1000 - i
+	}
+	got, _, err := c.WorkItems.ListByIDs(context.Background(), "o", "p", ids, nil, 2)
+	if err != nil {
+		t.Fatalf("WorkItems.ListByIDs returned error: %v", err)
+	}
+
+	sort.Ints(sizes)
+	if !cmp.Equal(sizes, []int{50, 200, 200}) {
+		t.Errorf("WorkItems.ListByIDs requested batches of %v, want [50 200 200]", sizes)
+	}
+	if len(got) != len(ids) {
+		t.Fatalf("WorkItems.ListByIDs returned %d work items, want %d", len(got), len(ids))
+	}
+	for i, workItem := range got {
+		if workItem.GetID() != ids[i] {
+			t.Fatalf("WorkItems.ListByIDs returned work item %d at %d, want %d", workItem.GetID(), i, ids[i])
+		}
+	}
+}
+
+func TestWorkItems_ListByIDs_omitted(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/wit/workitemsbatch", func(w http.ResponseWriter, r *http.Request) {
+		testBody(t, r, `{"ids":[1,2,3],"errorPolicy":"omit"}`+"\n")
+		fmt.Fprint(w, `{"count": 3, "value": [{"id": 1}, null, {"id": 3}]}`)
+	})
+
+	opts := &azuredevops.WorkItemListOptions{ErrorPolicy: "omit"}
+	got, _, err := c.WorkItems.ListByIDs(context.Background(), "o", "p", []int{1, 2, 3}, opts, 0)
+	if err != nil {
+		t.Fatalf("WorkItems.ListByIDs returned error: %v", err)
+	}
+
+	want := []*azuredevops.WorkItem{{ID: Int(1)}, {ID: Int(3)}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("WorkItems.ListByIDs diff: %s", cmp.Diff(want, got))
+	}
+}
+
+func TestWorkItems_ListByIDs_error(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/p/_apis/wit/workitemsbatch", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, _, err := c.WorkItems.ListByIDs(context.Background(), "o", "p", make([]int, 500), nil, 0); err == nil {
+		t.Error("WorkItems.ListByIDs returned no error")
+	}
+}
+
+func TestWorkItems_GetForIteration_fieldsAndExpand(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(getIdsURL, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("WorkItems.GetForIteration sent a request for invalid options")
+	})
+
+	iteration := azuredevops.Iteration{ID: String("a589a806-bf11-4d4f-a031-c19813331553")}
+	opts := &azuredevops.IterationWorkItemsOptions{Fields: []string{"System.Title"}, Expand: "relations"}
+	if _, _, err := c.WorkItems.GetForIteration(context.Background(), "o", "p", "t", iteration, opts); err == nil {
+		t.Error("WorkItems.GetForIteration with Fields and Expand returned no error")
+	}
+}
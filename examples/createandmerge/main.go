@@ -7,6 +7,7 @@
 // 1. Creates a NewClient() using basic auth and personal access token
 // 2. Creates a pull request
 // 3. Merges the pull request with the default no fast-forward
+// 4. Waits for the merge to complete and reports why it stopped
 
 package main
 
@@ -17,6 +18,7 @@ import (
 	"os"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/mcdafydd/go-azuredevops/azuredevops"
 	"golang.org/x/crypto/ssh/terminal"
@@ -129,7 +131,34 @@ func (client *myClient) merge(pull *azuredevops.GitPullRequest, id *azuredevops.
 		fmt.Printf("Merge failed: %+v\n", err)
 		return
 	}
-	fmt.Printf("Successfully merged pull request: %+v\n", merge)
+	fmt.Printf("Merge requested for pull request ID %d, waiting for completion.\n", merge.GetPullRequestID())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	result, _, err := client.c.PullRequests.WaitForCompletion(ctx,
+		client.org,
+		client.project,
+		client.repo,
+		pull.GetPullRequestID(),
+		nil,
+	)
+	if err != nil {
+		fmt.Printf("Waiting for merge failed: %+v\n", err)
+		return
+	}
+
+	switch result.Outcome {
+	case azuredevops.WaitCompleted:
+		fmt.Printf("Successfully merged pull request ID %d\n", pull.GetPullRequestID())
+	case azuredevops.WaitMergeFailed:
+		fmt.Printf("Merge failed (%s): %s\n", result.FailureType, result.FailureMessage)
+	case azuredevops.WaitRejectedByPolicy:
+		for _, policy := range result.RejectedPolicies {
+			fmt.Printf("Merge rejected by policy: %s\n", policy.GetConfiguration().GetType().GetDisplayName())
+		}
+	default:
+		fmt.Printf("Merge stopped: %s\n", result.Outcome)
+	}
 }
 
 // String helper function returns string pointer
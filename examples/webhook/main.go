@@ -9,6 +9,7 @@
 // 3. Listens for azuredevops.PushEvent webhooks
 // 4. Upon receiving a webhook, validates Basic user/pass
 // 5. Queues the supplied build ID number if webhook is valid
+// 6. Waits for the queued build to complete and logs its result
 package main
 
 import (
@@ -22,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/mcdafydd/go-azuredevops/azuredevops"
 	"golang.org/x/crypto/ssh/terminal"
@@ -140,11 +142,34 @@ func eventHandler(client *myClient, logger *log.Logger) http.Handler {
 			} else {
 				logger.Printf("Successful build trigger response: %+v\n", r)
 				logger.Printf("\nHTTP Response: %+v\n", resp)
+				go client.waitForBuild(r.GetID(), logger)
 			}
 		}
 	})
 }
 
+// waitForBuild logs the status changes and the result of a queued build
+func (client *myClient) waitForBuild(buildID int, logger *log.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	opts := &azuredevops.BuildWaitOptions{
+		OnStatusChange: func(build *azuredevops.Build) {
+			var status azuredevops.BuildStatus
+			if build.GetStatus() != nil {
+				status = *build.GetStatus()
+			}
+			logger.Printf("Build %d is %s\n", build.GetID(), status)
+		},
+	}
+	_, result, err := client.c.Builds.WaitForBuild(ctx, client.org, client.project, buildID, opts)
+	if err != nil {
+		logger.Printf("Waiting for build %d failed: %+v\n", buildID, err)
+		return
+	}
+	logger.Printf("Build %d completed with result %s\n", buildID, result)
+}
+
 // formatRef helper function for API calls that need a branch reference
 // as an input parameter and returns a pointer to a formatted string.
 // Examples: